@@ -1,12 +1,9 @@
 package azure
 
 import (
-	"fmt"
-	"regexp"
-	"strings"
-
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 )
 
 func SchemaResourceGroupName() *pluginsdk.Schema {
@@ -85,22 +82,5 @@ func SchemaResourceGroupNameSetOptional() *pluginsdk.Schema {
 }
 
 func ValidateResourceGroupName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-
-	if len(value) > 90 {
-		errors = append(errors, fmt.Errorf("%q may not exceed 90 characters in length", k))
-	}
-
-	if strings.HasSuffix(value, ".") {
-		errors = append(errors, fmt.Errorf("%q may not end with a period", k))
-	}
-
-	if len(value) == 0 {
-		errors = append(errors, fmt.Errorf("%q cannot be blank", k))
-	} else if matched := regexp.MustCompile(`^[-\w._()]+$`).Match([]byte(value)); !matched {
-		// regex pulled from https://docs.microsoft.com/en-us/rest/api/resources/resourcegroups/createorupdate
-		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters, dash, underscores, parentheses and periods", k))
-	}
-
-	return warnings, errors
+	return validation.ResourceName("Microsoft.Resources/resourceGroups")(v, k)
 }
@@ -17,7 +17,7 @@ func TestValidateResourceGroupName(t *testing.T) {
 		{
 			Value:    "",
 			ErrCount: 1,
-			Message:  "cannot be blank",
+			Message:  "must be between 1 and 90 characters",
 		},
 		{
 			Value:    "hello",
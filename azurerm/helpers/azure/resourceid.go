@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 // ResourceID represents a parsed long-form Azure Resource Manager ID
@@ -91,6 +93,17 @@ func ParseAzureResourceID(id string) (*ResourceID, error) {
 	return idObj, nil
 }
 
+// ParseAzureResourceIDInsensitively converts a long-form Azure Resource Manager ID
+// into a ResourceID, in the same way as ParseAzureResourceID - however the names of
+// the `subscriptions`, `resourceGroups` and `providers` segments are matched
+// case-insensitively, since some API's return these in a different casing.
+//
+// This is used by the generated Resource ID Parsers, which should also use
+// PopSegmentInsensitively to retrieve the other segments.
+func ParseAzureResourceIDInsensitively(id string) (*ResourceID, error) {
+	return ParseAzureResourceID(resourceid.NormalizeCasing(id))
+}
+
 // ParseAzureResourceIDWithoutSubscription parses Azure Resource ID's that are not prefixed
 // with a Subscription ID. Typically these are for administrative resources that are not bound
 // to a particular subscription. Note that these IDs are also unlikely to have a resourceGroup
@@ -153,6 +166,27 @@ func (id *ResourceID) PopSegment(name string) (string, error) {
 	return val, nil
 }
 
+// PopSegmentInsensitively retrieves a segment from the Path and returns it, in the
+// same way as PopSegment - however when there's no exact match this falls back to a
+// single segment whose name matches case-insensitively (e.g. `virtualnetworks`).
+func (id *ResourceID) PopSegmentInsensitively(name string) (string, error) {
+	if _, ok := id.Path[name]; ok {
+		return id.PopSegment(name)
+	}
+
+	matches := make([]string, 0)
+	for key := range id.Path {
+		if strings.EqualFold(key, name) {
+			matches = append(matches, key)
+		}
+	}
+	if len(matches) != 1 {
+		return "", fmt.Errorf("ID was missing the `%s` element", name)
+	}
+
+	return id.PopSegment(matches[0])
+}
+
 // ValidateNoEmptySegments validates ...
 func (id *ResourceID) ValidateNoEmptySegments(sourceId string) error {
 	if len(id.Path) == 0 {
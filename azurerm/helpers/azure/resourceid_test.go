@@ -147,6 +147,12 @@ func TestParseAzureResourceID(t *testing.T) {
 			},
 			false,
 		},
+		{
+			// upper-cased segment names
+			"/SUBSCRIPTIONS/34ca515c-4629-458e-bf7c-738d77e0d0ea/RESOURCEGROUPS/testGroup1/PROVIDERS/Microsoft.Network/VIRTUALNETWORKS/virtualNetwork1",
+			nil,
+			true,
+		},
 		{
 			// missing resource group
 			"/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.ApiManagement/service/service1/subscriptions/22222222-2222-2222-2222-222222222222",
@@ -178,6 +184,60 @@ func TestParseAzureResourceID(t *testing.T) {
 	}
 }
 
+func TestParseAzureResourceIDInsensitively(t *testing.T) {
+	testCases := []struct {
+		id                 string
+		expectedResourceID *azure.ResourceID
+		expectError        bool
+	}{
+		{
+			"random",
+			nil,
+			true,
+		},
+		{
+			"/subscriptions/6d74bdd2-9f84-11e5-9bd9-7831c1c4c038/resourceGroups/testGroup1/providers/Microsoft.Network/virtualNetworks/virtualNetwork1",
+			&azure.ResourceID{
+				SubscriptionID: "6d74bdd2-9f84-11e5-9bd9-7831c1c4c038",
+				ResourceGroup:  "testGroup1",
+				Provider:       "Microsoft.Network",
+				Path: map[string]string{
+					"virtualNetworks": "virtualNetwork1",
+				},
+			},
+			false,
+		},
+		{
+			// upper-cased segment names
+			"/SUBSCRIPTIONS/34ca515c-4629-458e-bf7c-738d77e0d0ea/RESOURCEGROUPS/testGroup1/PROVIDERS/Microsoft.Network/VIRTUALNETWORKS/virtualNetwork1",
+			&azure.ResourceID{
+				SubscriptionID: "34ca515c-4629-458e-bf7c-738d77e0d0ea",
+				ResourceGroup:  "testGroup1",
+				Provider:       "Microsoft.Network",
+				Path: map[string]string{
+					"VIRTUALNETWORKS": "virtualNetwork1",
+				},
+			},
+			false,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q", test.id)
+		parsed, err := azure.ParseAzureResourceIDInsensitively(test.id)
+		if test.expectError && err != nil {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("Unexpected error: %s", err)
+		}
+
+		if !reflect.DeepEqual(test.expectedResourceID, parsed) {
+			t.Fatalf("Unexpected resource ID:\nExpected: %+v\nGot:      %+v\n", test.expectedResourceID, parsed)
+		}
+	}
+}
+
 func TestParseAzureResourceIDWithoutSubscription(t *testing.T) {
 	testCases := []struct {
 		id                 string
@@ -253,3 +313,90 @@ func TestParseAzureResourceIDWithoutSubscription(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceIDPopSegment(t *testing.T) {
+	id := azure.ResourceID{
+		Path: map[string]string{
+			"virtualnetworks": "network1",
+		},
+	}
+
+	// the casing of the segment name is significant
+	if _, err := id.PopSegment("virtualNetworks"); err == nil {
+		t.Fatalf("Expected an error but didn't get one")
+	}
+
+	actual, err := id.PopSegment("virtualnetworks")
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if actual != "network1" {
+		t.Fatalf("Expected %q but got %q", "network1", actual)
+	}
+	if len(id.Path) != 0 {
+		t.Fatalf("Expected the segment to be removed from the path")
+	}
+}
+
+func TestResourceIDPopSegmentInsensitively(t *testing.T) {
+	testCases := []struct {
+		path        map[string]string
+		name        string
+		expected    string
+		expectError bool
+	}{
+		{
+			path:        map[string]string{},
+			name:        "virtualNetworks",
+			expectError: true,
+		},
+		{
+			path:     map[string]string{"virtualNetworks": "network1"},
+			name:     "virtualNetworks",
+			expected: "network1",
+		},
+		{
+			path:     map[string]string{"virtualnetworks": "network1"},
+			name:     "virtualNetworks",
+			expected: "network1",
+		},
+		{
+			// the exact match takes precedence
+			path:     map[string]string{"VIRTUALNETWORKS": "network1", "virtualNetworks": "network2"},
+			name:     "virtualNetworks",
+			expected: "network2",
+		},
+		{
+			// ambiguous
+			path:        map[string]string{"VIRTUALNETWORKS": "network1", "virtualnetworks": "network2"},
+			name:        "virtualNetworks",
+			expectError: true,
+		},
+	}
+
+	for _, test := range testCases {
+		t.Logf("[DEBUG] Testing %q in %+v", test.name, test.path)
+		id := azure.ResourceID{
+			Path: test.path,
+		}
+		segments := len(id.Path)
+		actual, err := id.PopSegmentInsensitively(test.name)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("Unexpected error: %s", err)
+		}
+		if test.expectError {
+			t.Fatalf("Expected an error but got %q", actual)
+		}
+
+		if actual != test.expected {
+			t.Fatalf("Expected %q but got %q", test.expected, actual)
+		}
+		if len(id.Path) != segments-1 {
+			t.Fatalf("Expected %q to be removed from the path", test.name)
+		}
+	}
+}
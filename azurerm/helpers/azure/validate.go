@@ -2,6 +2,8 @@ package azure
 
 import (
 	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 func ValidateResourceID(i interface{}, k string) (warnings []string, errors []error) {
@@ -32,3 +34,41 @@ func ValidateResourceIDOrEmpty(i interface{}, k string) (_ []string, errors []er
 
 	return ValidateResourceID(i, k)
 }
+
+// ValidateScopeID validates that the specified value is a Scope which an Extension Resource can exist within,
+// which can be a Management Group, a Subscription, a Resource Group or a Resource
+func ValidateScopeID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := resourceid.ParseScope(v); err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a scope: %v", k, err))
+	}
+
+	return warnings, errors
+}
+
+// ValidateResourceScopeID validates that the specified value is a Scope which is a Resource (or a Child Resource),
+// rather than a Management Group, a Subscription or a Resource Group
+func ValidateResourceScopeID(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	scope, err := resourceid.ParseScope(v)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("Can not parse %q as a scope: %v", k, err))
+		return
+	}
+
+	if scope.Type != resourceid.ResourceScopeType {
+		errors = append(errors, fmt.Errorf("expected %q to be the ID of a Resource but got a %s scope", k, scope.Type))
+	}
+
+	return warnings, errors
+}
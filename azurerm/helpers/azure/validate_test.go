@@ -94,3 +94,55 @@ func TestAzureResourceIDOrEmpty(t *testing.T) {
 		})
 	}
 }
+
+func TestHelper_ScopeID(t *testing.T) {
+	cases := []struct {
+		ID            string
+		Scope         bool
+		ResourceScope bool
+	}{
+		{
+			ID: "",
+		},
+		{
+			ID: "/path/to/nothing",
+		},
+		{
+			ID:    "/providers/Microsoft.Management/managementGroups/group1",
+			Scope: true,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000",
+			Scope: true,
+		},
+		{
+			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1",
+			Scope: true,
+		},
+		{
+			ID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Scope:         true,
+			ResourceScope: true,
+		},
+		{
+			ID:            "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Scope:         true,
+			ResourceScope: true,
+		},
+		{
+			ID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.ID, func(t *testing.T) {
+			if _, errors := azure.ValidateScopeID(tc.ID, "test"); (len(errors) == 0) != tc.Scope {
+				t.Fatalf("Expected the Scope to be valid %t but got the errors %+v", tc.Scope, errors)
+			}
+
+			if _, errors := azure.ValidateResourceScopeID(tc.ID, "test"); (len(errors) == 0) != tc.ResourceScope {
+				t.Fatalf("Expected the Resource Scope to be valid %t but got the errors %+v", tc.ResourceScope, errors)
+			}
+		})
+	}
+}
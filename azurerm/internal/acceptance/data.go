@@ -51,6 +51,9 @@ type TestData struct {
 
 	// resourceLabel is the local used for the resource - generally "test""
 	resourceLabel string
+
+	// recorder is used to record (or replay) the random values used in this test, when enabled
+	recorder testRecorder
 }
 
 // BuildTestData generates some test data for the given resource
@@ -62,9 +65,13 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		t.Fatalf("Error retrieving Environment: %+v", err)
 	}
 
+	recorder := startRecording(t)
+
 	testData := TestData{
-		RandomInteger:   RandTimeInt(),
-		RandomString:    acctest.RandString(5),
+		RandomInteger: recorder.recordedInt("random_integer", RandTimeInt),
+		RandomString: recorder.recordedString("random_string", func() string {
+			return acctest.RandString(5)
+		}),
 		ResourceName:    fmt.Sprintf("%s.%s", resourceType, resourceLabel),
 		Environment:     *env,
 		EnvironmentName: EnvironmentName(),
@@ -72,6 +79,7 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 
 		ResourceType:  resourceType,
 		resourceLabel: resourceLabel,
+		recorder:      recorder,
 	}
 
 	if features.UseDynamicTestLocations() {
@@ -84,6 +92,13 @@ func BuildTestData(t *testing.T, resourceType string, resourceLabel string) Test
 		}
 	}
 
+	// the locations are used within the requests, so need to match those which were recorded
+	testData.Locations = Regions{
+		Primary:   recorder.recordedString("location", func() string { return testData.Locations.Primary }),
+		Secondary: recorder.recordedString("location_alt", func() string { return testData.Locations.Secondary }),
+		Ternary:   recorder.recordedString("location_alt2", func() string { return testData.Locations.Ternary }),
+	}
+
 	return testData
 }
 
@@ -122,5 +137,7 @@ func (td *TestData) RandomStringOfLength(len int) string {
 		panic("Invalid Test: RandomStringOfLength: length argument must be between 1 and 1024 characters")
 	}
 
-	return acctest.RandString(len)
+	return td.recorder.recordedString("random_string_of_length", func() string {
+		return acctest.RandString(len)
+	})
 }
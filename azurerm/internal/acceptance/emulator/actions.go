@@ -0,0 +1,55 @@
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ActionFunc handles an Action (e.g. `listKeys`) invoked on a resource, returning the Response Body (if any)
+type ActionFunc func(resource map[string]interface{}, body map[string]interface{}) map[string]interface{}
+
+// HandleAction configures the Emulator to handle the specified Action (e.g. `listKeys`) when it's
+// invoked on any resource. Actions are always completed immediately, rather than as a Long Running Operation.
+func (e *Emulator) HandleAction(action string, handler ActionFunc) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.actions[strings.ToLower(action)] = handler
+}
+
+// post invokes the Action specified in the last segment of the path on the resource
+// NOTE: this must be called with the lock held
+func (e *Emulator) post(w http.ResponseWriter, path string, segments []string, body []byte) {
+	action := segments[len(segments)-1]
+	resourceId := "/" + strings.Join(segments[:len(segments)-1], "/")
+
+	existing, ok := e.resources[resourceKey(resourceId)]
+	if !ok {
+		writeNotFound(w, resourceId)
+		return
+	}
+
+	handler, ok := e.actions[strings.ToLower(action)]
+	if !ok {
+		writeError(w, http.StatusBadRequest, "UnsupportedByEmulator", fmt.Sprintf("the Action %q isn't supported by the Emulator", action))
+		return
+	}
+
+	parsed := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("parsing the request body: %+v", err))
+			return
+		}
+	}
+
+	result := handler(existing, parsed)
+	if result == nil {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, result)
+}
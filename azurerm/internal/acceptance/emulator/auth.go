@@ -0,0 +1,66 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// isAuthPath returns whether the path is one of the Azure Active Directory (or Graph) endpoints within the
+// Emulator's Tenant, which are used to authenticate
+func isAuthPath(segments []string) bool {
+	return len(segments) >= 2 && strings.EqualFold(segments[0], TenantId)
+}
+
+func (e *Emulator) handleAuth(w http.ResponseWriter, r *http.Request, segments []string, body []byte) {
+	switch {
+	case len(segments) == 3 && strings.EqualFold(segments[1], "oauth2") && strings.EqualFold(segments[2], "token") && r.Method == http.MethodPost:
+		e.token(w, body)
+
+	case len(segments) == 2 && strings.EqualFold(segments[1], "servicePrincipals") && r.Method == http.MethodGet:
+		e.servicePrincipals(w)
+
+	default:
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("%s %s isn't supported by the Emulator", r.Method, strings.Join(segments, "/")))
+	}
+}
+
+// token issues an access token for the requested resource - the Emulator doesn't validate the credentials, nor
+// the access tokens which are subsequently sent
+func (e *Emulator) token(w http.ResponseWriter, body []byte) {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("parsing the request body: %+v", err))
+		return
+	}
+
+	if clientId := values.Get("client_id"); clientId != ClientId {
+		writeError(w, http.StatusUnauthorized, "unauthorized_client", fmt.Sprintf("the Client %q was not found in the Tenant %q", clientId, TenantId))
+		return
+	}
+
+	now := time.Now()
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"access_token": "emulator",
+		"expires_in":   "3600",
+		"expires_on":   fmt.Sprintf("%d", now.Add(time.Hour).Unix()),
+		"not_before":   fmt.Sprintf("%d", now.Unix()),
+		"resource":     values.Get("resource"),
+		"token_type":   "Bearer",
+	})
+}
+
+// servicePrincipals returns the Service Principal which is used to authenticate with the Emulator
+func (e *Emulator) servicePrincipals(w http.ResponseWriter) {
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"value": []interface{}{
+			map[string]interface{}{
+				"appId":      ClientId,
+				"objectId":   ObjectId,
+				"objectType": "ServicePrincipal",
+			},
+		},
+	})
+}
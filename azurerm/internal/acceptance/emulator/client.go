@@ -0,0 +1,97 @@
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+)
+
+// Environment returns the Azure Environment for this Emulator, which is the Public Cloud with the
+// Active Directory, Graph and Resource Manager Endpoints pointing at the Emulator
+func (e *Emulator) Environment() azure.Environment {
+	env := azure.PublicCloud
+	env.Name = "AzureEmulatorCloud"
+	env.ActiveDirectoryEndpoint = e.Endpoint() + "/"
+	env.GraphEndpoint = e.Endpoint() + "/"
+	env.ResourceManagerEndpoint = e.Endpoint()
+	return env
+}
+
+// ClientOptions returns the ClientOptions used to build Clients which send requests to this Emulator
+func (e *Emulator) ClientOptions() *common.ClientOptions {
+	// the Emulator doesn't require authentication
+	authorizer := autorest.NullAuthorizer{}
+
+	return &common.ClientOptions{
+		SubscriptionId:            SubscriptionId,
+		TenantID:                  TenantId,
+		TerraformVersion:          "0.12.0",
+		GraphAuthorizer:           authorizer,
+		GraphEndpoint:             e.Endpoint(),
+		KeyVaultAuthorizer:        authorizer,
+		ResourceManagerAuthorizer: authorizer,
+		ResourceManagerEndpoint:   e.Endpoint(),
+		StorageAuthorizer:         authorizer,
+		SynapseAuthorizer:         authorizer,
+		// requests to the Emulator are retried quickly, so that tests don't need to wait
+		RetryPolicy: common.RetryPolicy{
+			MaxAttempts:         3,
+			MinDelay:            10 * time.Millisecond,
+			MaxDelay:            100 * time.Millisecond,
+			RetryableErrorCodes: common.DefaultRetryableErrorCodes,
+		},
+		DisableTerraformPartnerID: true,
+		Environment:               e.Environment(),
+		Features:                  features.Default(),
+	}
+}
+
+// Client returns a Client which sends requests to this Emulator
+func (e *Emulator) Client(ctx context.Context) (*clients.Client, error) {
+	env := e.Environment()
+	client := clients.Client{
+		Account: &clients.ResourceManagerAccount{
+			AuthenticatedAsAServicePrincipal: true,
+			ClientId:                         ClientId,
+			Environment:                      env,
+			ObjectId:                         ObjectId,
+			SubscriptionId:                   SubscriptionId,
+			TenantId:                         TenantId,
+		},
+	}
+
+	if err := client.Build(ctx, e.ClientOptions()); err != nil {
+		return nil, fmt.Errorf("building Client for the Emulator: %+v", err)
+	}
+
+	return &client, nil
+}
+
+// Provider returns the Provider configured to send requests to this Emulator, rather than Azure - which
+// is configured as usual (see ProviderConfig), with the Endpoints overridden to point at the Emulator
+func (e *Emulator) Provider() *schema.Provider {
+	return provider.TestAzureProviderWithEnvironment(e.Environment()).(*schema.Provider)
+}
+
+// ProviderConfig returns a Provider block which authenticates with this Emulator using a Client Secret,
+// including any additional configuration (e.g. the `features` block)
+func (e *Emulator) ProviderConfig(additional string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  subscription_id = %q
+  tenant_id       = %q
+  client_id       = %q
+  client_secret   = "emulator"
+
+  %s
+}
+`, SubscriptionId, TenantId, ClientId, additional)
+}
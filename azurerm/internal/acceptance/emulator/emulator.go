@@ -0,0 +1,387 @@
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+)
+
+const (
+	// SubscriptionId is the ID of the Subscription exposed by the Emulator
+	SubscriptionId = "11111111-1111-1111-1111-111111111111"
+
+	// TenantId is the ID of the Tenant used by Clients connecting to the Emulator
+	TenantId = "22222222-2222-2222-2222-222222222222"
+
+	// ClientId is the ID of the Client used by Clients connecting to the Emulator
+	ClientId = "33333333-3333-3333-3333-333333333333"
+
+	// ObjectId is the Object ID of the principal used by Clients connecting to the Emulator
+	ObjectId = "44444444-4444-4444-4444-444444444444"
+)
+
+// Emulator is a minimal in-memory implementation of Azure Resource Manager, which allows the
+// Clients (and the Provider) to be tested end-to-end without access to an Azure Subscription.
+//
+// The Emulator supports generic Create/Read/Update/Delete operations for any Resource ID within the
+// Subscription, Long Running Operations (using the `Azure-AsyncOperation` header), invoking Actions
+// on resources, listing and registering Resource Providers, issuing access tokens (so that the Provider
+// can authenticate using a Client Secret) and injecting failures (for example to test retries).
+type Emulator struct {
+	server *httptest.Server
+
+	lock               sync.Mutex
+	resources          map[string]map[string]interface{}
+	providers          map[string]*resourceProvider
+	actions            map[string]ActionFunc
+	operations         map[string]*operation
+	faults             []*Fault
+	requests           []Request
+	longRunning        bool
+	pollsUntilComplete int
+	operationCount     int
+}
+
+// Request is a request which was received by the Emulator
+type Request struct {
+	Method string
+	Path   string
+}
+
+// New starts a new Emulator - the Caller is responsible for calling Close once it's no longer needed
+func New() *Emulator {
+	e := &Emulator{
+		resources:  make(map[string]map[string]interface{}),
+		providers:  make(map[string]*resourceProvider),
+		actions:    make(map[string]ActionFunc),
+		operations: make(map[string]*operation),
+	}
+	e.server = httptest.NewServer(e)
+	return e
+}
+
+// Close stops the Emulator
+func (e *Emulator) Close() {
+	e.server.Close()
+}
+
+// Endpoint returns the Resource Manager Endpoint for this Emulator
+func (e *Emulator) Endpoint() string {
+	return e.server.URL
+}
+
+// EnableLongRunningOperations configures the Emulator to complete PUT, PATCH and DELETE requests
+// as Long Running Operations, which are polled the specified number of times before completing
+func (e *Emulator) EnableLongRunningOperations(pollsUntilComplete int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.longRunning = true
+	e.pollsUntilComplete = pollsUntilComplete
+}
+
+// Requests returns the requests received by the Emulator, in the order they were received
+func (e *Emulator) Requests() []Request {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	output := make([]Request, len(e.requests))
+	copy(output, e.requests)
+	return output
+}
+
+// RequestCount returns the number of requests received by the Emulator using the specified
+// method for the specified path (which is compared case-insensitively)
+func (e *Emulator) RequestCount(method, path string) int {
+	count := 0
+	for _, v := range e.Requests() {
+		if strings.EqualFold(v.Method, method) && strings.EqualFold(v.Path, normalizePath(path)) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Exists returns whether the resource with the specified ID exists within the Emulator
+func (e *Emulator) Exists(id string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	_, ok := e.resources[resourceKey(id)]
+	return ok
+}
+
+// Put creates (or replaces) the resource with the specified ID within the Emulator, for example
+// to create a resource which exists outside of Terraform
+func (e *Emulator) Put(id string, body map[string]interface{}) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.resources[resourceKey(id)] = normalizeResource(normalizePath(id), body)
+}
+
+// ServeHTTP handles a request made to the Emulator
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("reading the request body: %+v", err))
+		return
+	}
+
+	path := normalizePath(r.URL.Path)
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.requests = append(e.requests, Request{
+		Method: r.Method,
+		Path:   path,
+	})
+
+	if fault := e.matchingFault(r.Method, path); fault != nil {
+		writeError(w, fault.StatusCode, fault.Code, fmt.Sprintf("%s %s failed due to an injected fault", r.Method, path))
+		return
+	}
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	switch {
+	case len(segments) == 3 && strings.EqualFold(segments[0], "emulator") && strings.EqualFold(segments[1], "operations"):
+		e.getOperation(w, r, segments[2])
+		return
+
+	case isAuthPath(segments):
+		e.handleAuth(w, r, segments, body)
+		return
+
+	case len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions"):
+		writeError(w, http.StatusNotFound, "NotFound", fmt.Sprintf("the path %q isn't supported by the Emulator", path))
+		return
+
+	case !strings.EqualFold(segments[1], SubscriptionId):
+		writeError(w, http.StatusNotFound, "SubscriptionNotFound", fmt.Sprintf("the Subscription %q was not found", segments[1]))
+		return
+
+	case isResourceProviderPath(segments):
+		e.handleResourceProvider(w, r, segments)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		e.get(w, path, segments)
+
+	case http.MethodHead:
+		e.head(w, path)
+
+	case http.MethodPut:
+		e.put(w, r, path, body)
+
+	case http.MethodPatch:
+		e.patch(w, r, path, body)
+
+	case http.MethodDelete:
+		e.delete(w, r, path)
+
+	case http.MethodPost:
+		e.post(w, path, segments, body)
+
+	default:
+		writeError(w, http.StatusBadRequest, "UnsupportedByEmulator", fmt.Sprintf("%s %s isn't supported by the Emulator", r.Method, path))
+	}
+}
+
+func (e *Emulator) get(w http.ResponseWriter, path string, segments []string) {
+	if existing, ok := e.resources[resourceKey(path)]; ok {
+		writeJSON(w, http.StatusOK, existing)
+		return
+	}
+
+	if isCollection(segments) {
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": e.list(path, segments),
+		})
+		return
+	}
+
+	writeNotFound(w, path)
+}
+
+func (e *Emulator) head(w http.ResponseWriter, path string) {
+	if _, ok := e.resources[resourceKey(path)]; ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func (e *Emulator) put(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	parsed := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("parsing the request body: %+v", err))
+			return
+		}
+	}
+
+	if namespace := resourceProviderNamespace(path); namespace != "" {
+		if provider, ok := e.providers[strings.ToLower(namespace)]; ok && !provider.registered {
+			// the Namespace is returned as the target, which is used to automatically register the Resource Provider
+			writeJSON(w, http.StatusConflict, map[string]interface{}{
+				"error": map[string]interface{}{
+					"code":    "MissingSubscriptionRegistration",
+					"message": fmt.Sprintf("The subscription is not registered to use namespace '%s'.", provider.namespace),
+					"details": []interface{}{
+						map[string]interface{}{
+							"code":   "MissingSubscriptionRegistration",
+							"target": provider.namespace,
+						},
+					},
+				},
+			})
+			return
+		}
+	}
+
+	if parent := parentId(path); parent != "" {
+		if _, ok := e.resources[resourceKey(parent)]; !ok {
+			writeNotFound(w, parent)
+			return
+		}
+	}
+
+	statusCode := http.StatusCreated
+	if existing, ok := e.resources[resourceKey(path)]; ok {
+		statusCode = http.StatusOK
+		// the casing of the ID is retained from when the resource was created
+		path = existing["id"].(string)
+	}
+
+	resource := normalizeResource(path, parsed)
+	e.resources[resourceKey(path)] = resource
+	e.writeResult(w, r, statusCode, resource)
+}
+
+func (e *Emulator) patch(w http.ResponseWriter, r *http.Request, path string, body []byte) {
+	existing, ok := e.resources[resourceKey(path)]
+	if !ok {
+		writeNotFound(w, path)
+		return
+	}
+
+	parsed := make(map[string]interface{})
+	if len(body) > 0 {
+		if err := json.Unmarshal(body, &parsed); err != nil {
+			writeError(w, http.StatusBadRequest, "InvalidRequestContent", fmt.Sprintf("parsing the request body: %+v", err))
+			return
+		}
+	}
+
+	for k, v := range parsed {
+		existingProperties, existingOk := existing[k].(map[string]interface{})
+		properties, ok := v.(map[string]interface{})
+		if k == "properties" && existingOk && ok {
+			for pk, pv := range properties {
+				existingProperties[pk] = pv
+			}
+			continue
+		}
+
+		existing[k] = v
+	}
+
+	resource := normalizeResource(existing["id"].(string), existing)
+	e.resources[resourceKey(path)] = resource
+	e.writeResult(w, r, http.StatusOK, resource)
+}
+
+func (e *Emulator) delete(w http.ResponseWriter, r *http.Request, path string) {
+	if _, ok := e.resources[resourceKey(path)]; !ok {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// deleting a resource also deletes any resources nested within it
+	key := resourceKey(path)
+	for k := range e.resources {
+		if k == key || strings.HasPrefix(k, key+"/") {
+			delete(e.resources, k)
+		}
+	}
+
+	if e.longRunning {
+		e.writeResult(w, r, http.StatusAccepted, nil)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// list returns the resources within the specified collection
+func (e *Emulator) list(path string, segments []string) []interface{} {
+	// `/resourceGroups/{name}/resources` lists all of the resources within the Resource Group
+	listAll := len(segments) == 5 && strings.EqualFold(segments[2], "resourceGroups") && strings.EqualFold(segments[4], "resources")
+	prefix := resourceKey(path) + "/"
+	if listAll {
+		prefix = resourceKey(strings.Join(segments[:4], "/")) + "/providers/"
+	}
+
+	output := make([]interface{}, 0)
+	for k, v := range e.resources {
+		if !strings.HasPrefix(k, prefix) {
+			continue
+		}
+
+		if listAll || !strings.Contains(strings.TrimPrefix(k, prefix), "/") {
+			output = append(output, v)
+		}
+	}
+
+	return output
+}
+
+// writeResult writes the result of a PUT/PATCH/DELETE, which is completed as a
+// Long Running Operation when these are enabled
+func (e *Emulator) writeResult(w http.ResponseWriter, r *http.Request, statusCode int, body map[string]interface{}) {
+	if e.longRunning {
+		operationId := e.startOperation()
+		w.Header().Set("Azure-AsyncOperation", fmt.Sprintf("http://%s/emulator/operations/%s", r.Host, operationId))
+		w.Header().Set("Retry-After", "0")
+	}
+
+	if body == nil {
+		w.WriteHeader(statusCode)
+		return
+	}
+
+	writeJSON(w, statusCode, body)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(body) // nolint: errcheck
+}
+
+func writeError(w http.ResponseWriter, statusCode int, code, message string) {
+	writeJSON(w, statusCode, map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    code,
+			"message": message,
+		},
+	})
+}
+
+func writeNotFound(w http.ResponseWriter, path string) {
+	code := "ResourceNotFound"
+	if resourceType(path) == "Microsoft.Resources/resourceGroups" {
+		code = "ResourceGroupNotFound"
+	}
+
+	writeError(w, http.StatusNotFound, code, fmt.Sprintf("The Resource %q was not found.", path))
+}
@@ -0,0 +1,606 @@
+package emulator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestEmulator_ResourceGroupLifecycle(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	groupsClient := client.Resource.GroupsClient
+
+	existing, err := groupsClient.Get(ctx, "example")
+	if err == nil || !utils.ResponseWasNotFound(existing.Response) {
+		t.Fatalf("expected the Resource Group not to exist but got %+v", err)
+	}
+
+	if _, err := groupsClient.CreateOrUpdate(ctx, "example", resources.Group{
+		Location: utils.String("westeurope"),
+		Tags: map[string]*string{
+			"environment": utils.String("test"),
+		},
+	}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+
+	group, err := groupsClient.Get(ctx, "EXAMPLE")
+	if err != nil {
+		t.Fatalf("retrieving Resource Group: %+v", err)
+	}
+	expectedId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	if group.ID == nil || *group.ID != expectedId {
+		t.Fatalf("expected the ID to be %q but got %v", expectedId, group.ID)
+	}
+	if group.Location == nil || *group.Location != "westeurope" {
+		t.Fatalf("expected the Location to be `westeurope` but got %v", group.Location)
+	}
+	if v := group.Tags["environment"]; v == nil || *v != "test" {
+		t.Fatalf("expected the Tag `environment` to be `test` but got %v", v)
+	}
+
+	list, err := groupsClient.List(ctx, "", nil)
+	if err != nil {
+		t.Fatalf("listing Resource Groups: %+v", err)
+	}
+	if len(list.Values()) != 1 {
+		t.Fatalf("expected 1 Resource Group but got %d", len(list.Values()))
+	}
+
+	future, err := groupsClient.Delete(ctx, "example", "")
+	if err != nil {
+		t.Fatalf("deleting Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("waiting for deletion of Resource Group: %+v", err)
+	}
+
+	if e.Exists(expectedId) {
+		t.Fatalf("expected the Resource Group to have been deleted")
+	}
+}
+
+func TestEmulator_NestedResources(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	resourcesClient := client.Resource.ResourcesClient
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	parentId := groupId + "/providers/Microsoft.Emulator/parents/parent1"
+	childId := parentId + "/children/child1"
+
+	// the Parent doesn't exist yet, so the Child can't be created
+	if _, err := resourcesClient.CreateOrUpdateByID(ctx, childId, "2020-01-01", resources.GenericResource{}); err == nil {
+		t.Fatalf("expected an error creating a resource when the parent doesn't exist")
+	}
+
+	e.Put(groupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	for _, id := range []string{parentId, childId} {
+		future, err := resourcesClient.CreateOrUpdateByID(ctx, id, "2020-01-01", resources.GenericResource{
+			Location: utils.String("westeurope"),
+		})
+		if err != nil {
+			t.Fatalf("creating %q: %+v", id, err)
+		}
+		if err := future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
+			t.Fatalf("waiting for creation of %q: %+v", id, err)
+		}
+	}
+
+	child, err := resourcesClient.GetByID(ctx, childId, "2020-01-01")
+	if err != nil {
+		t.Fatalf("retrieving %q: %+v", childId, err)
+	}
+	if child.Type == nil || *child.Type != "Microsoft.Emulator/parents/children" {
+		t.Fatalf("expected the Type to be `Microsoft.Emulator/parents/children` but got %v", child.Type)
+	}
+
+	list, err := resourcesClient.ListByResourceGroup(ctx, "example", "", "", nil)
+	if err != nil {
+		t.Fatalf("listing resources: %+v", err)
+	}
+	if len(list.Values()) != 2 {
+		t.Fatalf("expected 2 resources but got %d", len(list.Values()))
+	}
+
+	// deleting the Parent also deletes the Child
+	future, err := resourcesClient.DeleteByID(ctx, parentId, "2020-01-01")
+	if err != nil {
+		t.Fatalf("deleting %q: %+v", parentId, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
+		t.Fatalf("waiting for deletion of %q: %+v", parentId, err)
+	}
+	if e.Exists(childId) {
+		t.Fatalf("expected %q to have been deleted", childId)
+	}
+}
+
+func TestEmulator_LongRunningOperations(t *testing.T) {
+	e := New()
+	defer e.Close()
+	e.EnableLongRunningOperations(2)
+	e.Put(fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId), map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	groupsClient := client.Resource.GroupsClient
+
+	future, err := groupsClient.Delete(ctx, "example", "")
+	if err != nil {
+		t.Fatalf("deleting Resource Group: %+v", err)
+	}
+	if err := future.WaitForCompletionRef(ctx, groupsClient.Client); err != nil {
+		t.Fatalf("waiting for deletion of Resource Group: %+v", err)
+	}
+
+	// the operation is polled twice whilst in progress, then once more when it's completed
+	if count := e.RequestCount(http.MethodGet, "/emulator/operations/operation-1"); count != 3 {
+		t.Fatalf("expected the operation to be polled 3 times but got %d", count)
+	}
+}
+
+func TestEmulator_ResourceProviderRegistration(t *testing.T) {
+	e := New()
+	defer e.Close()
+	e.AddResourceProvider("Microsoft.Registered", true)
+	e.AddResourceProvider("Microsoft.Unregistered", false)
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	providersClient := client.Resource.ProvidersClient
+
+	providers, err := providersClient.List(ctx, nil, "")
+	if err != nil {
+		t.Fatalf("listing Resource Providers: %+v", err)
+	}
+	if len(providers.Values()) != 2 {
+		t.Fatalf("expected 2 Resource Providers but got %d", len(providers.Values()))
+	}
+
+	required := map[string]struct{}{
+		"Microsoft.Registered":   {},
+		"Microsoft.Unregistered": {},
+	}
+	if err := resourceproviders.EnsureRegistered(ctx, *providersClient, providers.Values(), required); err != nil {
+		t.Fatalf("registering Resource Providers: %+v", err)
+	}
+
+	if !e.IsResourceProviderRegistered("Microsoft.Unregistered") {
+		t.Fatalf("expected `Microsoft.Unregistered` to have been registered")
+	}
+	if count := e.RequestCount(http.MethodPost, fmt.Sprintf("/subscriptions/%s/providers/Microsoft.Registered/register", SubscriptionId)); count != 0 {
+		t.Fatalf("expected `Microsoft.Registered` not to be registered again but got %d requests", count)
+	}
+}
+
+func TestEmulator_AutomaticResourceProviderRegistration(t *testing.T) {
+	e := New()
+	defer e.Close()
+	e.AddResourceProvider("Microsoft.Emulator", false)
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	e.Put(groupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+	resourcesClient := client.Resource.ResourcesClient
+
+	// the Resource Provider is registered automatically by autorest when the request fails
+	id := groupId + "/providers/Microsoft.Emulator/things/thing1"
+	future, err := resourcesClient.CreateOrUpdate(ctx, "example", "Microsoft.Emulator", "", "things", "thing1", "2020-01-01", resources.GenericResource{
+		Location: utils.String("westeurope"),
+	})
+	if err != nil {
+		t.Fatalf("creating %q: %+v", id, err)
+	}
+	if err := future.WaitForCompletionRef(ctx, resourcesClient.Client); err != nil {
+		t.Fatalf("waiting for creation of %q: %+v", id, err)
+	}
+
+	if !e.IsResourceProviderRegistered("Microsoft.Emulator") {
+		t.Fatalf("expected `Microsoft.Emulator` to have been registered")
+	}
+	if !e.Exists(id) {
+		t.Fatalf("expected %q to exist", id)
+	}
+}
+
+func TestEmulator_Retries(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/example", SubscriptionId)
+	e.InjectFault(Fault{
+		Method:     http.MethodPut,
+		Path:       groupId,
+		StatusCode: http.StatusConflict,
+		Code:       "AnotherOperationInProgress",
+		Times:      2,
+	})
+
+	ctx := context.TODO()
+	client, err := e.Client(ctx)
+	if err != nil {
+		t.Fatalf("building client: %+v", err)
+	}
+
+	if _, err := client.Resource.GroupsClient.CreateOrUpdate(ctx, "example", resources.Group{
+		Location: utils.String("westeurope"),
+	}); err != nil {
+		t.Fatalf("creating Resource Group: %+v", err)
+	}
+
+	if count := e.RequestCount(http.MethodPut, groupId); count != 3 {
+		t.Fatalf("expected 3 attempts to create the Resource Group but got %d", count)
+	}
+}
+
+func TestEmulator_ProviderRequiresImport(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	azurerm := e.Provider()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-emulator", SubscriptionId)
+	config := e.ProviderConfig("features {}") + `
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-emulator"
+  location = "westeurope"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": azurerm,
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if e.Exists(groupId) {
+				return fmt.Errorf("%q still exists", groupId)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource_group.test", "id", groupId),
+					func(_ *terraform.State) error {
+						if !e.Exists(groupId) {
+							return fmt.Errorf("%q does not exist", groupId)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config + `
+resource "azurerm_resource_group" "import" {
+  name     = azurerm_resource_group.test.name
+  location = azurerm_resource_group.test.location
+}
+`,
+				ExpectError: regexp.MustCompile("to be managed via Terraform this resource needs to be imported into the State"),
+			},
+		},
+	})
+}
+
+func TestEmulator_ProviderConfiguration(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	azurerm := e.Provider()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-emulator", SubscriptionId)
+	config := e.ProviderConfig(`
+  features {}
+
+  default_tags = {
+    source = "provider"
+  }
+`) + `
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-emulator"
+  location = "westeurope"
+}
+`
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": azurerm,
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource_group.test", "id", groupId),
+					resource.TestCheckResourceAttr("azurerm_resource_group.test", "tags_all.%", "1"),
+					resource.TestCheckResourceAttr("azurerm_resource_group.test", "tags_all.source", "provider"),
+					func(_ *terraform.State) error {
+						// the Provider authenticates using the Client Secret, as it would against Azure
+						if count := e.RequestCount(http.MethodPost, fmt.Sprintf("/%s/oauth2/token", TenantId)); count == 0 {
+							return fmt.Errorf("expected the Provider to request an access token")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestEmulator_ProviderGenericResource(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	azurerm := e.Provider()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-emulator", SubscriptionId)
+	e.Put(groupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	id := groupId + "/providers/Microsoft.Emulator/things/thing1"
+	config := func(apiVersion, environment string) string {
+		return e.ProviderConfig("features {}") + fmt.Sprintf(`
+
+resource "azurerm_resource" "test" {
+  name      = "thing1"
+  parent_id = %q
+  type      = "Microsoft.Emulator/things@%s"
+  body = jsonencode({
+    location = "westeurope"
+    tags = {
+      environment = %q
+    }
+  })
+}
+`, groupId, apiVersion, environment)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": azurerm,
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if e.Exists(id) {
+				return fmt.Errorf("%q still exists", id)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config("2020-01-01", "test"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource.test", "id", id),
+					// the fields added by the API are returned in the `output` but not the `body`
+					resource.TestCheckResourceAttr("azurerm_resource.test", "body", `{"location":"westeurope","tags":{"environment":"test"}}`),
+					resource.TestMatchResourceAttr("azurerm_resource.test", "output", regexp.MustCompile(`"provisioningState":"Succeeded"`)),
+				),
+			},
+			{
+				// changing the API Version and the Body updates the resource in-place
+				Config: config("2021-01-01", "production"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource.test", "id", id),
+					resource.TestCheckResourceAttr("azurerm_resource.test", "type", "Microsoft.Emulator/things@2021-01-01"),
+					resource.TestMatchResourceAttr("azurerm_resource.test", "output", regexp.MustCompile(`"environment":"production"`)),
+					func(_ *terraform.State) error {
+						if count := e.RequestCount(http.MethodDelete, id); count != 0 {
+							return fmt.Errorf("expected %q to be updated in-place but it was deleted %d times", id, count)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// the Provider is configured using the Provider block within the Config
+				Config:            config("2021-01-01", "production"),
+				ResourceName:      "azurerm_resource.test",
+				ImportState:       true,
+				ImportStateId:     id + "?api-version=2021-01-01",
+				ImportStateVerify: true,
+				// when importing the `body` contains all of the fields returned from the API
+				ImportStateVerifyIgnore: []string{"body"},
+			},
+		},
+	})
+}
+
+func TestEmulator_ProviderResourceAction(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	azurerm := e.Provider()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-emulator", SubscriptionId)
+	id := groupId + "/providers/Microsoft.Emulator/things/thing1"
+	e.Put(groupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	e.Put(id, map[string]interface{}{
+		"location": "westeurope",
+	})
+
+	regenerated := 0
+	e.HandleAction("regenerateKey", func(_ map[string]interface{}, body map[string]interface{}) map[string]interface{} {
+		regenerated++
+		return map[string]interface{}{
+			"keyName": body["keyName"],
+			"value":   fmt.Sprintf("key%d", regenerated),
+		}
+	})
+	e.HandleAction("listKeys", func(_ map[string]interface{}, _ map[string]interface{}) map[string]interface{} {
+		return map[string]interface{}{
+			"primaryKey": fmt.Sprintf("key%d", regenerated),
+		}
+	})
+
+	config := func(rotation int) string {
+		return e.ProviderConfig("features {}") + fmt.Sprintf(`
+
+resource "azurerm_resource_action" "test" {
+  resource_id = %q
+  action      = "regenerateKey"
+  type        = "Microsoft.Emulator/things@2020-01-01"
+  body = jsonencode({
+    keyName = "primary"
+  })
+
+  triggers = {
+    rotation = "%d"
+  }
+}
+
+data "azurerm_resource_action" "test" {
+  resource_id = azurerm_resource_action.test.resource_id
+  action      = "listKeys"
+  type        = "Microsoft.Emulator/things@2020-01-01"
+}
+`, id, rotation)
+	}
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": azurerm,
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource_action.test", "id", id+"/regenerateKey"),
+					resource.TestCheckResourceAttr("azurerm_resource_action.test", "output", `{"keyName":"primary","value":"key1"}`),
+					resource.TestCheckResourceAttr("data.azurerm_resource_action.test", "output", `{"primaryKey":"key1"}`),
+				),
+			},
+			{
+				// changing the triggers invokes the Action again
+				Config: config(2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("azurerm_resource_action.test", "output", `{"keyName":"primary","value":"key2"}`),
+					func(_ *terraform.State) error {
+						if regenerated != 2 {
+							return fmt.Errorf("expected the Action to be invoked 2 times but got %d", regenerated)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+
+	if !e.Exists(id) {
+		t.Fatalf("expected %q to still exist once the Action has been removed", id)
+	}
+}
+
+func TestEmulator_ProviderGenericResourceDataSource(t *testing.T) {
+	e := New()
+	defer e.Close()
+
+	azurerm := e.Provider()
+
+	groupId := fmt.Sprintf("/subscriptions/%s/resourceGroups/acctestRG-emulator", SubscriptionId)
+	id := groupId + "/providers/Microsoft.Emulator/things/thing1"
+	e.Put(groupId, map[string]interface{}{
+		"location": "westeurope",
+	})
+	e.Put(id, map[string]interface{}{
+		"location": "westeurope",
+		"properties": map[string]interface{}{
+			"capacity": 3,
+			"enabled":  true,
+			"items": []interface{}{
+				map[string]interface{}{"name": "first"},
+				map[string]interface{}{"name": "second"},
+			},
+		},
+	})
+
+	resource.UnitTest(t, resource.TestCase{
+		Providers: map[string]terraform.ResourceProvider{
+			"azurerm": azurerm,
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: e.ProviderConfig("features {}") + fmt.Sprintf(`
+
+data "azurerm_resource" "test" {
+  resource_id = %q
+  type        = "Microsoft.Emulator/things@2020-01-01"
+
+  query {
+    path = "location"
+  }
+
+  query {
+    path = "properties.capacity"
+  }
+
+  query {
+    path = "properties.enabled"
+  }
+
+  query {
+    path = "properties.items[*].name"
+  }
+
+  query {
+    path = "properties.doesNotExist"
+  }
+}
+`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "id", id),
+					resource.TestMatchResourceAttr("data.azurerm_resource.test", "output", regexp.MustCompile(`"provisioningState":"Succeeded"`)),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.0.type", "string"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.0.string_value", "westeurope"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.1.type", "number"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.1.number_value", "3"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.2.type", "bool"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.2.bool_value", "true"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.3.type", "array"),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.3.json_value", `["first","second"]`),
+					resource.TestCheckResourceAttr("data.azurerm_resource.test", "query.4.type", "null"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,52 @@
+package emulator
+
+import (
+	"strings"
+)
+
+// Fault defines requests which the Emulator should fail, for example to test that they're retried
+type Fault struct {
+	// Method is the HTTP Method of the requests which should fail, or an empty string for all methods
+	Method string
+
+	// Path is the path of the requests which should fail (compared case-insensitively), or an empty string for all paths
+	Path string
+
+	// StatusCode is the HTTP Status Code which should be returned
+	StatusCode int
+
+	// Code is the Error Code which should be returned, for example `AnotherOperationInProgress`
+	Code string
+
+	// Times is the number of matching requests which should fail
+	Times int
+}
+
+// InjectFault configures the Emulator to fail matching requests
+func (e *Emulator) InjectFault(fault Fault) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.faults = append(e.faults, &fault)
+}
+
+// matchingFault returns the Fault which should be returned for this request, if any
+// NOTE: this must be called with the lock held
+func (e *Emulator) matchingFault(method, path string) *Fault {
+	for _, v := range e.faults {
+		if v.Times <= 0 {
+			continue
+		}
+		if v.Method != "" && !strings.EqualFold(v.Method, method) {
+			continue
+		}
+		if v.Path != "" && resourceKey(v.Path) != resourceKey(path) {
+			continue
+		}
+
+		v.Times--
+		return v
+	}
+
+	return nil
+}
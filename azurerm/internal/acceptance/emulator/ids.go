@@ -0,0 +1,130 @@
+package emulator
+
+import (
+	"strings"
+)
+
+// normalizePath returns the path without any empty segments (e.g. from a double slash) prefixed with a
+// single slash, and with the casing of the well-known segments (e.g. `resourceGroups`) normalized
+func normalizePath(input string) string {
+	segments := make([]string, 0)
+	for _, v := range strings.Split(input, "/") {
+		if v == "" {
+			continue
+		}
+
+		for _, known := range []string{"subscriptions", "resourceGroups", "providers"} {
+			if len(segments)%2 == 0 && strings.EqualFold(v, known) {
+				v = known
+			}
+		}
+		segments = append(segments, v)
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
+
+// resourceKey returns the key for the specified Resource ID - since Resource IDs are case-insensitive
+func resourceKey(id string) string {
+	return strings.ToLower(normalizePath(id))
+}
+
+// normalizeResource returns the resource with the computed fields (e.g. the ID, Name and Type) populated
+func normalizeResource(id string, input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input)+3)
+	for k, v := range input {
+		output[k] = v
+	}
+
+	segments := strings.Split(strings.TrimPrefix(id, "/"), "/")
+	output["id"] = id
+	output["name"] = segments[len(segments)-1]
+	output["type"] = resourceType(id)
+
+	properties, ok := output["properties"].(map[string]interface{})
+	if !ok {
+		properties = make(map[string]interface{})
+	}
+	properties["provisioningState"] = "Succeeded"
+	output["properties"] = properties
+
+	return output
+}
+
+// isCollection returns whether the segments of the path refer to a collection of resources, rather than a
+// single resource. Resource IDs are made up of key/value pairs (other than `providers/{namespace}`)
+// so the path refers to a collection when there's an odd number of segments.
+func isCollection(segments []string) bool {
+	count := 0
+	for i := 0; i < len(segments); i++ {
+		if strings.EqualFold(segments[i], "providers") && i+1 < len(segments) {
+			i++
+			continue
+		}
+
+		count++
+	}
+
+	return count%2 == 1
+}
+
+// resourceType returns the Resource Type for the specified Resource ID, e.g. `Microsoft.Foo/bars`
+func resourceType(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+
+	namespace := ""
+	types := make([]string, 0)
+	lastKey := ""
+	for i := 0; i+1 < len(segments); i += 2 {
+		key := segments[i]
+		if strings.EqualFold(key, "providers") {
+			namespace = segments[i+1]
+			types = make([]string, 0)
+			continue
+		}
+
+		lastKey = key
+		if namespace != "" {
+			types = append(types, key)
+		}
+	}
+
+	if namespace == "" {
+		return "Microsoft.Resources/" + lastKey
+	}
+
+	return namespace + "/" + strings.Join(types, "/")
+}
+
+// resourceProviderNamespace returns the Resource Provider Namespace for the specified Resource ID, if any
+func resourceProviderNamespace(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	for i := 0; i+1 < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
+// parentId returns the ID of the parent resource (e.g. the Resource Group, or a parent resource for a nested
+// resource) which must exist for this resource to be created - or an empty string if there isn't one
+func parentId(id string) string {
+	segments := strings.Split(strings.Trim(id, "/"), "/")
+	if len(segments) < 4 {
+		return ""
+	}
+
+	segments = segments[:len(segments)-2]
+	if len(segments) >= 2 && strings.EqualFold(segments[len(segments)-2], "providers") {
+		segments = segments[:len(segments)-2]
+	}
+
+	// the Subscription is the parent of Resource Groups and Subscription-level resources
+	if len(segments) <= 2 {
+		return ""
+	}
+
+	return "/" + strings.Join(segments, "/")
+}
@@ -0,0 +1,79 @@
+package emulator
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceType(t *testing.T) {
+	testData := map[string]string{
+		"/subscriptions/sub1/resourceGroups/group1":                                                                           "Microsoft.Resources/resourceGroups",
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1":                                         "Microsoft.Foo/bars",
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs/baz1":                               "Microsoft.Foo/bars/bazs",
+		"/subscriptions/sub1/providers/Microsoft.Foo/bars/bar1":                                                               "Microsoft.Foo/bars",
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/providers/Microsoft.Baz/extensions/ext1": "Microsoft.Baz/extensions",
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Test Case: %q", input)
+
+		if actual := resourceType(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestIsCollection(t *testing.T) {
+	testData := map[string]bool{
+		"/subscriptions/sub1/resourceGroups":                                                  true,
+		"/subscriptions/sub1/resourceGroups/group1":                                           false,
+		"/subscriptions/sub1/resourceGroups/group1/resources":                                 true,
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars":              true,
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1":         false,
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs":    true,
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs/b1": false,
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Test Case: %q", input)
+
+		segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+		if actual := isCollection(segments); actual != expected {
+			t.Fatalf("Expected %t but got %t", expected, actual)
+		}
+	}
+}
+
+func TestParentId(t *testing.T) {
+	testData := map[string]string{
+		"/subscriptions/sub1/resourceGroups/group1":                                           "",
+		"/subscriptions/sub1/providers/Microsoft.Foo/bars/bar1":                               "",
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1":         "/subscriptions/sub1/resourceGroups/group1",
+		"/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1/bazs/b1": "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Test Case: %q", input)
+
+		if actual := parentId(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
+
+func TestNormalizePath(t *testing.T) {
+	testData := map[string]string{
+		"/subscriptions/sub1/resourcegroups/group1/":                                    "/subscriptions/sub1/resourceGroups/group1",
+		"//SUBSCRIPTIONS/sub1/resourceGroups/group1/PROVIDERS/Microsoft.Foo//bars/bar1": "/subscriptions/sub1/resourceGroups/group1/providers/Microsoft.Foo/bars/bar1",
+		// only the keys are normalized, not the values
+		"/subscriptions/sub1/resourceGroups/PROVIDERS": "/subscriptions/sub1/resourceGroups/PROVIDERS",
+	}
+
+	for input, expected := range testData {
+		t.Logf("[DEBUG] Test Case: %q", input)
+
+		if actual := normalizePath(input); actual != expected {
+			t.Fatalf("Expected %q but got %q", expected, actual)
+		}
+	}
+}
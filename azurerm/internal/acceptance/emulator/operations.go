@@ -0,0 +1,46 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type operation struct {
+	remainingPolls int
+}
+
+// startOperation starts a Long Running Operation, returning its ID
+// NOTE: this must be called with the lock held
+func (e *Emulator) startOperation() string {
+	e.operationCount++
+	id := fmt.Sprintf("operation-%d", e.operationCount)
+	e.operations[id] = &operation{
+		remainingPolls: e.pollsUntilComplete,
+	}
+	return id
+}
+
+func (e *Emulator) getOperation(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusBadRequest, "UnsupportedByEmulator", fmt.Sprintf("%s isn't supported for Operations", r.Method))
+		return
+	}
+
+	operation, ok := e.operations[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "OperationNotFound", fmt.Sprintf("The Operation %q was not found.", id))
+		return
+	}
+
+	status := "Succeeded"
+	if operation.remainingPolls > 0 {
+		operation.remainingPolls--
+		status = "InProgress"
+		w.Header().Set("Retry-After", "0")
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"name":   id,
+		"status": status,
+	})
+}
@@ -0,0 +1,101 @@
+package emulator
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+type resourceProvider struct {
+	namespace  string
+	registered bool
+}
+
+// AddResourceProvider adds a Resource Provider to the Subscription exposed by the Emulator. Resources
+// can't be created within a Resource Provider which isn't registered - however Resource Providers
+// which haven't been added to the Emulator are treated as registered.
+func (e *Emulator) AddResourceProvider(namespace string, registered bool) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.providers[strings.ToLower(namespace)] = &resourceProvider{
+		namespace:  namespace,
+		registered: registered,
+	}
+}
+
+// IsResourceProviderRegistered returns whether the specified Resource Provider is registered
+func (e *Emulator) IsResourceProviderRegistered(namespace string) bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	provider, ok := e.providers[strings.ToLower(namespace)]
+	return !ok || provider.registered
+}
+
+// isResourceProviderPath returns whether the path is used to list, retrieve or register Resource Providers, e.g.
+// `/subscriptions/{id}/providers`, `/subscriptions/{id}/providers/{namespace}` or `.../providers/{namespace}/register`
+func isResourceProviderPath(segments []string) bool {
+	if len(segments) < 3 || !strings.EqualFold(segments[2], "providers") {
+		return false
+	}
+
+	return len(segments) <= 4 || (len(segments) == 5 && strings.EqualFold(segments[4], "register"))
+}
+
+func (e *Emulator) handleResourceProvider(w http.ResponseWriter, r *http.Request, segments []string) {
+	if len(segments) == 3 {
+		if r.Method != http.MethodGet {
+			writeError(w, http.StatusBadRequest, "UnsupportedByEmulator", fmt.Sprintf("%s isn't supported for Resource Providers", r.Method))
+			return
+		}
+
+		namespaces := make([]string, 0)
+		for k := range e.providers {
+			namespaces = append(namespaces, k)
+		}
+		sort.Strings(namespaces)
+
+		values := make([]interface{}, 0)
+		for _, v := range namespaces {
+			values = append(values, e.providers[v].toResponse())
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{
+			"value": values,
+		})
+		return
+	}
+
+	provider, ok := e.providers[strings.ToLower(segments[3])]
+	if !ok {
+		writeError(w, http.StatusNotFound, "InvalidResourceNamespace", fmt.Sprintf("The resource namespace '%s' is invalid.", segments[3]))
+		return
+	}
+
+	switch {
+	case len(segments) == 4 && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, provider.toResponse())
+
+	case len(segments) == 5 && r.Method == http.MethodPost:
+		provider.registered = true
+		writeJSON(w, http.StatusOK, provider.toResponse())
+
+	default:
+		writeError(w, http.StatusBadRequest, "UnsupportedByEmulator", fmt.Sprintf("%s isn't supported for Resource Providers", r.Method))
+	}
+}
+
+func (p resourceProvider) toResponse() map[string]interface{} {
+	registrationState := "NotRegistered"
+	if p.registered {
+		registrationState = "Registered"
+	}
+
+	return map[string]interface{}{
+		"id":                fmt.Sprintf("/subscriptions/%s/providers/%s", SubscriptionId, p.namespace),
+		"namespace":         p.namespace,
+		"registrationState": registrationState,
+		"resourceTypes":     []interface{}{},
+	}
+}
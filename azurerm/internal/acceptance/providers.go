@@ -5,8 +5,10 @@ import (
 	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azuread/azuread"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 var once sync.Once
@@ -16,7 +18,13 @@ func EnsureProvidersAreInitialised() {
 	os.Setenv("TF_ACCTEST_REATTACH", "1")
 
 	once.Do(func() {
-		acctest.UseBinaryDriver("azurerm", provider.TestAzureProvider)
+		acctest.UseBinaryDriver("azurerm", azureRMProvider)
 		acctest.UseBinaryDriver("azuread", azuread.Provider)
 	})
 }
+
+// azureRMProvider returns the AzureRM Provider used in the Acceptance Tests, which records (or replays)
+// the requests made to Azure when the `ARM_TEST_RECORDING_MODE` Environment Variable is set
+func azureRMProvider() terraform.ResourceProvider {
+	return provider.TestAzureProviderWithRecordingMode(recording.CurrentMode())
+}
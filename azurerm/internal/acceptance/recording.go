@@ -0,0 +1,66 @@
+package acceptance
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
+)
+
+// testRecorder records (or replays) the random values used in a test, when a recording is in progress
+type testRecorder struct {
+	t        *testing.T
+	recorder *recording.Recorder
+}
+
+// startRecording starts recording (or replaying) the requests made during this test
+// when the `ARM_TEST_RECORDING_MODE` Environment Variable is set
+func startRecording(t *testing.T) testRecorder {
+	if recording.CurrentMode() == recording.ModeLive {
+		return testRecorder{t: t}
+	}
+
+	recorder, err := recording.Start(t.Name())
+	if err != nil {
+		t.Fatalf("starting the recording: %+v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := recorder.Stop(); err != nil {
+			t.Errorf("stopping the recording: %+v", err)
+		}
+	})
+
+	return testRecorder{
+		t:        t,
+		recorder: recorder,
+	}
+}
+
+// recordedString returns the generated value, which is recorded (or replayed) when a recording is in progress
+func (r testRecorder) recordedString(name string, generate func() string) string {
+	if r.recorder == nil {
+		return generate()
+	}
+
+	value, err := r.recorder.Variable(name, generate)
+	if err != nil {
+		r.t.Fatalf("retrieving the recorded value for %q: %+v", name, err)
+	}
+
+	return value
+}
+
+// recordedInt returns the generated value, which is recorded (or replayed) when a recording is in progress
+func (r testRecorder) recordedInt(name string, generate func() int) int {
+	value := r.recordedString(name, func() string {
+		return strconv.Itoa(generate())
+	})
+
+	i, err := strconv.Atoi(value)
+	if err != nil {
+		r.t.Fatalf("parsing the recorded value %q for %q as an integer: %+v", value, name, err)
+	}
+
+	return i
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/helpers"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/testclient"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/types"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/provider"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 // lintignore:AT001
@@ -88,11 +88,16 @@ func (td TestData) runAcceptanceTest(t *testing.T, testCase resource.TestCase) {
 			return aad, nil
 		},
 		"azurerm": func() (terraform.ResourceProvider, error) {
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
+			return azureRMProvider(), nil
 		},
 	}
 
+	// only one test can be recorded/replayed at a time, so these tests can't be run in parallel
+	if recording.CurrentMode() != recording.ModeLive {
+		resource.Test(t, testCase)
+		return
+	}
+
 	resource.ParallelTest(t, testCase)
 }
 
@@ -103,8 +108,7 @@ func (td TestData) runAcceptanceSequentialTest(t *testing.T, testCase resource.T
 			return aad, nil
 		},
 		"azurerm": func() (terraform.ResourceProvider, error) {
-			azurerm := provider.TestAzureProvider()
-			return azurerm, nil
+			return azureRMProvider(), nil
 		},
 	}
 
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 var (
@@ -47,6 +48,7 @@ func Build() (*clients.Client, error) {
 			SkipProviderRegistration: true,
 			TerraformVersion:         os.Getenv("TERRAFORM_CORE_VERSION"),
 			Features:                 features.Default(),
+			RecordingMode:            recording.CurrentMode(),
 			StorageUseAzureAD:        false,
 		}
 		client, err := clients.Build(context.TODO(), clientBuilder)
@@ -10,9 +10,21 @@ import (
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 )
 
 func PreCheck(t *testing.T) {
+	if recording.CurrentMode() == recording.ModeReplay {
+		// no requests are sent to Azure when replaying, so placeholder credentials are used
+		// and the locations are those which were recorded
+		for variable, value := range recording.PlaceholderCredentials() {
+			if os.Getenv(variable) == "" {
+				os.Setenv(variable, value)
+			}
+		}
+		return
+	}
+
 	variables := []string{
 		"ARM_CLIENT_ID",
 		"ARM_CLIENT_SECRET",
@@ -4,8 +4,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/Azure/azure-sdk-for-go/services/graphrbac/1.6/graphrbac"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
+	"github.com/hashicorp/go-azure-helpers/sender"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 )
 
 type ResourceManagerAccount struct {
@@ -13,12 +16,13 @@ type ResourceManagerAccount struct {
 	ClientId                         string
 	Environment                      azure.Environment
 	ObjectId                         string
+	ResourceProviderRegistrations    common.ResourceProviderRegistrations
 	SkipResourceProviderRegistration bool
 	SubscriptionId                   string
 	TenantId                         string
 }
 
-func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, skipResourceProviderRegistration bool) (*ResourceManagerAccount, error) {
+func NewResourceManagerAccount(ctx context.Context, config authentication.Config, env azure.Environment, resourceProviderRegistrations common.ResourceProviderRegistrations) (*ResourceManagerAccount, error) {
 	objectId := ""
 
 	// TODO remove this when we confirm that MSI no longer returns nil with getAuthenticatedObjectID
@@ -36,8 +40,42 @@ func NewResourceManagerAccount(ctx context.Context, config authentication.Config
 		Environment:                      env,
 		ObjectId:                         objectId,
 		TenantId:                         config.TenantID,
-		SkipResourceProviderRegistration: skipResourceProviderRegistration,
+		ResourceProviderRegistrations:    resourceProviderRegistrations,
+		SkipResourceProviderRegistration: resourceProviderRegistrations.Limited && len(resourceProviderRegistrations.ResourceProviders) == 0,
 		SubscriptionId:                   config.SubscriptionID,
 	}
 	return &account, nil
 }
+
+// servicePrincipalObjectIDFunc returns a function which looks up the Object ID of the Service Principal used to
+// authenticate from the Graph API within the specified Azure Environment
+func servicePrincipalObjectIDFunc(config authentication.Config, env azure.Environment) func(ctx context.Context) (string, error) {
+	return func(ctx context.Context) (string, error) {
+		s := sender.BuildSender("AzureRM")
+
+		oauthConfig, err := config.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
+		if err != nil {
+			return "", fmt.Errorf("building OAuth Config: %+v", err)
+		}
+
+		graphAuth, err := config.GetAuthorizationToken(s, oauthConfig, env.GraphEndpoint)
+		if err != nil {
+			return "", fmt.Errorf("unable to get authorization token for graph endpoints: %+v", err)
+		}
+
+		client := graphrbac.NewServicePrincipalsClientWithBaseURI(env.GraphEndpoint, config.TenantID)
+		client.Authorizer = graphAuth
+		client.Sender = s
+
+		result, err := client.List(ctx, fmt.Sprintf("appId eq '%s'", config.ClientID))
+		if err != nil {
+			return "", fmt.Errorf("listing Service Principals: %+v", err)
+		}
+
+		if values := result.Values(); len(values) != 1 || values[0].ObjectID == nil {
+			return "", fmt.Errorf("expected a single Service Principal with the Client ID %q but got %d", config.ClientID, len(values))
+		}
+
+		return *result.Values()[0].ObjectID, nil
+	}
+}
@@ -13,7 +13,10 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/skus"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type ClientBuilder struct {
@@ -21,11 +24,20 @@ type ClientBuilder struct {
 	DisableCorrelationRequestID bool
 	CustomCorrelationRequestID  string
 	DisableTerraformPartnerID   bool
-	PartnerId                   string
-	SkipProviderRegistration    bool
-	StorageUseAzureAD           bool
-	TerraformVersion            string
-	Features                    features.UserFeatures
+	// Environment optionally overrides the Azure Environment which is otherwise looked up by name (or from the
+	// Metadata Host) - which allows requests to be sent to other endpoints, such as an Emulator
+	Environment                   *azure.Environment
+	PartnerId                     string
+	RateLimits                    common.RateLimits
+	RecordingMode                 recording.Mode
+	ResourceProviderRegistrations common.ResourceProviderRegistrations
+	RetryPolicy                   common.RetryPolicy
+	SkipProviderRegistration      bool
+	StorageUseAzureAD             bool
+	Tags                          tags.Config
+	TerraformVersion              string
+	UseResponseCache              bool
+	Features                      features.UserFeatures
 }
 
 const azureStackEnvironmentError = `
@@ -43,27 +55,49 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 		return nil, fmt.Errorf(azureStackEnvironmentError)
 	}
 
-	isAzureStack, err := authentication.IsEnvironmentAzureStack(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	env, err := environment(ctx, builder)
 	if err != nil {
-		return nil, fmt.Errorf("unable to determine if environment is Azure Stack: %+v", err)
+		return nil, err
 	}
-	if isAzureStack {
-		return nil, fmt.Errorf(azureStackEnvironmentError)
+
+	authConfig := *builder.AuthConfig
+	if builder.Environment != nil && authConfig.AuthenticatedAsAServicePrincipal && authConfig.GetAuthenticatedObjectID != nil {
+		// the Object ID is otherwise looked up from the Graph API within the Azure Environment determined by name
+		authConfig.GetAuthenticatedObjectID = servicePrincipalObjectIDFunc(authConfig, *env)
+	}
+	if getAuthenticatedObjectID := authConfig.GetAuthenticatedObjectID; getAuthenticatedObjectID != nil {
+		switch builder.RecordingMode {
+		case recording.ModeRecord:
+			// the Object ID can be used within requests (e.g. Key Vault Access Policies) so needs scrubbing
+			authConfig.GetAuthenticatedObjectID = func(ctx context.Context) (string, error) {
+				objectId, err := getAuthenticatedObjectID(ctx)
+				recording.ScrubValue(objectId, recording.PlaceholderObjectId)
+				return objectId, err
+			}
+		case recording.ModeReplay:
+			authConfig.GetAuthenticatedObjectID = func(_ context.Context) (string, error) {
+				return recording.PlaceholderObjectId, nil
+			}
+		}
 	}
 
-	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
-	if err != nil {
-		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
+	resourceProviderRegistrations := builder.ResourceProviderRegistrations
+	if builder.SkipProviderRegistration {
+		// no Resource Providers are registered, either up-front or when they're first used
+		resourceProviderRegistrations = common.ResourceProviderRegistrations{
+			Limited: true,
+		}
 	}
 
 	// client declarations:
-	account, err := NewResourceManagerAccount(ctx, *builder.AuthConfig, *env, builder.SkipProviderRegistration)
+	account, err := NewResourceManagerAccount(ctx, authConfig, *env, resourceProviderRegistrations)
 	if err != nil {
 		return nil, fmt.Errorf("Error building account: %+v", err)
 	}
 
 	client := Client{
 		Account: account,
+		Tags:    builder.Tags,
 	}
 
 	oauthConfig, err := builder.AuthConfig.BuildOAuthConfig(env.ActiveDirectoryEndpoint)
@@ -113,24 +147,32 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	keyVaultAuth := builder.AuthConfig.BearerAuthorizerCallback(sender, oauthConfig)
 
 	o := &common.ClientOptions{
-		SubscriptionId:              builder.AuthConfig.SubscriptionID,
-		TenantID:                    builder.AuthConfig.TenantID,
-		PartnerId:                   builder.PartnerId,
-		TerraformVersion:            builder.TerraformVersion,
-		GraphAuthorizer:             graphAuth,
-		GraphEndpoint:               graphEndpoint,
-		KeyVaultAuthorizer:          keyVaultAuth,
-		ResourceManagerAuthorizer:   auth,
-		ResourceManagerEndpoint:     endpoint,
-		StorageAuthorizer:           storageAuth,
-		SynapseAuthorizer:           synapseAuth,
-		SkipProviderReg:             builder.SkipProviderRegistration,
-		DisableCorrelationRequestID: builder.DisableCorrelationRequestID,
-		CustomCorrelationRequestID:  builder.CustomCorrelationRequestID,
-		DisableTerraformPartnerID:   builder.DisableTerraformPartnerID,
-		Environment:                 *env,
-		Features:                    builder.Features,
-		StorageUseAzureAD:           builder.StorageUseAzureAD,
+		SubscriptionId:                builder.AuthConfig.SubscriptionID,
+		TenantID:                      builder.AuthConfig.TenantID,
+		PartnerId:                     builder.PartnerId,
+		TerraformVersion:              builder.TerraformVersion,
+		GraphAuthorizer:               graphAuth,
+		GraphEndpoint:                 graphEndpoint,
+		KeyVaultAuthorizer:            keyVaultAuth,
+		ResourceManagerAuthorizer:     auth,
+		ResourceManagerEndpoint:       endpoint,
+		StorageAuthorizer:             storageAuth,
+		SynapseAuthorizer:             synapseAuth,
+		SkipProviderReg:               account.SkipResourceProviderRegistration,
+		ResourceProviderRegistrations: resourceProviderRegistrations,
+		RetryPolicy:                   builder.RetryPolicy,
+		RateLimiter:                   common.NewRateLimiter(builder.RateLimits, builder.AuthConfig.SubscriptionID, endpoint),
+		RecordingMode:                 builder.RecordingMode,
+		DisableCorrelationRequestID:   builder.DisableCorrelationRequestID,
+		CustomCorrelationRequestID:    builder.CustomCorrelationRequestID,
+		DisableTerraformPartnerID:     builder.DisableTerraformPartnerID,
+		Environment:                   *env,
+		Features:                      builder.Features,
+		StorageUseAzureAD:             builder.StorageUseAzureAD,
+	}
+
+	if builder.UseResponseCache {
+		o.ResponseCache = common.NewResponseCache(endpoint)
 	}
 
 	if err := client.Build(ctx, o); err != nil {
@@ -138,9 +180,36 @@ func Build(ctx context.Context, builder ClientBuilder) (*Client, error) {
 	}
 
 	if features.EnhancedValidationEnabled() {
-		location.CacheSupportedLocations(ctx, env)
+		// the supported locations are retrieved from the Metadata Service, which isn't recorded
+		if builder.RecordingMode != recording.ModeReplay {
+			location.CacheSupportedLocations(ctx, env)
+		}
 		resourceproviders.CacheSupportedProviders(ctx, client.Resource.ProvidersClient)
+		skus.CacheSupportedSkus(ctx, client.Compute.ResourceSkusClient)
 	}
 
 	return &client, nil
 }
+
+// environment returns the Azure Environment which the Client should send requests to - which is either the
+// Environment specified in the Client Builder, or the Environment looked up by name from the Metadata Host
+func environment(ctx context.Context, builder ClientBuilder) (*azure.Environment, error) {
+	if builder.Environment != nil {
+		return builder.Environment, nil
+	}
+
+	isAzureStack, err := authentication.IsEnvironmentAzureStack(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine if environment is Azure Stack: %+v", err)
+	}
+	if isAzureStack {
+		return nil, fmt.Errorf(azureStackEnvironmentError)
+	}
+
+	env, err := authentication.AzureEnvironmentByNameFromEndpoint(ctx, builder.AuthConfig.MetadataHost, builder.AuthConfig.Environment)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find environment %q from endpoint %q: %+v", builder.AuthConfig.Environment, builder.AuthConfig.MetadataHost, err)
+	}
+
+	return env, nil
+}
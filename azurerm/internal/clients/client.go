@@ -98,6 +98,7 @@ import (
 	trafficManager "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/trafficmanager/client"
 	vmware "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/vmware/client"
 	web "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 )
 
 type Client struct {
@@ -107,6 +108,16 @@ type Client struct {
 	Account  *ResourceManagerAccount
 	Features features.UserFeatures
 
+	// Tags defines the Default Tags applied to, and the Tags ignored on, each resource
+	Tags tags.Config
+
+	// NameAvailabilityCache caches the result of each name availability check made using this Client
+	NameAvailabilityCache *common.NameAvailabilityCache
+
+	// CorrelationRequestId is the ID sent to Azure in the `x-ms-correlation-request-id` header
+	// this is empty when the Correlation Request ID has been disabled
+	CorrelationRequestId string
+
 	Advisor               *advisor.Client
 	AnalysisServices      *analysisServices.Client
 	ApiManagement         *apiManagement.Client
@@ -207,7 +218,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	// Disable the Azure SDK for Go's validation since it's unhelpful for our use-case
 	validation.Disabled = true
 
+	client.CorrelationRequestId = o.CorrelationRequestID()
 	client.Features = o.Features
+	client.NameAvailabilityCache = common.NewNameAvailabilityCache()
 	client.StopContext = ctx
 
 	client.Advisor = advisor.NewClient(o)
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/sender"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/version"
 )
 
@@ -27,28 +28,56 @@ type ClientOptions struct {
 	StorageAuthorizer         autorest.Authorizer
 	SynapseAuthorizer         autorest.Authorizer
 
-	SkipProviderReg             bool
-	CustomCorrelationRequestID  string
-	DisableCorrelationRequestID bool
-	DisableTerraformPartnerID   bool
-	Environment                 azure.Environment
-	Features                    features.UserFeatures
-	StorageUseAzureAD           bool
+	SkipProviderReg               bool
+	ResourceProviderRegistrations ResourceProviderRegistrations
+	RetryPolicy                   RetryPolicy
+	RateLimiter                   *RateLimiter
+	ResponseCache                 *ResponseCache
+	RecordingMode                 recording.Mode
+	CustomCorrelationRequestID    string
+	DisableCorrelationRequestID   bool
+	DisableTerraformPartnerID     bool
+	Environment                   azure.Environment
+	Features                      features.UserFeatures
+	StorageUseAzureAD             bool
 }
 
 func (o ClientOptions) ConfigureClient(c *autorest.Client, authorizer autorest.Authorizer) {
 	setUserAgent(c, o.TerraformVersion, o.PartnerId, o.DisableTerraformPartnerID)
 
 	c.Authorizer = authorizer
-	c.Sender = sender.BuildSender("AzureRM")
+	// NOTE: each decorator wraps those before it, so the last decorator is the outermost. Requests are
+	// recorded/replayed closest to the base Sender so that each retry is recorded, the Rate Limiter is
+	// within the retries so that each retry is also rate limited, and the Response Cache wraps both so
+	// that cached responses aren't rate limited (or retried). Errors for unregistered Resource Providers
+	// are surfaced by the outermost decorator, once the request can no longer succeed.
+	c.Sender = autorest.DecorateSender(sender.BuildSender("AzureRM"), recording.WithRecording(o.RecordingMode), o.RateLimiter.WithRateLimit(), o.RetryPolicy.WithRetries(), o.ResponseCache.WithResponseCache(), o.ResourceProviderRegistrations.WithUnregisteredResourceProviderErrors())
 	c.SkipResourceProviderRegistration = o.SkipProviderReg
+	o.RetryPolicy.ConfigureClient(c)
+	if o.RecordingMode == recording.ModeReplay {
+		// requests which are replayed are never sent, so there's nothing to authorize - nor any need to wait between retries/polling
+		c.Authorizer = autorest.NullAuthorizer{}
+		c.PollingDelay = 0
+		c.RetryDuration = 0
+	}
 	if !o.DisableCorrelationRequestID {
-		id := o.CustomCorrelationRequestID
-		if id == "" {
-			id = correlationRequestID()
-		}
-		c.RequestInspector = withCorrelationRequestID(id)
+		c.RequestInspector = withCorrelationRequestID(o.CorrelationRequestID())
+	}
+}
+
+// CorrelationRequestID returns the Correlation Request ID which is sent to Azure in the
+// `x-ms-correlation-request-id` header - which is either the user-specified value or a
+// generated value. This returns an empty string when the Correlation Request ID is disabled.
+func (o ClientOptions) CorrelationRequestID() string {
+	if o.DisableCorrelationRequestID {
+		return ""
 	}
+
+	if o.CustomCorrelationRequestID != "" {
+		return o.CustomCorrelationRequestID
+	}
+
+	return correlationRequestID()
 }
 
 func setUserAgent(client *autorest.Client, tfVersion, partnerID string, disableTerraformPartnerID bool) {
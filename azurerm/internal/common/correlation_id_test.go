@@ -29,3 +29,39 @@ func TestWithCorrelationRequestID(t *testing.T) {
 			HeaderCorrelationRequestID, uuid, req.Header.Get(HeaderCorrelationRequestID))
 	}
 }
+
+func TestClientOptionsCorrelationRequestID(t *testing.T) {
+	testData := []struct {
+		name     string
+		options  ClientOptions
+		expected string
+	}{
+		{
+			name:     "default",
+			options:  ClientOptions{},
+			expected: correlationRequestID(),
+		},
+		{
+			name: "custom",
+			options: ClientOptions{
+				CustomCorrelationRequestID: "abc123",
+			},
+			expected: "abc123",
+		},
+		{
+			name: "disabled",
+			options: ClientOptions{
+				CustomCorrelationRequestID:  "abc123",
+				DisableCorrelationRequestID: true,
+			},
+			expected: "",
+		},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if actual := v.options.CorrelationRequestID(); actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
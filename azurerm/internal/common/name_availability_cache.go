@@ -0,0 +1,60 @@
+package common
+
+import (
+	"sync"
+)
+
+// NameAvailabilityCache caches the result of each name availability check made using a Client -
+// since Terraform launches a new instance of the Provider for each operation, this means that each
+// name is checked once per plan, regardless of how often the diff is computed.
+//
+// Checks for different keys run concurrently, whereas checks for the same key are made once, with
+// any concurrent callers waiting on (and sharing) the result of the in-flight check.
+type NameAvailabilityCache struct {
+	lock    sync.Mutex
+	entries map[string]*nameAvailabilityCacheEntry
+}
+
+type nameAvailabilityCacheEntry struct {
+	lock   sync.Mutex
+	result interface{}
+}
+
+// NewNameAvailabilityCache returns an empty NameAvailabilityCache
+func NewNameAvailabilityCache() *NameAvailabilityCache {
+	return &NameAvailabilityCache{
+		entries: make(map[string]*nameAvailabilityCacheEntry),
+	}
+}
+
+// Check returns the cached result for the specified key when available, otherwise calls the check
+// function and caches the result. Errors aren't cached so that these can be retried.
+func (c *NameAvailabilityCache) Check(key string, check func() (interface{}, error)) (interface{}, error) {
+	if c == nil {
+		return check()
+	}
+
+	c.lock.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &nameAvailabilityCacheEntry{}
+		c.entries[key] = entry
+	}
+	c.lock.Unlock()
+
+	// only the entry for this key is locked whilst checking, since this can take some time
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.result != nil {
+		return entry.result, nil
+	}
+
+	result, err := check()
+	if err != nil {
+		return nil, err
+	}
+
+	entry.result = result
+	return result, nil
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestNameAvailabilityCache(t *testing.T) {
+	cache := NewNameAvailabilityCache()
+
+	calls := 0
+	check := func() (interface{}, error) {
+		calls++
+		return "AlreadyExists", nil
+	}
+
+	for i := 0; i < 3; i++ {
+		result, err := cache.Check("subscription/storage account/example", check)
+		if err != nil {
+			t.Fatalf("checking: %+v", err)
+		}
+		if result != "AlreadyExists" {
+			t.Fatalf("expected %q but got %+v", "AlreadyExists", result)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected the name to be checked once but got %d", calls)
+	}
+
+	// a different key is checked separately
+	if _, err := cache.Check("subscription/key vault/example", check); err != nil {
+		t.Fatalf("checking: %+v", err)
+	}
+	if calls != 2 {
+		t.Fatalf("expected the name to be checked twice but got %d", calls)
+	}
+}
+
+func TestNameAvailabilityCacheErrorsAreNotCached(t *testing.T) {
+	cache := NewNameAvailabilityCache()
+
+	calls := 0
+	check := func() (interface{}, error) {
+		calls++
+		if calls == 1 {
+			return nil, fmt.Errorf("transient error")
+		}
+		return "Available", nil
+	}
+
+	if _, err := cache.Check("example", check); err == nil {
+		t.Fatalf("expected an error for the first check")
+	}
+
+	result, err := cache.Check("example", check)
+	if err != nil {
+		t.Fatalf("expected no error for the second check but got %+v", err)
+	}
+	if result != "Available" {
+		t.Fatalf("expected %q but got %+v", "Available", result)
+	}
+}
+
+func TestNameAvailabilityCacheChecksDifferentKeysConcurrently(t *testing.T) {
+	cache := NewNameAvailabilityCache()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go func() {
+		_, _ = cache.Check("slow", func() (interface{}, error) {
+			close(started)
+			<-release
+			return "slow", nil
+		})
+	}()
+	<-started
+	defer close(release)
+
+	done := make(chan struct{})
+	go func() {
+		_, _ = cache.Check("fast", func() (interface{}, error) {
+			return "fast", nil
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected the check for a different key not to wait on the in-flight check")
+	}
+}
+
+func TestNameAvailabilityCacheChecksTheSameKeyOnce(t *testing.T) {
+	cache := NewNameAvailabilityCache()
+
+	var lock sync.Mutex
+	calls := 0
+	check := func() (interface{}, error) {
+		lock.Lock()
+		calls++
+		lock.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		return "AlreadyExists", nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 5; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := cache.Check("example", check); err != nil {
+				t.Errorf("checking: %+v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if calls != 1 {
+		t.Fatalf("expected the name to be checked once but got %d", calls)
+	}
+}
+
+func TestNameAvailabilityCacheNil(t *testing.T) {
+	var cache *NameAvailabilityCache
+
+	calls := 0
+	check := func() (interface{}, error) {
+		calls++
+		return "Available", nil
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := cache.Check("example", check); err != nil {
+			t.Fatalf("checking: %+v", err)
+		}
+	}
+	if calls != 2 {
+		t.Fatalf("expected each check to be made when there's no cache but got %d", calls)
+	}
+}
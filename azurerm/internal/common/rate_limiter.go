@@ -0,0 +1,213 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// RateLimits defines the maximum number of requests which can be sent to Azure Resource Manager
+// for each Subscription - a value of 0 means that class of requests isn't rate limited
+type RateLimits struct {
+	// ReadsPerHour is the maximum number of read (GET/HEAD) requests per hour, per Subscription
+	ReadsPerHour int
+
+	// WritesPerHour is the maximum number of write (PUT/PATCH/POST/DELETE) requests per hour, per Subscription
+	WritesPerHour int
+}
+
+// RateLimiter limits the number of requests sent to Azure Resource Manager, using a separate
+// token bucket for reads and writes within each Subscription
+//
+// Azure Resource Manager enforces quotas (for example 12,000 reads per hour) per Subscription, once
+// these are exhausted all requests fail - this allows requests to be spread out over time instead.
+type RateLimiter struct {
+	limits                RateLimits
+	defaultSubscriptionId string
+	host                  string
+
+	lock    sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+// NewRateLimiter returns a RateLimiter using the specified limits for requests to the specified Resource
+// Manager Endpoint - requests which don't target a specific Subscription are counted against the default
+// Subscription, and requests to other hosts (for example data plane requests to a Key Vault or Storage
+// Account) aren't rate limited, since these aren't counted towards the Resource Manager quotas
+func NewRateLimiter(limits RateLimits, defaultSubscriptionId string, resourceManagerEndpoint string) *RateLimiter {
+	return &RateLimiter{
+		limits:                limits,
+		defaultSubscriptionId: defaultSubscriptionId,
+		host:                  hostForEndpoint(resourceManagerEndpoint),
+		buckets:               make(map[string]*tokenBucket),
+	}
+}
+
+// WithRateLimit returns a SendDecorator which blocks each request until the rate limit
+// for the Subscription and class of request (read/write) allows it to be sent
+func (l *RateLimiter) WithRateLimit() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if l != nil {
+				if err := l.wait(r); err != nil {
+					return nil, err
+				}
+			}
+
+			return s.Do(r)
+		})
+	}
+}
+
+func (l *RateLimiter) wait(r *http.Request) error {
+	bucket, key := l.bucketForRequest(r)
+	if bucket == nil {
+		return nil
+	}
+
+	delay, err := bucket.wait(r.Context())
+	if err != nil {
+		return fmt.Errorf("waiting for the rate limit %q: %+v", key, err)
+	}
+	if delay > 0 {
+		log.Printf("[DEBUG] Rate Limit %q: %s %s was delayed by %s", key, r.Method, r.URL, delay)
+	}
+
+	return nil
+}
+
+func (l *RateLimiter) bucketForRequest(r *http.Request) (*tokenBucket, string) {
+	if !strings.EqualFold(r.URL.Host, l.host) {
+		return nil, ""
+	}
+
+	requestsPerHour := l.limits.WritesPerHour
+	class := "write"
+	if r.Method == http.MethodGet || r.Method == http.MethodHead {
+		requestsPerHour = l.limits.ReadsPerHour
+		class = "read"
+	}
+	if requestsPerHour <= 0 {
+		return nil, ""
+	}
+
+	subscriptionId := subscriptionIdFromURLPath(r.URL.Path)
+	if subscriptionId == "" {
+		subscriptionId = l.defaultSubscriptionId
+	}
+	key := fmt.Sprintf("%s/%s", strings.ToLower(subscriptionId), class)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(requestsPerHour)
+		l.buckets[key] = bucket
+	}
+	return bucket, key
+}
+
+// hostForEndpoint returns the host for the specified endpoint (e.g. `https://management.azure.com/`)
+func hostForEndpoint(endpoint string) string {
+	host := endpoint
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	return strings.ToLower(host)
+}
+
+// subscriptionIdFromURLPath returns the Subscription ID from a Resource Manager URL path
+// (e.g. `/subscriptions/{id}/resourceGroups/...`) or an empty string when there isn't one
+func subscriptionIdFromURLPath(input string) string {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	for i := 0; i < len(segments)-1; i++ {
+		if strings.EqualFold(segments[i], "subscriptions") {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
+// tokenBucket is a token bucket which is refilled at a constant rate, and which can hold
+// up to one minute's worth of tokens, allowing for short bursts of requests
+type tokenBucket struct {
+	lock sync.Mutex
+
+	capacity float64
+	tokens   float64
+	interval time.Duration
+	updated  time.Time
+
+	// now is overridden in the unit tests
+	now func() time.Time
+}
+
+func newTokenBucket(requestsPerHour int) *tokenBucket {
+	capacity := float64(requestsPerHour) / 60
+	if capacity < 1 {
+		capacity = 1
+	}
+
+	return &tokenBucket{
+		capacity: capacity,
+		tokens:   capacity,
+		interval: time.Hour / time.Duration(requestsPerHour),
+		updated:  time.Now(),
+		now:      time.Now,
+	}
+}
+
+// reserve takes a token from the bucket, returning how long the caller needs to wait before it can be used
+func (b *tokenBucket) reserve() time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	now := b.now()
+	if elapsed := now.Sub(b.updated); elapsed > 0 {
+		b.tokens += float64(elapsed) / float64(b.interval)
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.updated = now
+
+	// the token is taken regardless, so that concurrent callers queue up behind one another
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens * float64(b.interval))
+}
+
+// wait blocks until a token is available, returning the length of time spent waiting
+func (b *tokenBucket) wait(ctx context.Context) (time.Duration, error) {
+	delay := b.reserve()
+	if delay <= 0 {
+		return 0, nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return delay, nil
+	case <-ctx.Done():
+		// return the token, since the request is never sent
+		b.lock.Lock()
+		b.tokens++
+		b.lock.Unlock()
+		return 0, ctx.Err()
+	}
+}
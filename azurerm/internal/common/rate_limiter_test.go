@@ -0,0 +1,182 @@
+package common
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestSubscriptionIdFromURLPath(t *testing.T) {
+	testData := []struct {
+		input    string
+		expected string
+	}{
+		{
+			input:    "",
+			expected: "",
+		},
+		{
+			input:    "/providers/Microsoft.Resources/operations",
+			expected: "",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			input:    "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+		{
+			input:    "/Subscriptions/11111111-1111-1111-1111-111111111111/resourcegroups/group1",
+			expected: "11111111-1111-1111-1111-111111111111",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		actual := subscriptionIdFromURLPath(v.input)
+		if actual != v.expected {
+			t.Fatalf("expected %q but got %q", v.expected, actual)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	now := time.Now()
+	bucket := newTokenBucket(120)
+	bucket.now = func() time.Time {
+		return now
+	}
+	bucket.updated = now
+
+	// 120 per hour is a capacity of 2 tokens, refilled every 30s
+	for i := 0; i < 2; i++ {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("expected request %d to not be delayed but got %s", i, delay)
+		}
+	}
+	if delay := bucket.reserve(); delay != 30*time.Second {
+		t.Fatalf("expected the third request to be delayed by 30s but got %s", delay)
+	}
+	if delay := bucket.reserve(); delay != time.Minute {
+		t.Fatalf("expected the fourth request to be delayed by 1m but got %s", delay)
+	}
+
+	// once the bucket has refilled requests are no longer delayed
+	now = now.Add(time.Hour)
+	if delay := bucket.reserve(); delay != 0 {
+		t.Fatalf("expected the request to not be delayed once refilled but got %s", delay)
+	}
+}
+
+func TestRateLimiter_BucketForRequest(t *testing.T) {
+	limiter := NewRateLimiter(RateLimits{ReadsPerHour: 1000}, "00000000-0000-0000-0000-000000000000", "https://management.azure.com/")
+
+	testData := []struct {
+		method      string
+		url         string
+		expectedKey string
+	}{
+		{
+			method:      http.MethodGet,
+			url:         "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expectedKey: "11111111-1111-1111-1111-111111111111/read",
+		},
+		{
+			method:      http.MethodHead,
+			url:         "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expectedKey: "11111111-1111-1111-1111-111111111111/read",
+		},
+		{
+			method:      http.MethodGet,
+			url:         "https://management.azure.com/providers/Microsoft.Resources/operations",
+			expectedKey: "00000000-0000-0000-0000-000000000000/read",
+		},
+		{
+			// data plane requests aren't counted towards the Resource Manager quotas
+			method:      http.MethodGet,
+			url:         "https://example.vault.azure.net/secrets/secret1",
+			expectedKey: "",
+		},
+		{
+			method:      http.MethodGet,
+			url:         "https://example.blob.core.windows.net/container1",
+			expectedKey: "",
+		},
+		{
+			// writes aren't rate limited
+			method:      http.MethodPut,
+			url:         "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			expectedKey: "",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s %s..", v.method, v.url)
+
+		req, _ := http.NewRequest(v.method, v.url, nil)
+		bucket, key := limiter.bucketForRequest(req)
+		if key != v.expectedKey {
+			t.Fatalf("expected the key %q but got %q", v.expectedKey, key)
+		}
+		if (bucket == nil) != (v.expectedKey == "") {
+			t.Fatalf("expected a bucket to be returned for %q", v.expectedKey)
+		}
+	}
+}
+
+func TestRateLimiter_WithRateLimit(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// 60 writes per hour is a capacity of a single request, so the second request has to wait
+	limiter := NewRateLimiter(RateLimits{WritesPerHour: 60}, "00000000-0000-0000-0000-000000000000", server.URL)
+	sender := autorest.DecorateSender(server.Client(), limiter.WithRateLimit())
+
+	req, _ := http.NewRequest(http.MethodPut, server.URL, nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending the first request: %+v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ = http.NewRequestWithContext(ctx, http.MethodPut, server.URL, nil)
+	if _, err := sender.Do(req); err == nil {
+		t.Fatalf("expected the second request to time out waiting for the rate limit")
+	}
+
+	// reads aren't rate limited
+	req, _ = http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending the read request: %+v", err)
+	}
+
+	if requests != 2 {
+		t.Fatalf("expected 2 requests to be sent but got %d", requests)
+	}
+}
+
+func TestRateLimiter_NilLimiter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var limiter *RateLimiter
+	sender := autorest.DecorateSender(server.Client(), limiter.WithRateLimit())
+	req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	if _, err := sender.Do(req); err != nil {
+		t.Fatalf("sending request: %+v", err)
+	}
+}
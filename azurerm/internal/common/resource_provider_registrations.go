@@ -0,0 +1,122 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ResourceProviderRegistrations defines which Resource Providers the Provider is able to register
+type ResourceProviderRegistrations struct {
+	// Limited specifies that only the Resource Providers defined in ResourceProviders can be registered,
+	// rather than any Resource Provider being registered automatically when it's first used
+	Limited bool
+
+	// ResourceProviders is the set of Resource Providers which are registered by the Provider
+	ResourceProviders map[string]struct{}
+}
+
+// CanRegister returns whether the specified Resource Provider can be registered by the Provider
+func (r ResourceProviderRegistrations) CanRegister(namespace string) bool {
+	if !r.Limited {
+		return true
+	}
+
+	for v := range r.ResourceProviders {
+		if strings.EqualFold(v, namespace) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WithUnregisteredResourceProviderErrors returns a SendDecorator which returns an error naming the Resource Provider
+// when a request fails since the Resource Provider isn't registered and the Provider can't register it - since the
+// error returned from Azure can otherwise be confusing (or lead to the Resource Provider being registered anyway)
+func (r ResourceProviderRegistrations) WithUnregisteredResourceProviderErrors() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		if !r.Limited {
+			return s
+		}
+
+		return autorest.SenderFunc(func(req *http.Request) (*http.Response, error) {
+			resp, err := s.Do(req)
+			if err != nil {
+				return resp, err
+			}
+
+			namespace := unregisteredResourceProvider(resp)
+			if namespace == "" || r.CanRegister(namespace) {
+				return resp, nil
+			}
+
+			return resp, fmt.Errorf(unregisteredResourceProviderErrorFmt, namespace, namespace, namespace)
+		})
+	}
+}
+
+// unregisteredResourceProvider returns the Namespace of the Resource Provider when the request failed
+// since it isn't registered in the Subscription, otherwise an empty string is returned
+func unregisteredResourceProvider(resp *http.Response) string {
+	if resp == nil || resp.StatusCode != http.StatusConflict || resp.Body == nil {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var errorResponse struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Details []struct {
+				Target string `json:"target"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		return ""
+	}
+	if errorResponse.Error == nil || !strings.EqualFold(errorResponse.Error.Code, "MissingSubscriptionRegistration") {
+		return ""
+	}
+
+	// the Namespace is returned as the target, which is what autorest uses to register the Resource Provider
+	for _, v := range errorResponse.Error.Details {
+		if v.Target != "" {
+			return v.Target
+		}
+	}
+
+	// otherwise fall back to the Namespace within the Resource ID
+	if resp.Request == nil || resp.Request.URL == nil {
+		return ""
+	}
+	segments := strings.Split(strings.Trim(resp.Request.URL.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		if strings.EqualFold(segments[i], "providers") {
+			return segments[i+1]
+		}
+	}
+
+	return ""
+}
+
+const unregisteredResourceProviderErrorFmt = `the Resource Provider %q isn't registered in this Subscription.
+
+Terraform hasn't registered this Resource Provider since it's not included in the Resource
+Providers which the Provider has been configured to register (using the "skip_provider_registration",
+"resource_provider_registrations" and "resource_providers_to_register" fields in the Provider block).
+
+Either register this Resource Provider outside of Terraform (for example using
+"az provider register --namespace %s") or add %q to the "resource_providers_to_register"
+field in the Provider block.`
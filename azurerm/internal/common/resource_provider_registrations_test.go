@@ -0,0 +1,133 @@
+package common
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestResourceProviderRegistrations_CanRegister(t *testing.T) {
+	testData := []struct {
+		Name          string
+		Registrations ResourceProviderRegistrations
+		Namespace     string
+		Expected      bool
+	}{
+		{
+			Name:          "Not Limited",
+			Registrations: ResourceProviderRegistrations{},
+			Namespace:     "Microsoft.Compute",
+			Expected:      true,
+		},
+		{
+			Name: "Limited and Registered",
+			Registrations: ResourceProviderRegistrations{
+				Limited: true,
+				ResourceProviders: map[string]struct{}{
+					"microsoft.insights": {},
+				},
+			},
+			Namespace: "Microsoft.Insights",
+			Expected:  true,
+		},
+		{
+			Name: "Limited and Not Registered",
+			Registrations: ResourceProviderRegistrations{
+				Limited: true,
+				ResourceProviders: map[string]struct{}{
+					"Microsoft.Network": {},
+				},
+			},
+			Namespace: "Microsoft.Compute",
+			Expected:  false,
+		},
+		{
+			Name: "None",
+			Registrations: ResourceProviderRegistrations{
+				Limited: true,
+			},
+			Namespace: "Microsoft.Compute",
+			Expected:  false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		if actual := v.Registrations.CanRegister(v.Namespace); actual != v.Expected {
+			t.Fatalf("expected %t but got %t", v.Expected, actual)
+		}
+	}
+}
+
+func TestResourceProviderRegistrations_WithUnregisteredResourceProviderErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "Microsoft.Unregistered") {
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"error":{"code":"MissingSubscriptionRegistration","message":"The subscription is not registered to use namespace 'Microsoft.Unregistered'.","details":[{"code":"MissingSubscriptionRegistration","target":"Microsoft.Unregistered"}]}}`)) // nolint: errcheck
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	registrations := ResourceProviderRegistrations{
+		Limited: true,
+		ResourceProviders: map[string]struct{}{
+			"Microsoft.Registered": {},
+		},
+	}
+	sender := autorest.DecorateSender(http.DefaultClient, registrations.WithUnregisteredResourceProviderErrors())
+
+	send := func(namespace string) error {
+		req, err := http.NewRequest(http.MethodPut, server.URL+"/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/"+namespace+"/things/thing1", nil)
+		if err != nil {
+			t.Fatalf("building request: %+v", err)
+		}
+		resp, err := sender.Do(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		return err
+	}
+
+	if err := send("Microsoft.Registered"); err != nil {
+		t.Fatalf("expected no error for a registered Resource Provider but got %+v", err)
+	}
+
+	err := send("Microsoft.Unregistered")
+	if err == nil {
+		t.Fatalf("expected an error for an unregistered Resource Provider but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `"Microsoft.Unregistered"`) {
+		t.Fatalf("expected the error to name the Resource Provider but got %+v", err)
+	}
+
+	// the error is returned as-is when the Resource Provider can be registered
+	registrations.ResourceProviders["Microsoft.Unregistered"] = struct{}{}
+	sender = autorest.DecorateSender(http.DefaultClient, registrations.WithUnregisteredResourceProviderErrors())
+	if err := send("Microsoft.Unregistered"); err != nil {
+		t.Fatalf("expected no error when the Resource Provider can be registered but got %+v", err)
+	}
+}
+
+func TestUnregisteredResourceProvider_NamespaceFromURL(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://management.azure.com/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/example/providers/Microsoft.Foo/bars/bar1", nil)
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+	resp := &http.Response{
+		StatusCode: http.StatusConflict,
+		Body:       ioutil.NopCloser(strings.NewReader(`{"error":{"code":"MissingSubscriptionRegistration"}}`)),
+		Request:    req,
+	}
+
+	if actual := unregisteredResourceProvider(resp); actual != "Microsoft.Foo" {
+		t.Fatalf("expected `Microsoft.Foo` but got %q", actual)
+	}
+}
@@ -0,0 +1,213 @@
+package common
+
+import (
+	"bytes"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// ResponseCache caches the responses to GET requests made against Azure Resource Manager during
+// a refresh - which allows multiple resources reading the same (parent) object to share a single
+// request, rather than each issuing their own.
+//
+// A write (e.g. PUT/PATCH/POST/DELETE) to a resource invalidates the cached responses for that
+// resource, any resources within it (e.g. the Subnets within a Virtual Network) and any resources
+// it's within (e.g. the Virtual Network a Subnet is within) - the cached responses for other
+// resources are retained, and responses continue to be cached after the write.
+type ResponseCache struct {
+	host string
+
+	lock      sync.Mutex
+	responses map[string]*cachedResponse
+	inFlight  map[string]*inFlightRequest
+}
+
+type cachedResponse struct {
+	path       string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+type inFlightRequest struct {
+	path     string
+	wg       sync.WaitGroup
+	response *cachedResponse
+
+	// invalidated is whether a write to a related resource was made whilst this request was in-flight
+	invalidated bool
+}
+
+// NewResponseCache returns a ResponseCache for requests to the specified Resource Manager Endpoint
+func NewResponseCache(resourceManagerEndpoint string) *ResponseCache {
+	return &ResponseCache{
+		host:      hostForEndpoint(resourceManagerEndpoint),
+		responses: make(map[string]*cachedResponse),
+		inFlight:  make(map[string]*inFlightRequest),
+	}
+}
+
+// WithResponseCache returns a SendDecorator which returns cached responses for GET requests
+// until a write is made, at which point the cache is cleared
+func (c *ResponseCache) WithResponseCache() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if c == nil || !strings.EqualFold(r.URL.Host, c.host) {
+				return s.Do(r)
+			}
+
+			path := normalizeCachePath(r.URL.Path)
+			switch {
+			case r.Method == http.MethodGet:
+				return c.get(s, r, path)
+
+			case r.Method == http.MethodHead || isListAction(r.Method, path):
+				return s.Do(r)
+			}
+
+			c.invalidate(r.Method, path)
+			return s.Do(r)
+		})
+	}
+}
+
+func (c *ResponseCache) get(s autorest.Sender, r *http.Request, path string) (*http.Response, error) {
+	key := strings.ToLower(r.URL.Host) + path + "?" + r.URL.RawQuery
+
+	if isOperationPath(path) {
+		return s.Do(r)
+	}
+
+	c.lock.Lock()
+	if cached, ok := c.responses[key]; ok {
+		c.lock.Unlock()
+		log.Printf("[DEBUG] Response Cache: returning the cached response for GET %s", r.URL)
+		return cached.toResponse(r), nil
+	}
+	if existing, ok := c.inFlight[key]; ok {
+		c.lock.Unlock()
+		existing.wg.Wait()
+		if existing.response != nil {
+			log.Printf("[DEBUG] Response Cache: returning the response from an in-flight request for GET %s", r.URL)
+			return existing.response.toResponse(r), nil
+		}
+
+		// the in-flight request wasn't cacheable (e.g. it failed) so send this one separately
+		return s.Do(r)
+	}
+
+	request := &inFlightRequest{
+		path: path,
+	}
+	request.wg.Add(1)
+	c.inFlight[key] = request
+	c.lock.Unlock()
+
+	resp, err := s.Do(r)
+	if err == nil && resp != nil && resp.StatusCode == http.StatusOK && resp.Body != nil {
+		body, readErr := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if readErr == nil {
+			request.response = &cachedResponse{
+				path:       path,
+				statusCode: resp.StatusCode,
+				header:     resp.Header.Clone(),
+				body:       body,
+			}
+		}
+	}
+
+	c.lock.Lock()
+	delete(c.inFlight, key)
+	// a write to a related resource could have been made whilst this request was in-flight
+	if request.response != nil && !request.invalidated {
+		c.responses[key] = request.response
+	} else {
+		request.response = nil
+	}
+	c.lock.Unlock()
+	request.wg.Done()
+
+	return resp, err
+}
+
+// invalidate removes the cached responses for resources related to the specified path following a write
+func (c *ResponseCache) invalidate(method, path string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	removed := 0
+	for key, response := range c.responses {
+		if isRelatedPath(response.path, path) {
+			delete(c.responses, key)
+			removed++
+		}
+	}
+	for _, request := range c.inFlight {
+		if isRelatedPath(request.path, path) {
+			request.invalidated = true
+		}
+	}
+
+	if removed > 0 {
+		log.Printf("[DEBUG] Response Cache: removed %d cached response(s) following %s %q", removed, method, path)
+	}
+}
+
+func (r cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        http.StatusText(r.statusCode),
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          ioutil.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+func normalizeCachePath(input string) string {
+	return "/" + strings.ToLower(strings.Trim(input, "/"))
+}
+
+// isRelatedPath returns whether either (normalized) path is the same as, or within, the other path
+func isRelatedPath(first, second string) bool {
+	return isWithinPath(first, second) || isWithinPath(second, first)
+}
+
+// isWithinPath returns whether the (normalized) path is the same as, or within, the parent path
+func isWithinPath(path, parent string) bool {
+	return path == parent || strings.HasPrefix(path, strings.TrimSuffix(parent, "/")+"/")
+}
+
+// isListAction returns whether the request is a POST to a `list` action (e.g. `listKeys`)
+// which is used to retrieve information rather than to update the resource
+func isListAction(method, path string) bool {
+	if method != http.MethodPost {
+		return false
+	}
+
+	segments := strings.Split(path, "/")
+	return strings.HasPrefix(segments[len(segments)-1], "list")
+}
+
+// isOperationPath returns whether the path is used to poll the status of a long running operation
+// which can't be cached since the response changes until the operation completes
+func isOperationPath(path string) bool {
+	for _, segment := range strings.Split(path, "/") {
+		switch segment {
+		case "operations", "operationresults", "operationstatuses", "asyncoperations", "azureasyncoperations":
+			return true
+		}
+	}
+
+	return false
+}
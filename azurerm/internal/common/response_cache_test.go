@@ -0,0 +1,279 @@
+package common
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testVirtualNetworkPath = "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1"
+
+type responseCacheTestServer struct {
+	server   *httptest.Server
+	requests int32
+	status   int32
+}
+
+func newResponseCacheTestServer() *responseCacheTestServer {
+	s := &responseCacheTestServer{
+		status: http.StatusOK,
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(&s.requests, 1)
+		w.WriteHeader(int(atomic.LoadInt32(&s.status)))
+		fmt.Fprintf(w, `{"request": %d}`, count)
+	}))
+	return s
+}
+
+func (s *responseCacheTestServer) send(t *testing.T, sender autorest.Sender, method, path string) string {
+	req, _ := http.NewRequest(method, s.server.URL+path+"?api-version=2020-01-01", nil)
+	resp, err := sender.Do(req)
+	if err != nil {
+		t.Fatalf("sending %s %s: %+v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading the body: %+v", err)
+	}
+	return string(body)
+}
+
+func TestResponseCache_CachesGetRequests(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+
+	cache := NewResponseCache(s.server.URL)
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	first := s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	second := s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if first != second {
+		t.Fatalf("expected the cached response %q but got %q", first, second)
+	}
+	if s.requests != 1 {
+		t.Fatalf("expected 1 request but got %d", s.requests)
+	}
+
+	// a list action isn't a write, so the cached response remains
+	s.send(t, sender, http.MethodPost, testVirtualNetworkPath+"/listKeys")
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if s.requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", s.requests)
+	}
+}
+
+func TestResponseCache_InvalidatedByWrites(t *testing.T) {
+	testData := []struct {
+		name      string
+		method    string
+		path      string
+		cacheable bool
+	}{
+		{
+			name:      "write to the same resource",
+			method:    http.MethodPut,
+			path:      testVirtualNetworkPath,
+			cacheable: false,
+		},
+		{
+			name:      "write to a child resource",
+			method:    http.MethodPut,
+			path:      testVirtualNetworkPath + "/subnets/subnet1",
+			cacheable: false,
+		},
+		{
+			name:      "delete of the parent resource",
+			method:    http.MethodDelete,
+			path:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group1",
+			cacheable: false,
+		},
+		{
+			name:      "action on the resource with different casing",
+			method:    http.MethodPost,
+			path:      "/SUBSCRIPTIONS/11111111-1111-1111-1111-111111111111/resourcegroups/group1/providers/Microsoft.Network/virtualNetworks/NETWORK1/checkIPAddressAvailability",
+			cacheable: false,
+		},
+		{
+			name:      "write to another resource",
+			method:    http.MethodPut,
+			path:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Network/networkInterfaces/nic1",
+			cacheable: true,
+		},
+		{
+			name:      "write to a resource with the same prefix",
+			method:    http.MethodPut,
+			path:      testVirtualNetworkPath + "2",
+			cacheable: true,
+		},
+		{
+			name:      "list action on another resource",
+			method:    http.MethodPost,
+			path:      "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Storage/storageAccounts/account1/listKeys",
+			cacheable: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		s := newResponseCacheTestServer()
+		cache := NewResponseCache(s.server.URL)
+		sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+		s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+		s.send(t, sender, v.method, v.path)
+		s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+		s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+		s.server.Close()
+
+		// the initial GET, the write and then either 0 or 1 GET's depending on whether this is cached,
+		// since responses continue to be cached following a write
+		expected := int32(3)
+		if v.cacheable {
+			expected = 2
+		}
+		if s.requests != expected {
+			t.Fatalf("expected %d requests but got %d", expected, s.requests)
+		}
+	}
+}
+
+func TestResponseCache_WriteOnlyInvalidatesRelatedResources(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+
+	cache := NewResponseCache(s.server.URL)
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	otherPath := "/subscriptions/11111111-1111-1111-1111-111111111111/resourceGroups/group2/providers/Microsoft.Network/virtualNetworks/network2"
+	network := s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	other := s.send(t, sender, http.MethodGet, otherPath)
+
+	s.send(t, sender, http.MethodPut, testVirtualNetworkPath)
+
+	// the response for the other resource remains cached
+	if actual := s.send(t, sender, http.MethodGet, otherPath); actual != other {
+		t.Fatalf("expected the cached response %q for the other resource but got %q", other, actual)
+	}
+
+	// whereas the resource which was written to is retrieved again, and then cached
+	updated := s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if updated == network {
+		t.Fatalf("expected the response for the updated resource not to be cached")
+	}
+	if actual := s.send(t, sender, http.MethodGet, testVirtualNetworkPath); actual != updated {
+		t.Fatalf("expected the cached response %q for the updated resource but got %q", updated, actual)
+	}
+
+	// the 2 initial GET's, the write and the GET for the updated resource
+	if s.requests != 4 {
+		t.Fatalf("expected 4 requests but got %d", s.requests)
+	}
+}
+
+func TestResponseCache_DoesNotCacheFailures(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+	s.status = http.StatusNotFound
+
+	cache := NewResponseCache(s.server.URL)
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if s.requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", s.requests)
+	}
+}
+
+func TestResponseCache_DoesNotCacheOperations(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+
+	cache := NewResponseCache(s.server.URL)
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	path := "/subscriptions/11111111-1111-1111-1111-111111111111/providers/Microsoft.Network/locations/westeurope/operations/abc123"
+	s.send(t, sender, http.MethodGet, path)
+	s.send(t, sender, http.MethodGet, path)
+	if s.requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", s.requests)
+	}
+}
+
+func TestResponseCache_OtherHostsAreNotCached(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+
+	cache := NewResponseCache("https://management.azure.com/")
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if s.requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", s.requests)
+	}
+}
+
+func TestResponseCache_ConcurrentRequests(t *testing.T) {
+	var requests int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		<-release
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	cache := NewResponseCache(server.URL)
+	sender := autorest.DecorateSender(server.Client(), cache.WithResponseCache())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req, _ := http.NewRequest(http.MethodGet, server.URL+testVirtualNetworkPath, nil)
+			resp, err := sender.Do(req)
+			if err != nil {
+				t.Errorf("sending request: %+v", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+
+	// give each request the opportunity to find the in-flight request before it completes
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Fatalf("expected 1 request but got %d", requests)
+	}
+}
+
+func TestResponseCache_NilCache(t *testing.T) {
+	s := newResponseCacheTestServer()
+	defer s.server.Close()
+
+	var cache *ResponseCache
+	sender := autorest.DecorateSender(s.server.Client(), cache.WithResponseCache())
+
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	s.send(t, sender, http.MethodGet, testVirtualNetworkPath)
+	if s.requests != 2 {
+		t.Fatalf("expected 2 requests but got %d", s.requests)
+	}
+}
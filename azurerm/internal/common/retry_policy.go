@@ -0,0 +1,207 @@
+package common
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// DefaultRetryableErrorCodes are the error codes returned from Azure Resource Manager which
+// indicate that the request failed due to a transient issue and should be retried
+var DefaultRetryableErrorCodes = []string{
+	"AnotherOperationInProgress",
+	"RetryableError",
+	"RetryableErrorDueToAnotherOperation",
+}
+
+// RetryPolicy defines how requests which fail due to a transient error should be retried
+//
+// Requests which fail with a retryable Status Code (for example a 429 or 503) are retried by
+// the autorest Client using MaxAttempts and MinDelay - in addition requests which fail with
+// a retryable Error Code (for example `AnotherOperationInProgress`) are retried using this policy.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times a failed request will be retried
+	MaxAttempts int
+
+	// MinDelay is the initial delay between retries, which is doubled on each subsequent attempt
+	MinDelay time.Duration
+
+	// MaxDelay is the maximum delay between retries
+	MaxDelay time.Duration
+
+	// RetryableErrorCodes is a list of the Error Codes returned from Azure which should be retried
+	RetryableErrorCodes []string
+}
+
+// DefaultRetryPolicy returns the RetryPolicy used when one isn't configured in the Provider block
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:         autorest.DefaultRetryAttempts,
+		MinDelay:            autorest.DefaultRetryDuration,
+		MaxDelay:            5 * time.Minute,
+		RetryableErrorCodes: DefaultRetryableErrorCodes,
+	}
+}
+
+// ConfigureClient configures the retry settings used by the autorest Client
+func (p RetryPolicy) ConfigureClient(c *autorest.Client) {
+	// NOTE: autorest's Resource Provider Registration logic iterates RetryAttempts times
+	// so this must be at least 1 for requests to be sent at all
+	if p.MaxAttempts > 0 {
+		c.RetryAttempts = p.MaxAttempts
+	}
+	if p.MinDelay > 0 {
+		c.RetryDuration = p.MinDelay
+	}
+}
+
+// WithRetries returns a SendDecorator which retries requests which fail with
+// one of the RetryableErrorCodes, honouring any `Retry-After` header returned
+//
+// Only requests which are safe to send more than once are retried (see isRetryableRequest).
+// When a request still fails with a retryable error once it can no longer be retried an error
+// is returned - since autorest otherwise sends a request which failed with a `409 Conflict`
+// again when checking whether the Resource Provider needs registering, which would multiply
+// the number of attempts.
+func (p RetryPolicy) WithRetries() autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (resp *http.Response, err error) {
+			if !isRetryableRequest(r) {
+				return s.Do(r)
+			}
+
+			rr := autorest.NewRetriableRequest(r)
+			for attempt := 0; ; attempt++ {
+				if err = rr.Prepare(); err != nil {
+					return resp, err
+				}
+
+				autorest.DrainResponseBody(resp)
+				resp, err = s.Do(rr.Request())
+				if err != nil {
+					return resp, err
+				}
+
+				errorCode := p.retryableErrorCode(resp)
+				if errorCode == "" {
+					return resp, err
+				}
+
+				if attempt >= p.MaxAttempts {
+					return resp, fmt.Errorf("%s %s failed with the retryable error %q after %d attempts", r.Method, r.URL, errorCode, attempt+1)
+				}
+
+				delay := p.delayForAttempt(resp, attempt)
+				if deadline, ok := r.Context().Deadline(); ok && time.Now().Add(delay).After(deadline) {
+					return resp, fmt.Errorf("%s %s failed with the retryable error %q and the delay of %s before retrying exceeds the deadline", r.Method, r.URL, errorCode, delay)
+				}
+
+				log.Printf("[DEBUG] %s %s failed with the retryable error %q - retrying in %s (attempt %d of %d)", r.Method, r.URL, errorCode, delay, attempt+1, p.MaxAttempts)
+				select {
+				case <-time.After(delay):
+				case <-r.Context().Done():
+					return resp, r.Context().Err()
+				}
+			}
+		})
+	}
+}
+
+// isRetryableRequest returns whether the request can safely be sent more than once, which is the
+// case for idempotent methods and POST requests for read-only `list` actions (e.g. `listKeys`)
+func isRetryableRequest(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete:
+		return true
+
+	case http.MethodPost:
+		if r.URL == nil {
+			return false
+		}
+		segments := strings.Split(strings.TrimSuffix(r.URL.Path, "/"), "/")
+		action := segments[len(segments)-1]
+		return strings.HasPrefix(strings.ToLower(action), "list")
+	}
+
+	return false
+}
+
+// delayForAttempt returns the delay before the next attempt - which is the value of the `Retry-After`
+// header when specified, else an exponential backoff between the MinDelay and MaxDelay
+func (p RetryPolicy) delayForAttempt(resp *http.Response, attempt int) time.Duration {
+	if resp != nil {
+		if v := resp.Header.Get("Retry-After"); v != "" {
+			if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+				return time.Duration(seconds) * time.Second
+			}
+			if t, err := http.ParseTime(v); err == nil {
+				if delay := time.Until(t); delay > 0 {
+					return delay
+				}
+			}
+		}
+	}
+
+	delay := p.MinDelay
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if p.MaxDelay > 0 && delay >= p.MaxDelay {
+			break
+		}
+	}
+
+	if p.MaxDelay > 0 && delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// retryableErrorCode returns the Error Code from the response when it's one of the RetryableErrorCodes
+// otherwise an empty string is returned
+func (p RetryPolicy) retryableErrorCode(resp *http.Response) string {
+	if resp == nil || resp.StatusCode < http.StatusBadRequest || resp.Body == nil {
+		return ""
+	}
+
+	// requests which fail with a retryable Status Code are retried by the autorest Client
+	if autorest.ResponseHasStatusCode(resp, autorest.StatusCodesForRetry...) {
+		return ""
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return ""
+	}
+
+	var errorResponse struct {
+		Code  string `json:"code"`
+		Error *struct {
+			Code string `json:"code"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &errorResponse); err != nil {
+		return ""
+	}
+
+	errorCode := errorResponse.Code
+	if errorResponse.Error != nil && errorResponse.Error.Code != "" {
+		errorCode = errorResponse.Error.Code
+	}
+	for _, v := range p.RetryableErrorCodes {
+		if errorCode != "" && strings.EqualFold(v, errorCode) {
+			return errorCode
+		}
+	}
+
+	return ""
+}
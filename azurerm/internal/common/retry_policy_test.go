@@ -0,0 +1,298 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+func TestRetryPolicy_WithRetries(t *testing.T) {
+	testData := []struct {
+		name             string
+		method           string
+		path             string
+		statusCode       int
+		body             string
+		failures         int32
+		maxAttempts      int
+		expectedRequests int32
+		expectedStatus   int
+		expectError      bool
+	}{
+		{
+			name:             "success",
+			statusCode:       http.StatusOK,
+			body:             `{}`,
+			failures:         0,
+			maxAttempts:      3,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "retryable error code",
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "AnotherOperationInProgress", "message": "busy"}}`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "retryable top-level error code with different casing",
+			statusCode:       http.StatusConflict,
+			body:             `{"code": "retryableerror", "message": "busy"}`,
+			failures:         1,
+			maxAttempts:      3,
+			expectedRequests: 2,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "non-retryable error code",
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "Conflict", "message": "nope"}}`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusConflict,
+		},
+		{
+			name:             "non-json body",
+			statusCode:       http.StatusBadRequest,
+			body:             `bad request`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusBadRequest,
+		},
+		{
+			name:             "max attempts exceeded",
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "AnotherOperationInProgress", "message": "busy"}}`,
+			failures:         10,
+			maxAttempts:      2,
+			expectedRequests: 3,
+			expectedStatus:   http.StatusConflict,
+			expectError:      true,
+		},
+		{
+			name:             "post isn't retried",
+			method:           http.MethodPost,
+			path:             "/restart",
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "AnotherOperationInProgress", "message": "busy"}}`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusConflict,
+		},
+		{
+			name:             "post for a list action is retried",
+			method:           http.MethodPost,
+			path:             "/listKeys",
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "AnotherOperationInProgress", "message": "busy"}}`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 3,
+			expectedStatus:   http.StatusOK,
+		},
+		{
+			name:             "patch isn't retried",
+			method:           http.MethodPatch,
+			statusCode:       http.StatusConflict,
+			body:             `{"error": {"code": "AnotherOperationInProgress", "message": "busy"}}`,
+			failures:         2,
+			maxAttempts:      3,
+			expectedRequests: 1,
+			expectedStatus:   http.StatusConflict,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			count := atomic.AddInt32(&requests, 1)
+			if count <= v.failures {
+				w.WriteHeader(v.statusCode)
+				fmt.Fprint(w, v.body)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+			fmt.Fprint(w, `{}`)
+		}))
+
+		policy := RetryPolicy{
+			MaxAttempts:         v.maxAttempts,
+			MinDelay:            time.Millisecond,
+			MaxDelay:            5 * time.Millisecond,
+			RetryableErrorCodes: DefaultRetryableErrorCodes,
+		}
+		sender := autorest.DecorateSender(server.Client(), policy.WithRetries())
+		method := v.method
+		if method == "" {
+			method = http.MethodPut
+		}
+		req, _ := http.NewRequest(method, server.URL+v.path, nil)
+		resp, err := sender.Do(req)
+		server.Close()
+		if err != nil && !v.expectError {
+			t.Fatalf("sending request: %+v", err)
+		}
+		if err == nil && v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if resp.StatusCode != v.expectedStatus {
+			t.Fatalf("expected the status code %d but got %d", v.expectedStatus, resp.StatusCode)
+		}
+		if requests != v.expectedRequests {
+			t.Fatalf("expected %d requests but got %d", v.expectedRequests, requests)
+		}
+	}
+}
+
+func TestRetryPolicy_WithRetriesDeadline(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"error": {"code": "AnotherOperationInProgress"}}`)
+	}))
+	defer server.Close()
+
+	policy := DefaultRetryPolicy()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	sender := autorest.DecorateSender(server.Client(), policy.WithRetries())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	resp, err := sender.Do(req)
+	if err == nil {
+		t.Fatalf("expected an error since the request can't be retried but didn't get one")
+	}
+	if resp.StatusCode != http.StatusConflict {
+		t.Fatalf("expected the status code %d but got %d", http.StatusConflict, resp.StatusCode)
+	}
+	if requests != 1 {
+		t.Fatalf("expected 1 request since the Retry-After exceeds the deadline but got %d", requests)
+	}
+}
+
+func TestRetryPolicy_DelayForAttempt(t *testing.T) {
+	policy := RetryPolicy{
+		MinDelay: 10 * time.Second,
+		MaxDelay: 60 * time.Second,
+	}
+
+	testData := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		expected   time.Duration
+	}{
+		{
+			name:     "first attempt",
+			attempt:  0,
+			expected: 10 * time.Second,
+		},
+		{
+			name:     "exponential backoff",
+			attempt:  2,
+			expected: 40 * time.Second,
+		},
+		{
+			name:     "capped at max delay",
+			attempt:  10,
+			expected: 60 * time.Second,
+		},
+		{
+			name:       "retry after in seconds",
+			retryAfter: "5",
+			attempt:    3,
+			expected:   5 * time.Second,
+		},
+		{
+			name:       "retry after as a date in the past",
+			retryAfter: "Sun, 06 Nov 1994 08:49:37 GMT",
+			attempt:    0,
+			expected:   10 * time.Second,
+		},
+		{
+			name:       "retry after as an rfc850 date in the past",
+			retryAfter: "Sunday, 06-Nov-94 08:49:37 GMT",
+			attempt:    1,
+			expected:   20 * time.Second,
+		},
+		{
+			name:       "invalid retry after",
+			retryAfter: "soon",
+			attempt:    1,
+			expected:   20 * time.Second,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		if v.retryAfter != "" {
+			resp.Header.Set("Retry-After", v.retryAfter)
+		}
+
+		actual := policy.delayForAttempt(resp, v.attempt)
+		if actual != v.expected {
+			t.Fatalf("expected a delay of %s but got %s", v.expected, actual)
+		}
+	}
+}
+
+func TestRetryPolicy_DelayForAttemptRetryAfterDate(t *testing.T) {
+	policy := RetryPolicy{
+		MinDelay: time.Second,
+		MaxDelay: time.Second,
+	}
+
+	// `Retry-After` can be in any of the formats supported by HTTP
+	retryAt := time.Now().Add(time.Hour).UTC()
+	for _, format := range []string{http.TimeFormat, time.RFC850, time.ANSIC} {
+		t.Logf("[DEBUG] Testing %q..", format)
+
+		resp := &http.Response{
+			Header: http.Header{},
+		}
+		resp.Header.Set("Retry-After", retryAt.Format(format))
+
+		actual := policy.delayForAttempt(resp, 0)
+		if actual < 55*time.Minute || actual > time.Hour {
+			t.Fatalf("expected a delay of around an hour but got %s", actual)
+		}
+	}
+}
+
+func TestRetryPolicy_ConfigureClient(t *testing.T) {
+	client := autorest.NewClientWithUserAgent("")
+	RetryPolicy{}.ConfigureClient(&client)
+	if client.RetryAttempts != autorest.DefaultRetryAttempts {
+		t.Fatalf("expected a zero-value policy to leave RetryAttempts as %d but got %d", autorest.DefaultRetryAttempts, client.RetryAttempts)
+	}
+
+	RetryPolicy{MaxAttempts: 7, MinDelay: 2 * time.Second}.ConfigureClient(&client)
+	if client.RetryAttempts != 7 {
+		t.Fatalf("expected RetryAttempts to be 7 but got %d", client.RetryAttempts)
+	}
+	if client.RetryDuration != 2*time.Second {
+		t.Fatalf("expected RetryDuration to be 2s but got %s", client.RetryDuration)
+	}
+}
@@ -10,6 +10,9 @@ func Default() UserFeatures {
 		LogAnalyticsWorkspace: LogAnalyticsWorkspaceFeatures{
 			PermanentlyDeleteOnDestroy: false,
 		},
+		NameAvailability: NameAvailabilityFeatures{
+			CheckDuringPlan: false,
+		},
 		Network: NetworkFeatures{
 			RelaxedLocking: false,
 		},
@@ -7,6 +7,7 @@ type UserFeatures struct {
 	Network                NetworkFeatures
 	TemplateDeployment     TemplateDeploymentFeatures
 	LogAnalyticsWorkspace  LogAnalyticsWorkspaceFeatures
+	NameAvailability       NameAvailabilityFeatures
 }
 
 type VirtualMachineFeatures struct {
@@ -36,3 +37,7 @@ type TemplateDeploymentFeatures struct {
 type LogAnalyticsWorkspaceFeatures struct {
 	PermanentlyDeleteOnDestroy bool
 }
+
+type NameAvailabilityFeatures struct {
+	CheckDuringPlan bool
+}
@@ -0,0 +1,79 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+)
+
+// Holder describes who holds (or is waiting for) a lock, which is logged to help diagnose
+// where a lock is held for an extended period of time (for example when an apply hangs)
+type Holder struct {
+	// ResourceType is the type of the resource which holds the lock, e.g. `azurerm_subnet`
+	ResourceType string
+
+	// ResourceID is the ID of the resource which holds the lock, which can be empty during creation
+	ResourceID string
+
+	// Caller is the function which acquired the lock
+	Caller string
+}
+
+func (h Holder) String() string {
+	if h.ResourceType == "" {
+		if h.Caller == "" {
+			return "an unknown caller"
+		}
+		return h.Caller
+	}
+
+	out := h.ResourceType
+	if h.ResourceID != "" {
+		out = fmt.Sprintf("%s %q", out, h.ResourceID)
+	}
+	if h.Caller != "" {
+		out = fmt.Sprintf("%s (%s)", out, h.Caller)
+	}
+	return out
+}
+
+type holderContextKey struct{}
+
+// WithHolder returns a copy of the context specifying the resource acquiring locks using it,
+// which is used to identify the holder of a lock in the logs and in any timeout errors
+func WithHolder(ctx context.Context, resourceType string, resourceId string) context.Context {
+	return context.WithValue(ctx, holderContextKey{}, Holder{
+		ResourceType: resourceType,
+		ResourceID:   resourceId,
+	})
+}
+
+func holderFromContext(ctx context.Context) Holder {
+	holder := Holder{}
+	if v, ok := ctx.Value(holderContextKey{}).(Holder); ok {
+		holder = v
+	}
+
+	holder.Caller = callerOutsidePackage()
+	return holder
+}
+
+// callerOutsidePackage returns the name and location of the first function in the call stack
+// outside of this package, which is the function acquiring the lock
+func callerOutsidePackage() string {
+	pcs := make([]uintptr, 16)
+	n := runtime.Callers(2, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/internal/locks.") || strings.HasSuffix(frame.File, "_test.go") {
+			function := frame.Function[strings.LastIndex(frame.Function, "/")+1:]
+			return fmt.Sprintf("%s (%s:%d)", function, filepath.Base(frame.File), frame.Line)
+		}
+		if !more {
+			return ""
+		}
+	}
+}
@@ -9,10 +9,13 @@ func ByID(id string) {
 
 // handle the case of using the same name for different kinds of resources
 func ByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Lock(updatedName)
+	armMutexKV.Lock(nameKey(name, resourceType))
 }
 
+// MultipleByName locks each of the specified names in the order they're specified
+//
+// NOTE: this can deadlock when another caller locks the same names in a different order,
+// new code should use Acquire which locks each name in a canonical order instead
 func MultipleByName(names *[]string, resourceType string) {
 	newSlice := removeDuplicatesFromStringArray(*names)
 
@@ -26,8 +29,7 @@ func UnlockByID(id string) {
 }
 
 func UnlockByName(name string, resourceType string) {
-	updatedName := resourceType + "." + name
-	armMutexKV.Unlock(updatedName)
+	armMutexKV.Unlock(nameKey(name, resourceType))
 }
 
 func UnlockMultipleByName(names *[]string, resourceType string) {
@@ -37,3 +39,7 @@ func UnlockMultipleByName(names *[]string, resourceType string) {
 		UnlockByName(name, resourceType)
 	}
 }
+
+func nameKey(name string, resourceType string) string {
+	return resourceType + "." + name
+}
@@ -0,0 +1,122 @@
+package locks
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Key is the name of a lock, which is either a Resource ID (see IDKey) or
+// the name of a resource of a given type (see NameKey)
+type Key string
+
+// IDKey returns the Key for the specified Resource ID, which is the same lock used by ByID
+func IDKey(id string) Key {
+	return Key(id)
+}
+
+// NameKey returns the Key for a resource of the specified type, which is the same lock used by ByName
+func NameKey(name string, resourceType string) Key {
+	return Key(nameKey(name, resourceType))
+}
+
+// NameKeys returns the Keys for multiple resources of the specified type
+func NameKeys(names []string, resourceType string) []Key {
+	out := make([]Key, 0)
+	for _, name := range names {
+		out = append(out, NameKey(name, resourceType))
+	}
+	return out
+}
+
+// Acquire locks each of the specified Keys, returning a function which releases all of them.
+//
+// Keys are de-duplicated and then locked in a canonical order, rather than the order they're specified
+// in - as such two callers locking the same Keys in a different order can't deadlock. Parent resources
+// are locked before their children (see resourceTypeLockOrder), then any remaining Keys are sorted.
+// Should the context be cancelled (or time out) before all of the Keys are locked, any Keys which
+// have been locked are released and an error is returned.
+//
+// Example Usage:
+//
+//	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName), locks.NameKey(id.Name, SubnetResourceName))
+//	if err != nil {
+//		return err
+//	}
+//	defer unlock()
+func Acquire(ctx context.Context, keys ...Key) (func(), error) {
+	return acquire(ctx, armMutexKV, keys...)
+}
+
+func acquire(ctx context.Context, kv *mutexKV, keys ...Key) (func(), error) {
+	sorted := sortedUniqueKeys(keys)
+	start := time.Now()
+
+	locked := make([]string, 0)
+	release := func() {
+		// release in the reverse order to acquisition
+		for i := len(locked) - 1; i >= 0; i-- {
+			kv.Unlock(locked[i])
+		}
+	}
+
+	for _, key := range sorted {
+		if err := kv.LockWithContext(ctx, key); err != nil {
+			release()
+			return nil, fmt.Errorf("acquiring the locks %q: %+v", sorted, err)
+		}
+		locked = append(locked, key)
+	}
+
+	log.Printf("[DEBUG] Acquired the locks %q after %s", sorted, time.Since(start))
+	return release, nil
+}
+
+// resourceTypeLockOrder is the order resources of these types are locked in, parents first - since some
+// resources are still locked individually (e.g. a Virtual Network and then a Subnet within it) this must
+// match the order those are locked in.
+var resourceTypeLockOrder = []string{
+	"azurerm_virtual_hub",
+	"azurerm_express_route_circuit",
+	"azurerm_virtual_network",
+	"azurerm_subnet",
+	"azurerm_network_interface",
+	"azurerm_network_security_group",
+	"azurerm_route_table",
+	"azurerm_nat_gateway",
+}
+
+// lockRank returns the position of the resource type for this key within resourceTypeLockOrder,
+// Keys for other resource types (and Resource IDs) are ranked after these
+func lockRank(key string) int {
+	if i := strings.Index(key, "."); i > 0 {
+		resourceType := key[:i]
+		for rank, v := range resourceTypeLockOrder {
+			if v == resourceType {
+				return rank
+			}
+		}
+	}
+
+	return len(resourceTypeLockOrder)
+}
+
+func sortedUniqueKeys(keys []Key) []string {
+	names := make([]string, 0)
+	for _, key := range keys {
+		names = append(names, string(key))
+	}
+
+	out := removeDuplicatesFromStringArray(names)
+	sort.SliceStable(out, func(i, j int) bool {
+		first, second := lockRank(out[i]), lockRank(out[j])
+		if first != second {
+			return first < second
+		}
+		return out[i] < out[j]
+	})
+	return out
+}
@@ -0,0 +1,134 @@
+package locks
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSortedUniqueKeys(t *testing.T) {
+	cases := []struct {
+		Name   string
+		Input  []Key
+		Result []string
+	}{
+		{
+			Name:   "empty",
+			Input:  []Key{},
+			Result: []string{},
+		},
+		{
+			Name:   "sorted",
+			Input:  []Key{NameKey("network1", "azurerm_virtual_network"), NameKey("subnet1", "azurerm_subnet")},
+			Result: []string{"azurerm_virtual_network.network1", "azurerm_subnet.subnet1"},
+		},
+		{
+			Name: "parents first",
+			Input: []Key{
+				NameKey("nsg1", "azurerm_network_security_group"),
+				NameKey("nic1", "azurerm_network_interface"),
+				NameKey("subnet2", "azurerm_subnet"),
+				NameKey("firewall1", "azurerm_firewall"),
+				NameKey("subnet1", "azurerm_subnet"),
+				NameKey("network1", "azurerm_virtual_network"),
+			},
+			Result: []string{
+				"azurerm_virtual_network.network1",
+				"azurerm_subnet.subnet1",
+				"azurerm_subnet.subnet2",
+				"azurerm_network_interface.nic1",
+				"azurerm_network_security_group.nsg1",
+				"azurerm_firewall.firewall1",
+			},
+		},
+		{
+			Name: "duplicates",
+			Input: []Key{
+				NameKey("subnet1", "azurerm_subnet"),
+				IDKey("/subscriptions/00000000-0000-0000-0000-000000000000"),
+				NameKey("subnet1", "azurerm_subnet"),
+			},
+			Result: []string{"azurerm_subnet.subnet1", "/subscriptions/00000000-0000-0000-0000-000000000000"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			actual := sortedUniqueKeys(tc.Input)
+			if !reflect.DeepEqual(actual, tc.Result) {
+				t.Fatalf("Expected %+v but got %+v", tc.Result, actual)
+			}
+		})
+	}
+}
+
+func TestAcquire_DifferentOrders(t *testing.T) {
+	kv := NewMutexKV()
+	first := NameKey("network1", "azurerm_virtual_network")
+	second := NameKey("subnet1", "azurerm_subnet")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// locking the same keys in different orders would deadlock without a canonical order
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unlock, err := acquire(ctx, kv, first, second)
+			if err != nil {
+				t.Errorf("acquiring locks: %+v", err)
+				return
+			}
+			unlock()
+		}()
+		go func() {
+			defer wg.Done()
+			unlock, err := acquire(ctx, kv, second, first)
+			if err != nil {
+				t.Errorf("acquiring locks: %+v", err)
+				return
+			}
+			unlock()
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAcquire_Timeout(t *testing.T) {
+	kv := NewMutexKV()
+	first := NameKey("network1", "azurerm_virtual_network")
+	second := NameKey("subnet1", "azurerm_subnet")
+
+	// hold the lock for the Virtual Network, which is sorted after the Subnet
+	kv.Lock(string(first))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := acquire(ctx, kv, first, second); err == nil {
+		t.Fatalf("expected an error when the context times out but didn't get one")
+	}
+
+	// the lock for the Subnet should have been released
+	ctx, cancel = context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	unlock, err := acquire(ctx, kv, second)
+	if err != nil {
+		t.Fatalf("expected the Subnet lock to be released but got: %+v", err)
+	}
+	unlock()
+	kv.Unlock(string(first))
+}
+
+func TestContextMutex_UnlockOfUnlockedMutex(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic when unlocking an unlocked mutex")
+		}
+	}()
+
+	newContextMutex().Unlock()
+}
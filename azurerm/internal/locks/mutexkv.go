@@ -1,48 +1,255 @@
 package locks
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"sort"
 	"sync"
+	"time"
 )
 
+// lockDiagnosticsInterval is how frequently locks which have been held for longer than this are logged
+var lockDiagnosticsInterval = time.Minute
+
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
 type mutexKV struct {
 	lock  sync.Mutex
-	store map[string]*sync.Mutex
+	store map[string]*contextMutex
+
+	// held is the number of locks which are held (or being waited on), whilst this is non-zero
+	// the locks which have been held for an extended period of time are periodically logged
+	held       int
+	monitoring bool
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
-	log.Printf("[DEBUG] Locking %q", key)
-	m.get(key).Lock()
-	log.Printf("[DEBUG] Locked %q", key)
+	holder := Holder{
+		Caller: callerOutsidePackage(),
+	}
+	log.Printf("[DEBUG] Locking %q for %s", key, holder)
+	m.trackHeld(1)
+	m.get(key).Lock(holder)
+	log.Printf("[DEBUG] Locked %q for %s", key, holder)
+}
+
+// LockWithContext locks the mutex for the given key, returning an error if the context
+// is cancelled (or times out) before the lock can be acquired. When no error is returned
+// the Caller is responsible for calling Unlock for the same key
+func (m *mutexKV) LockWithContext(ctx context.Context, key string) error {
+	holder := holderFromContext(ctx)
+	log.Printf("[DEBUG] Locking %q for %s", key, holder)
+
+	start := time.Now()
+	m.trackHeld(1)
+	if err := m.get(key).LockWithContext(ctx, holder); err != nil {
+		m.trackHeld(-1)
+		current, acquired, ok := m.get(key).Holder()
+		if !ok {
+			return fmt.Errorf("waiting %s for the lock %q: %+v", time.Since(start).Round(time.Second), key, err)
+		}
+
+		return fmt.Errorf("waiting %s for the lock %q which has been held by %s for %s: %+v", time.Since(start).Round(time.Second), key, current, time.Since(acquired).Round(time.Second), err)
+	}
+
+	log.Printf("[DEBUG] Locked %q for %s after %s", key, holder, time.Since(start))
+	return nil
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
 	log.Printf("[DEBUG] Unlocking %q", key)
 	m.get(key).Unlock()
+	m.trackHeld(-1)
 	log.Printf("[DEBUG] Unlocked %q", key)
 }
 
 // Returns a mutex for the given key, no guarantee of its lock status
-func (m *mutexKV) get(key string) *sync.Mutex {
+func (m *mutexKV) get(key string) *contextMutex {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 	mutex, ok := m.store[key]
 	if !ok {
-		mutex = &sync.Mutex{}
+		mutex = newContextMutex()
 		m.store[key] = mutex
 	}
 	return mutex
 }
 
+// trackHeld updates the number of locks which are held, starting to log any long-held locks
+// when the first is acquired - which stops once none are held
+func (m *mutexKV) trackHeld(delta int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.held += delta
+	if m.held > 0 && !m.monitoring {
+		m.monitoring = true
+		go m.logLongHeldLocks(lockDiagnosticsInterval)
+	}
+}
+
+// heldLock describes a lock which is currently held
+type heldLock struct {
+	key      string
+	holder   Holder
+	acquired time.Time
+	waiting  int
+}
+
+// heldLocks returns the locks which have been held for at least the specified duration, longest-held first
+func (m *mutexKV) heldLocks(minimumDuration time.Duration) []heldLock {
+	m.lock.Lock()
+	mutexes := make(map[string]*contextMutex, len(m.store))
+	for k, v := range m.store {
+		mutexes[k] = v
+	}
+	m.lock.Unlock()
+
+	out := make([]heldLock, 0)
+	now := time.Now()
+	for key, mutex := range mutexes {
+		holder, acquired, ok := mutex.Holder()
+		if !ok || now.Sub(acquired) < minimumDuration {
+			continue
+		}
+
+		out = append(out, heldLock{
+			key:      key,
+			holder:   holder,
+			acquired: acquired,
+			waiting:  mutex.Waiting(),
+		})
+	}
+
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].acquired.Equal(out[j].acquired) {
+			return out[i].key < out[j].key
+		}
+		return out[i].acquired.Before(out[j].acquired)
+	})
+	return out
+}
+
+// logLongHeldLocks periodically logs the locks which have been held for longer than the interval,
+// until no locks are held
+func (m *mutexKV) logLongHeldLocks(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for _, v := range m.heldLocks(interval) {
+			log.Printf("[DEBUG] Lock %q has been held by %s for %s (%d waiting)", v.key, v.holder, time.Since(v.acquired).Round(time.Second), v.waiting)
+		}
+
+		if m.stopMonitoringIfNoneHeld() {
+			return
+		}
+	}
+}
+
+// stopMonitoringIfNoneHeld returns whether logging long-held locks should stop, since none are held
+func (m *mutexKV) stopMonitoringIfNoneHeld() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.held > 0 {
+		return false
+	}
+
+	m.monitoring = false
+	return true
+}
+
 // Returns a properly initialized mutexKV
 func NewMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store: make(map[string]*contextMutex),
 	}
 }
+
+// contextMutex is a mutual exclusion lock which, unlike a sync.Mutex, can stop waiting
+// for the lock when a context is cancelled - and which tracks who currently holds it
+type contextMutex struct {
+	ch chan struct{}
+
+	// lock protects the fields below
+	lock     sync.Mutex
+	holder   *Holder
+	acquired time.Time
+	waiting  int
+}
+
+func newContextMutex() *contextMutex {
+	return &contextMutex{
+		ch: make(chan struct{}, 1),
+	}
+}
+
+func (m *contextMutex) Lock(holder Holder) {
+	m.setWaiting(1)
+	m.ch <- struct{}{}
+	m.setHolder(&holder)
+	m.setWaiting(-1)
+}
+
+func (m *contextMutex) LockWithContext(ctx context.Context, holder Holder) error {
+	m.setWaiting(1)
+	defer m.setWaiting(-1)
+
+	select {
+	case m.ch <- struct{}{}:
+		m.setHolder(&holder)
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (m *contextMutex) Unlock() {
+	m.setHolder(nil)
+
+	select {
+	case <-m.ch:
+	default:
+		panic("locks: unlock of unlocked mutex")
+	}
+}
+
+// Holder returns the current holder of this lock and when it was acquired, if it's held
+func (m *contextMutex) Holder() (Holder, time.Time, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if m.holder == nil {
+		return Holder{}, time.Time{}, false
+	}
+	return *m.holder, m.acquired, true
+}
+
+// Waiting returns the number of callers waiting to acquire this lock
+func (m *contextMutex) Waiting() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	return m.waiting
+}
+
+func (m *contextMutex) setHolder(holder *Holder) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.holder = holder
+	m.acquired = time.Now()
+}
+
+func (m *contextMutex) setWaiting(delta int) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.waiting += delta
+}
@@ -0,0 +1,146 @@
+package locks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMutexKV_HolderTracking(t *testing.T) {
+	kv := NewMutexKV()
+	ctx := WithHolder(context.Background(), "azurerm_subnet", "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1")
+
+	if err := kv.LockWithContext(ctx, "azurerm_virtual_network.network1"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+
+	held := kv.heldLocks(0)
+	if len(held) != 1 {
+		t.Fatalf("expected 1 held lock but got %d", len(held))
+	}
+	if held[0].key != "azurerm_virtual_network.network1" {
+		t.Fatalf("expected the key `azurerm_virtual_network.network1` but got %q", held[0].key)
+	}
+	if held[0].holder.ResourceType != "azurerm_subnet" {
+		t.Fatalf("expected the holder to be an `azurerm_subnet` but got %q", held[0].holder.ResourceType)
+	}
+	if !strings.Contains(held[0].holder.Caller, "TestMutexKV_HolderTracking") {
+		t.Fatalf("expected the caller to be this test but got %q", held[0].holder.Caller)
+	}
+
+	// locks held for less than the minimum duration aren't returned
+	if held := kv.heldLocks(time.Hour); len(held) != 0 {
+		t.Fatalf("expected no locks held for over an hour but got %d", len(held))
+	}
+
+	kv.Unlock("azurerm_virtual_network.network1")
+	if held := kv.heldLocks(0); len(held) != 0 {
+		t.Fatalf("expected no held locks once unlocked but got %d", len(held))
+	}
+}
+
+func TestMutexKV_MonitoringStopsOnceNoLocksAreHeld(t *testing.T) {
+	interval := lockDiagnosticsInterval
+	lockDiagnosticsInterval = 10 * time.Millisecond
+	defer func() {
+		lockDiagnosticsInterval = interval
+	}()
+
+	kv := NewMutexKV()
+	isMonitoring := func() bool {
+		kv.lock.Lock()
+		defer kv.lock.Unlock()
+		return kv.monitoring
+	}
+
+	if isMonitoring() {
+		t.Fatalf("expected no monitoring before a lock is held")
+	}
+
+	kv.Lock("azurerm_virtual_network.network1")
+	if !isMonitoring() {
+		t.Fatalf("expected monitoring whilst a lock is held")
+	}
+
+	kv.Unlock("azurerm_virtual_network.network1")
+	deadline := time.Now().Add(5 * time.Second)
+	for isMonitoring() {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected monitoring to stop once no locks are held")
+		}
+		time.Sleep(lockDiagnosticsInterval)
+	}
+}
+
+func TestMutexKV_LockWithContextTimeout(t *testing.T) {
+	kv := NewMutexKV()
+	holderCtx := WithHolder(context.Background(), "azurerm_subnet", "subnet1")
+	if err := kv.LockWithContext(holderCtx, "azurerm_virtual_network.network1"); err != nil {
+		t.Fatalf("locking: %+v", err)
+	}
+	defer kv.Unlock("azurerm_virtual_network.network1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	err := kv.LockWithContext(ctx, "azurerm_virtual_network.network1")
+	if err == nil {
+		t.Fatalf("expected an error when the context times out but didn't get one")
+	}
+
+	// the error should describe who holds the lock
+	for _, expected := range []string{"azurerm_virtual_network.network1", `azurerm_subnet "subnet1"`, context.DeadlineExceeded.Error()} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Fatalf("expected the error to contain %q but got %q", expected, err.Error())
+		}
+	}
+
+	if waiting := kv.get("azurerm_virtual_network.network1").Waiting(); waiting != 0 {
+		t.Fatalf("expected no callers to be waiting once timed out but got %d", waiting)
+	}
+}
+
+func TestHolder_String(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Input    Holder
+		Expected string
+	}{
+		{
+			Name:     "empty",
+			Input:    Holder{},
+			Expected: "an unknown caller",
+		},
+		{
+			Name: "caller only",
+			Input: Holder{
+				Caller: "network.resourceSubnetDelete (subnet_resource.go:123)",
+			},
+			Expected: "network.resourceSubnetDelete (subnet_resource.go:123)",
+		},
+		{
+			Name: "resource type without an id",
+			Input: Holder{
+				ResourceType: "azurerm_subnet",
+				Caller:       "network.resourceSubnetCreate (subnet_resource.go:123)",
+			},
+			Expected: "azurerm_subnet (network.resourceSubnetCreate (subnet_resource.go:123))",
+		},
+		{
+			Name: "resource type and id",
+			Input: Holder{
+				ResourceType: "azurerm_subnet",
+				ResourceID:   "subnet1",
+			},
+			Expected: `azurerm_subnet "subnet1"`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if actual := tc.Input.String(); actual != tc.Expected {
+				t.Fatalf("Expected %q but got %q", tc.Expected, actual)
+			}
+		})
+	}
+}
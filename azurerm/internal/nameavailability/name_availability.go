@@ -0,0 +1,138 @@
+package nameavailability
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// Result is the result of checking whether a name is available
+type Result struct {
+	// Available specifies whether the name is available
+	Available bool
+
+	// Reason is the reason returned by Azure when the name isn't available, e.g. `AlreadyExists`
+	Reason string
+
+	// Message is the message returned by Azure when the name isn't available
+	Message string
+}
+
+// CheckFunc checks whether the specified name is available using the `CheckNameAvailability`
+// API for this Resource Type
+type CheckFunc func(ctx context.Context, client *clients.Client, name string) (*Result, error)
+
+// NameFunc returns the name which should be checked for availability - or an empty string
+// when this isn't known during the plan
+type NameFunc func(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceDiff) (string, error)
+
+// checkTimeout is the maximum duration of a single name availability check
+const checkTimeout = 5 * time.Minute
+
+// CustomizeDiff returns a CustomizeDiffFunc which checks that the name (from the `name` field) is
+// available when a new resource is being created, so that a name which is already taken is surfaced
+// during the plan rather than when the resource is provisioned.
+//
+// The resourceTypeName is the human-readable name of the Resource Type (e.g. `Storage Account`).
+//
+// This is opt-in via the `name_availability` block within the `features` block - and is best-effort,
+// when the availability can't be checked (e.g. due to permissions) this is logged and skipped.
+func CustomizeDiff(resourceTypeName string, check CheckFunc) pluginsdk.CustomizeDiffFunc {
+	return CustomizeDiffWithName(resourceTypeName, nameFromField, check)
+}
+
+// CustomizeDiffWithName returns a CustomizeDiffFunc which checks that the name returned from the
+// NameFunc is available when a new resource is being created - for resources where the name which
+// needs to be checked differs from the `name` field. See CustomizeDiff for more information.
+func CustomizeDiffWithName(resourceTypeName string, nameFunc NameFunc, check CheckFunc) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+		// only new resources need to be checked
+		if d.Id() != "" {
+			return nil
+		}
+
+		client, ok := meta.(*clients.Client)
+		if !ok || client == nil || !client.Features.NameAvailability.CheckDuringPlan {
+			return nil
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, checkTimeout)
+		defer cancel()
+
+		name, err := nameFunc(ctx, client, d)
+		if err != nil {
+			return err
+		}
+		if name == "" {
+			return nil
+		}
+
+		subscriptionId := ""
+		if client.Account != nil {
+			subscriptionId = client.Account.SubscriptionId
+		}
+		key := cacheKey(subscriptionId, resourceTypeName, name)
+		cached, err := client.NameAvailabilityCache.Check(key, func() (interface{}, error) {
+			result, err := check(ctx, client, name)
+			if err != nil {
+				return nil, err
+			}
+			if result == nil {
+				return nil, fmt.Errorf("checking the name availability returned no result")
+			}
+
+			return *result, nil
+		})
+		if err != nil {
+			log.Printf("[WARN] Unable to check whether the name %q is available for the %s - skipping: %+v", name, resourceTypeName, err)
+			return nil
+		}
+
+		result, ok := cached.(Result)
+		if !ok {
+			log.Printf("[WARN] Unable to check whether the name %q is available for the %s - skipping: expected the cached result to be a Result but got %T", name, resourceTypeName, cached)
+			return nil
+		}
+
+		return unavailableError(resourceTypeName, name, result)
+	}
+}
+
+// cacheKey returns the key used to cache the result - names are compared case-insensitively
+// since these are used as (part of) a DNS Name
+func cacheKey(subscriptionId, resourceTypeName, name string) string {
+	return strings.ToLower(fmt.Sprintf("%s/%s/%s", subscriptionId, resourceTypeName, name))
+}
+
+// nameFromField returns the value of the `name` field, when this is known
+func nameFromField(_ context.Context, _ *clients.Client, d *pluginsdk.ResourceDiff) (string, error) {
+	if !d.NewValueKnown("name") {
+		return "", nil
+	}
+
+	return d.Get("name").(string), nil
+}
+
+// unavailableError returns an error containing the reason returned from Azure when the name isn't available
+func unavailableError(resourceTypeName, name string, result Result) error {
+	if result.Available {
+		return nil
+	}
+
+	reason := result.Reason
+	if reason == "" {
+		reason = "Unknown"
+	}
+
+	message := fmt.Sprintf("the name %q isn't available for the %s (%s)", name, resourceTypeName, reason)
+	if result.Message != "" {
+		message = fmt.Sprintf("%s: %s", message, result.Message)
+	}
+
+	return fmt.Errorf("%s", message)
+}
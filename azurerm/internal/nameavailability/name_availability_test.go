@@ -0,0 +1,50 @@
+package nameavailability
+
+import (
+	"testing"
+)
+
+func TestUnavailableError(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    Result
+		Expected string
+	}{
+		{
+			Name: "Available",
+			Input: Result{
+				Available: true,
+			},
+			Expected: "",
+		},
+		{
+			Name: "Unavailable with Reason and Message",
+			Input: Result{
+				Available: false,
+				Reason:    "AlreadyExists",
+				Message:   "The storage account named example is already taken.",
+			},
+			Expected: `the name "example" isn't available for the Storage Account (AlreadyExists): The storage account named example is already taken.`,
+		},
+		{
+			Name: "Unavailable without a Reason",
+			Input: Result{
+				Available: false,
+			},
+			Expected: `the name "example" isn't available for the Storage Account (Unknown)`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		err := unavailableError("Storage Account", "example", v.Input)
+		actual := ""
+		if err != nil {
+			actual = err.Error()
+		}
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
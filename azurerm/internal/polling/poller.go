@@ -0,0 +1,341 @@
+package polling
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// DefaultPollInterval is the duration to wait between polls when the API doesn't return a `Retry-After` header
+const DefaultPollInterval = 10 * time.Second
+
+// deadlineMargin is how long before the context deadline the operation is last polled, so that there's time
+// for this request to complete
+const deadlineMargin = time.Second
+
+type strategy string
+
+const (
+	// strategyAsyncOperation polls the URI within the `Azure-AsyncOperation` header until its `status` is terminal
+	strategyAsyncOperation strategy = "Azure-AsyncOperation"
+
+	// strategyLocation polls the URI within the `Location` header until it no longer returns a `202 Accepted`
+	strategyLocation strategy = "Location"
+
+	// strategyProvisioningState polls the resource until its `provisioningState` is terminal
+	strategyProvisioningState strategy = "ProvisioningState"
+
+	// strategyNone is used when the operation completed immediately
+	strategyNone strategy = "None"
+)
+
+// Options allows customising how a LongRunningPoller polls
+type Options struct {
+	// PollInterval is the duration to wait between polls when the API doesn't return a `Retry-After` header,
+	// defaulting to DefaultPollInterval
+	PollInterval time.Duration
+
+	// DonePredicate optionally overrides how the Status of the operation is determined from each poll,
+	// which otherwise depends on how the API returned the operation
+	DonePredicate DonePredicate
+}
+
+// LongRunningPoller polls a Long Running Operation until it's completed
+type LongRunningPoller struct {
+	// HttpResponse is the latest HTTP Response
+	HttpResponse *http.Response
+
+	ctx     context.Context
+	client  autorest.Client
+	options Options
+
+	strategy   strategy
+	pollingUri string
+
+	// finalUri is the URI which is retrieved once the operation has succeeded, to obtain the result
+	finalUri string
+
+	// err is the error from the initial response, returned when polling
+	err error
+}
+
+// NewLongRunningPollerFromResponse creates a new LongRunningPoller from the HTTP Response
+func NewLongRunningPollerFromResponse(ctx context.Context, resp *http.Response, client autorest.Client) (LongRunningPoller, error) {
+	return NewLongRunningPollerFromResponseWithOptions(ctx, resp, client, Options{})
+}
+
+// NewLongRunningPollerFromResponseWithOptions creates a new LongRunningPoller from the HTTP Response, using the specified Options
+func NewLongRunningPollerFromResponseWithOptions(ctx context.Context, resp *http.Response, client autorest.Client, options Options) (LongRunningPoller, error) {
+	poller := LongRunningPoller{
+		HttpResponse: resp,
+		ctx:          ctx,
+		client:       client,
+		options:      options,
+	}
+	if poller.options.PollInterval <= 0 {
+		poller.options.PollInterval = DefaultPollInterval
+	}
+
+	if resp == nil || resp.Request == nil {
+		return poller, fmt.Errorf("the HTTP Response and the Request for it must be specified")
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent:
+	default:
+		return poller, fmt.Errorf("unexpected status code %d for the Long Running Operation: %s", resp.StatusCode, errorDetails(readBody(resp)))
+	}
+
+	method := resp.Request.Method
+	originalUri := resp.Request.URL.String()
+	isCreateOrUpdate := method == http.MethodPut || method == http.MethodPatch
+
+	if v := resp.Header.Get("Azure-AsyncOperation"); v != "" {
+		poller.strategy = strategyAsyncOperation
+		poller.pollingUri = v
+
+		// once the operation has succeeded the result is obtained from the resource when it's been created/updated,
+		// otherwise from the Location (when specified)
+		poller.finalUri = resp.Header.Get("Location")
+		if isCreateOrUpdate {
+			poller.finalUri = originalUri
+		}
+		return poller, poller.validateUris()
+	}
+
+	if v := resp.Header.Get("Location"); v != "" && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		poller.strategy = strategyLocation
+		poller.pollingUri = v
+
+		// once the operation has succeeded the result is obtained from the resource when it's been created/updated,
+		// otherwise it's the final response from the Location
+		if isCreateOrUpdate {
+			poller.finalUri = originalUri
+		}
+		return poller, poller.validateUris()
+	}
+
+	if resp.StatusCode == http.StatusAccepted && !isCreateOrUpdate {
+		return poller, fmt.Errorf("the Long Running Operation returned a 202 Accepted without a URI to poll")
+	}
+
+	if !isCreateOrUpdate || resp.StatusCode == http.StatusNoContent {
+		poller.strategy = strategyNone
+		return poller, nil
+	}
+
+	// otherwise the resource is polled until it's finished provisioning - which it may already have
+	poller.strategy = strategyProvisioningState
+	poller.pollingUri = originalUri
+	status, err := poller.donePredicate()(resp, readBody(resp))
+	if err != nil {
+		return poller, err
+	}
+	switch status {
+	case StatusSucceeded:
+		poller.strategy = strategyNone
+	case StatusFailed, StatusCanceled:
+		poller.strategy = strategyNone
+		poller.err = fmt.Errorf("the Long Running Operation %s: %s", strings.ToLower(string(status)), errorDetails(readBody(resp)))
+	}
+
+	return poller, nil
+}
+
+func (p LongRunningPoller) validateUris() error {
+	for _, uri := range []string{p.pollingUri, p.finalUri} {
+		if uri == "" {
+			continue
+		}
+
+		if _, err := url.ParseRequestURI(uri); err != nil {
+			return fmt.Errorf("parsing the polling URI %q: %+v", uri, err)
+		}
+	}
+
+	return nil
+}
+
+func (p LongRunningPoller) donePredicate() DonePredicate {
+	if p.options.DonePredicate != nil {
+		return p.options.DonePredicate
+	}
+
+	switch p.strategy {
+	case strategyAsyncOperation:
+		return AsyncOperationStatus
+	case strategyLocation:
+		return LocationStatus
+	}
+
+	return ProvisioningState
+}
+
+// PollUntilDone polls until this Long Running Operation is completed, the context is cancelled or its deadline is exceeded
+func (p *LongRunningPoller) PollUntilDone() error {
+	if p.err != nil {
+		return p.err
+	}
+
+	for p.strategy != strategyNone {
+		if err := p.wait(p.pollInterval()); err != nil {
+			return err
+		}
+
+		resp, err := p.get(p.pollingUri)
+		if err != nil {
+			return fmt.Errorf("polling the Long Running Operation: %+v", err)
+		}
+		p.HttpResponse = resp
+
+		if isTransient(resp.StatusCode) {
+			readBody(resp)
+			log.Printf("[DEBUG] Retrying polling the Long Running Operation which returned a %d", resp.StatusCode)
+			continue
+		}
+
+		body := readBody(resp)
+		status, err := p.donePredicate()(resp, body)
+		if err != nil {
+			return fmt.Errorf("determining the status of the Long Running Operation: %+v", err)
+		}
+
+		switch status {
+		case StatusInProgress:
+			continue
+
+		case StatusSucceeded:
+			p.strategy = strategyNone
+
+		default:
+			p.strategy = strategyNone
+			return fmt.Errorf("the Long Running Operation %s: %s", strings.ToLower(string(status)), errorDetails(body))
+		}
+	}
+
+	if p.finalUri != "" {
+		resp, err := p.get(p.finalUri)
+		if err != nil {
+			return fmt.Errorf("retrieving the result of the Long Running Operation: %+v", err)
+		}
+		p.HttpResponse = resp
+		p.finalUri = ""
+	}
+
+	return nil
+}
+
+// pollInterval returns the duration to wait before polling, from the `Retry-After` header of the latest response
+// (either as a number of seconds or a HTTP date) or the default
+func (p LongRunningPoller) pollInterval() time.Duration {
+	if p.HttpResponse == nil {
+		return p.options.PollInterval
+	}
+
+	v := p.HttpResponse.Header.Get("Retry-After")
+	if v == "" {
+		return p.options.PollInterval
+	}
+
+	if seconds, err := strconv.Atoi(v); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if date, err := http.ParseTime(v); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+		return 0
+	}
+
+	return p.options.PollInterval
+}
+
+// wait waits for the specified duration, returning an error if the context is cancelled or its deadline is exceeded first
+//
+// When the context deadline would be exceeded before the next poll, this instead waits until shortly before the
+// deadline (see deadlineMargin) so that the operation is polled once more, rather than giving up early.
+func (p LongRunningPoller) wait(delay time.Duration) error {
+	if deadline, ok := p.ctx.Deadline(); ok {
+		remaining := time.Until(deadline) - deadlineMargin
+		if remaining <= 0 {
+			return fmt.Errorf("waiting for the Long Running Operation to complete: the context deadline (%s) has been reached", deadline.Format(time.RFC3339))
+		}
+
+		if delay > remaining {
+			delay = remaining
+		}
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-p.ctx.Done():
+		return fmt.Errorf("waiting for the Long Running Operation to complete: %+v", p.ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+func (p LongRunningPoller) get(uri string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(p.ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building the request for %q: %+v", uri, err)
+	}
+
+	resp, err := p.client.Send(req)
+	if err != nil {
+		return resp, fmt.Errorf("sending the request to %q: %+v", uri, err)
+	}
+
+	return resp, nil
+}
+
+func isTransient(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// readBody reads the body of the HTTP Response, which is then replaced so that it can be read again
+func readBody(resp *http.Response) []byte {
+	if resp == nil || resp.Body == nil {
+		return nil
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		log.Printf("[DEBUG] Reading the body of the Long Running Operation: %+v", err)
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body
+}
+
+// errorDetails returns the details of the error within the specified body, if any
+func errorDetails(body []byte) string {
+	var v struct {
+		Error *struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil || v.Error == nil {
+		return string(body)
+	}
+
+	return fmt.Sprintf("Code=%q Message=%q", v.Error.Code, v.Error.Message)
+}
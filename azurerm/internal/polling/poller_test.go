@@ -0,0 +1,595 @@
+package polling
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+type testResponse struct {
+	statusCode int
+	headers    map[string]string
+	body       string
+}
+
+// testServer returns the configured responses for each path in order, repeating the last response
+type testServer struct {
+	*httptest.Server
+
+	lock      sync.Mutex
+	responses map[string][]testResponse
+	requests  map[string]int
+}
+
+func newTestServer(t *testing.T, responses map[string][]testResponse) *testServer {
+	server := &testServer{
+		responses: responses,
+		requests:  make(map[string]int),
+	}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.lock.Lock()
+		defer server.lock.Unlock()
+
+		key := r.Method + " " + r.URL.Path
+		available, ok := server.responses[key]
+		if !ok {
+			t.Errorf("unexpected request %q", key)
+			w.WriteHeader(http.StatusNotImplemented)
+			return
+		}
+
+		i := server.requests[key]
+		if i >= len(available) {
+			i = len(available) - 1
+		}
+		server.requests[key]++
+
+		response := available[i]
+		for k, v := range response.headers {
+			w.Header().Set(k, strings.ReplaceAll(v, "{server}", server.URL))
+		}
+		w.WriteHeader(response.statusCode)
+		w.Write([]byte(response.body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func (s *testServer) requestCount(key string) int {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.requests[key]
+}
+
+// start sends the initial request for the Long Running Operation, returning a Poller for it
+func (s *testServer) start(t *testing.T, ctx context.Context, method, path string, options Options) (LongRunningPoller, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.URL+path, nil)
+	if err != nil {
+		t.Fatalf("building the request: %+v", err)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("sending the request: %+v", err)
+	}
+
+	if options.PollInterval == 0 {
+		options.PollInterval = time.Millisecond
+	}
+	return NewLongRunningPollerFromResponseWithOptions(ctx, resp, autorest.Client{}, options)
+}
+
+func responseBody(t *testing.T, resp *http.Response) string {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading the body: %+v", err)
+	}
+	return string(body)
+}
+
+func TestPollerAsyncOperation(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"PUT /resource": {
+			{
+				statusCode: http.StatusCreated,
+				headers: map[string]string{
+					"Azure-AsyncOperation": "{server}/operations/1",
+					"Retry-After":          "0",
+				},
+				body: `{"properties": {"provisioningState": "Creating"}}`,
+			},
+		},
+		"GET /operations/1": {
+			{
+				statusCode: http.StatusOK,
+				headers: map[string]string{
+					"Retry-After": "0",
+				},
+				body: `{"status": "InProgress"}`,
+			},
+			{
+				statusCode: http.StatusOK,
+				body:       `{"status": "Running"}`,
+			},
+			{
+				statusCode: http.StatusOK,
+				body:       `{"status": "Succeeded"}`,
+			},
+		},
+		"GET /resource": {
+			{
+				statusCode: http.StatusOK,
+				body:       `{"name": "resource"}`,
+			},
+		},
+	})
+
+	poller, err := server.start(t, context.TODO(), http.MethodPut, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+
+	if count := server.requestCount("GET /operations/1"); count != 3 {
+		t.Fatalf("expected the operation to be polled 3 times but got %d", count)
+	}
+	if count := server.requestCount("GET /resource"); count != 1 {
+		t.Fatalf("expected the resource to be retrieved once but got %d", count)
+	}
+	if body := responseBody(t, poller.HttpResponse); body != `{"name": "resource"}` {
+		t.Fatalf("expected the HttpResponse to be the resource but got %q", body)
+	}
+}
+
+func TestPollerAsyncOperationFailed(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"POST /resource/action": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Azure-AsyncOperation": "{server}/operations/1",
+				},
+			},
+		},
+		"GET /operations/1": {
+			{
+				statusCode: http.StatusOK,
+				body:       `{"status": "Failed", "error": {"code": "Conflict", "message": "Something went wrong"}}`,
+			},
+		},
+	})
+
+	poller, err := server.start(t, context.TODO(), http.MethodPost, "/resource/action", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+
+	err = poller.PollUntilDone()
+	if err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+	if !strings.Contains(err.Error(), `Message="Something went wrong"`) {
+		t.Fatalf("expected the error to contain the message but got %q", err.Error())
+	}
+}
+
+func TestPollerLocation(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"DELETE /resource": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location": "{server}/operationResults/1",
+				},
+			},
+		},
+		"GET /operationResults/1": {
+			{
+				statusCode: http.StatusAccepted,
+			},
+			{
+				statusCode: http.StatusServiceUnavailable,
+			},
+			{
+				statusCode: http.StatusNoContent,
+			},
+		},
+	})
+
+	poller, err := server.start(t, context.TODO(), http.MethodDelete, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+
+	if count := server.requestCount("GET /operationResults/1"); count != 3 {
+		t.Fatalf("expected the operation to be polled 3 times but got %d", count)
+	}
+	if poller.HttpResponse.StatusCode != http.StatusNoContent {
+		t.Fatalf("expected the latest status code to be 204 but got %d", poller.HttpResponse.StatusCode)
+	}
+}
+
+func TestPollerProvisioningState(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"PUT /resource": {
+			{
+				statusCode: http.StatusCreated,
+				body:       `{"properties": {"provisioningState": "Creating"}}`,
+			},
+		},
+		"GET /resource": {
+			{
+				statusCode: http.StatusOK,
+				body:       `{"properties": {"provisioningState": "Updating"}}`,
+			},
+			{
+				statusCode: http.StatusOK,
+				body:       `{"properties": {"provisioningState": "succeeded"}}`,
+			},
+		},
+	})
+
+	poller, err := server.start(t, context.TODO(), http.MethodPut, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+
+	if count := server.requestCount("GET /resource"); count != 2 {
+		t.Fatalf("expected the resource to be polled 2 times but got %d", count)
+	}
+	if body := responseBody(t, poller.HttpResponse); body != `{"properties": {"provisioningState": "succeeded"}}` {
+		t.Fatalf("expected the HttpResponse to be the resource but got %q", body)
+	}
+}
+
+func TestPollerProvisioningStateFailed(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"PUT /resource": {
+			{
+				statusCode: http.StatusOK,
+				body:       `{"properties": {"provisioningState": "Failed"}}`,
+			},
+		},
+	})
+
+	poller, err := server.start(t, context.TODO(), http.MethodPut, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+}
+
+func TestPollerCompletedImmediately(t *testing.T) {
+	testData := []struct {
+		method     string
+		statusCode int
+		body       string
+	}{
+		{
+			method:     http.MethodPut,
+			statusCode: http.StatusOK,
+			body:       `{"properties": {"provisioningState": "Succeeded"}}`,
+		},
+		{
+			// no provisioningState
+			method:     http.MethodPut,
+			statusCode: http.StatusCreated,
+			body:       `{"name": "resource"}`,
+		},
+		{
+			method:     http.MethodDelete,
+			statusCode: http.StatusOK,
+		},
+		{
+			method:     http.MethodDelete,
+			statusCode: http.StatusNoContent,
+		},
+		{
+			method:     http.MethodPost,
+			statusCode: http.StatusOK,
+			body:       `{"keys": []}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s returning %d", v.method, v.statusCode)
+
+		server := newTestServer(t, map[string][]testResponse{
+			v.method + " /resource": {
+				{
+					statusCode: v.statusCode,
+					body:       v.body,
+				},
+			},
+		})
+
+		poller, err := server.start(t, context.TODO(), v.method, "/resource", Options{})
+		if err != nil {
+			t.Fatalf("creating the poller: %+v", err)
+		}
+		if err := poller.PollUntilDone(); err != nil {
+			t.Fatalf("polling: %+v", err)
+		}
+		if body := responseBody(t, poller.HttpResponse); body != v.body {
+			t.Fatalf("expected the HttpResponse to be the initial response %q but got %q", v.body, body)
+		}
+	}
+}
+
+func TestPollerInvalidInitialResponse(t *testing.T) {
+	testData := []struct {
+		method     string
+		statusCode int
+		headers    map[string]string
+	}{
+		{
+			// nothing to poll
+			method:     http.MethodPost,
+			statusCode: http.StatusAccepted,
+		},
+		{
+			method:     http.MethodPut,
+			statusCode: http.StatusBadRequest,
+		},
+		{
+			method:     http.MethodDelete,
+			statusCode: http.StatusAccepted,
+			headers: map[string]string{
+				"Location": "not a uri",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %s returning %d", v.method, v.statusCode)
+
+		server := newTestServer(t, map[string][]testResponse{
+			v.method + " /resource": {
+				{
+					statusCode: v.statusCode,
+					headers:    v.headers,
+					body:       `{"error": {"code": "BadRequest", "message": "Invalid"}}`,
+				},
+			},
+		})
+
+		if _, err := server.start(t, context.TODO(), v.method, "/resource", Options{}); err == nil {
+			t.Fatal("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestPollerDonePredicate(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"POST /resource/start": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location": "{server}/resource",
+				},
+			},
+		},
+		"GET /resource": {
+			{
+				statusCode: http.StatusOK,
+				body:       `{"state": "Starting"}`,
+			},
+			{
+				statusCode: http.StatusOK,
+				body:       `{"state": "Running"}`,
+			},
+		},
+	})
+
+	running := func(resp *http.Response, body []byte) (Status, error) {
+		if strings.Contains(string(body), `"Running"`) {
+			return StatusSucceeded, nil
+		}
+		return StatusInProgress, nil
+	}
+
+	poller, err := server.start(t, context.TODO(), http.MethodPost, "/resource/start", Options{DonePredicate: running})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+	if err := poller.PollUntilDone(); err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+
+	if count := server.requestCount("GET /resource"); count != 2 {
+		t.Fatalf("expected the resource to be polled 2 times but got %d", count)
+	}
+}
+
+func TestPollerContextDeadline(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"DELETE /resource": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location":    "{server}/operationResults/1",
+					"Retry-After": "60",
+				},
+			},
+		},
+		"GET /operationResults/1": {
+			{
+				statusCode: http.StatusNoContent,
+			},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+	defer cancel()
+
+	poller, err := server.start(t, ctx, http.MethodDelete, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+
+	// whilst the deadline would be exceeded before the next poll, the operation should be polled once more before it
+	started := time.Now()
+	if err := poller.PollUntilDone(); err != nil {
+		t.Fatalf("polling: %+v", err)
+	}
+	if elapsed := time.Since(started); elapsed > 3*time.Second {
+		t.Fatalf("expected polling to complete before the deadline but it took %s", elapsed)
+	}
+	if count := server.requestCount("GET /operationResults/1"); count != 1 {
+		t.Fatalf("expected the operation to be polled once but got %d", count)
+	}
+}
+
+func TestPollerContextDeadlineExceeded(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"DELETE /resource": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location":    "{server}/operationResults/1",
+					"Retry-After": "60",
+				},
+			},
+		},
+		"GET /operationResults/1": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location":    "{server}/operationResults/1",
+					"Retry-After": "60",
+				},
+			},
+		},
+	})
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 3*time.Second)
+	defer cancel()
+
+	poller, err := server.start(t, ctx, http.MethodDelete, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+
+	// the operation is polled once more before the deadline, after which there's no time to poll again
+	started := time.Now()
+	if err := poller.PollUntilDone(); err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+	if elapsed := time.Since(started); elapsed > 3*time.Second {
+		t.Fatalf("expected polling to return before the deadline but it took %s", elapsed)
+	}
+	if count := server.requestCount("GET /operationResults/1"); count != 1 {
+		t.Fatalf("expected the operation to be polled once but got %d", count)
+	}
+}
+
+func TestPollerContextCancelled(t *testing.T) {
+	server := newTestServer(t, map[string][]testResponse{
+		"DELETE /resource": {
+			{
+				statusCode: http.StatusAccepted,
+				headers: map[string]string{
+					"Location": "{server}/operationResults/1",
+				},
+			},
+		},
+		"GET /operationResults/1": {
+			{
+				statusCode: http.StatusAccepted,
+			},
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.TODO())
+	poller, err := server.start(t, ctx, http.MethodDelete, "/resource", Options{})
+	if err != nil {
+		t.Fatalf("creating the poller: %+v", err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		cancel()
+	}()
+
+	if err := poller.PollUntilDone(); err == nil {
+		t.Fatal("expected an error but didn't get one")
+	}
+}
+
+func TestPollInterval(t *testing.T) {
+	testData := []struct {
+		retryAfter string
+		expected   time.Duration
+	}{
+		{
+			retryAfter: "",
+			expected:   DefaultPollInterval,
+		},
+		{
+			retryAfter: "30",
+			expected:   30 * time.Second,
+		},
+		{
+			retryAfter: "0",
+			expected:   0,
+		},
+		{
+			// in the past
+			retryAfter: "Wed, 21 Oct 2015 07:28:00 GMT",
+			expected:   0,
+		},
+		{
+			retryAfter: "invalid",
+			expected:   DefaultPollInterval,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.retryAfter)
+
+		poller := LongRunningPoller{
+			HttpResponse: &http.Response{
+				Header: http.Header{},
+			},
+			options: Options{
+				PollInterval: DefaultPollInterval,
+			},
+		}
+		if v.retryAfter != "" {
+			poller.HttpResponse.Header.Set("Retry-After", v.retryAfter)
+		}
+
+		if actual := poller.pollInterval(); actual != v.expected {
+			t.Fatalf("expected %s but got %s", v.expected, actual)
+		}
+	}
+
+	// in the future
+	poller := LongRunningPoller{
+		HttpResponse: &http.Response{
+			Header: http.Header{
+				"Retry-After": []string{time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)},
+			},
+		},
+	}
+	if actual := poller.pollInterval(); actual <= 50*time.Second || actual > time.Minute {
+		t.Fatalf("expected around a minute but got %s", actual)
+	}
+}
@@ -0,0 +1,105 @@
+package polling
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Status is the Status of a Long Running Operation
+type Status string
+
+const (
+	StatusCanceled   Status = "Canceled"
+	StatusFailed     Status = "Failed"
+	StatusInProgress Status = "InProgress"
+	StatusSucceeded  Status = "Succeeded"
+)
+
+// DonePredicate determines the Status of a Long Running Operation from the HTTP Response (and its body) returned
+// when polling it - returning an error if the Status can't be determined
+type DonePredicate func(resp *http.Response, body []byte) (Status, error)
+
+// AsyncOperationStatus determines the Status of a Long Running Operation from the `status` field returned by
+// the URI within the `Azure-AsyncOperation` header
+func AsyncOperationStatus(resp *http.Response, body []byte) (Status, error) {
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, errorDetails(body))
+	}
+
+	var v struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", fmt.Errorf("parsing the response: %+v", err)
+	}
+	if v.Status == "" {
+		return "", fmt.Errorf("the response didn't contain a `status`")
+	}
+
+	return statusFromValue(v.Status), nil
+}
+
+// LocationStatus determines the Status of a Long Running Operation from the HTTP Status Code returned by the URI
+// within the `Location` header - which returns a `202 Accepted` until the operation has completed
+func LocationStatus(resp *http.Response, body []byte) (Status, error) {
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return StatusInProgress, nil
+
+	case http.StatusOK, http.StatusCreated, http.StatusNoContent:
+		return StatusSucceeded, nil
+	}
+
+	return StatusFailed, nil
+}
+
+// ProvisioningState determines the Status of a Long Running Operation from the `provisioningState` of the resource,
+// which is considered Succeeded when it isn't returned
+func ProvisioningState(resp *http.Response, body []byte) (Status, error) {
+	switch resp.StatusCode {
+	case http.StatusAccepted:
+		return StatusInProgress, nil
+
+	case http.StatusOK, http.StatusCreated:
+
+	default:
+		return "", fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, errorDetails(body))
+	}
+
+	if len(body) == 0 {
+		return StatusSucceeded, nil
+	}
+
+	var v struct {
+		Properties *struct {
+			ProvisioningState string `json:"provisioningState"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &v); err != nil {
+		return "", fmt.Errorf("parsing the response: %+v", err)
+	}
+	if v.Properties == nil || v.Properties.ProvisioningState == "" {
+		return StatusSucceeded, nil
+	}
+
+	return statusFromValue(v.Properties.ProvisioningState), nil
+}
+
+// statusFromValue returns the Status for the specified value (which is case-insensitive), where any
+// non-terminal value (e.g. `Accepted`, `Creating` or `Updating`) means that the operation is in progress
+func statusFromValue(input string) Status {
+	switch strings.ToLower(input) {
+	case "succeeded":
+		return StatusSucceeded
+
+	case "failed":
+		return StatusFailed
+
+	case "canceled", "cancelled":
+		return StatusCanceled
+	}
+
+	return StatusInProgress
+}
@@ -42,6 +42,20 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 			},
 		},
 
+		"name_availability": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"check_during_plan": {
+						Type:     pluginsdk.TypeBool,
+						Required: true,
+					},
+				},
+			},
+		},
+
 		"network": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
@@ -168,6 +182,16 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["name_availability"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 && items[0] != nil {
+			nameAvailabilityRaw := items[0].(map[string]interface{})
+			if v, ok := nameAvailabilityRaw["check_during_plan"]; ok {
+				features.NameAvailability.CheckDuringPlan = v.(bool)
+			}
+		}
+	}
+
 	if raw, ok := val["network"]; ok {
 		items := raw.([]interface{})
 		if len(items) > 0 {
@@ -25,6 +25,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
@@ -57,6 +60,11 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": true,
 						},
 					},
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+						},
+					},
 					"network": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": true,
@@ -90,6 +98,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: true,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: true,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: true,
 				},
@@ -122,6 +133,11 @@ func TestExpandFeatures(t *testing.T) {
 							"permanently_delete_on_destroy": false,
 						},
 					},
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": false,
+						},
+					},
 					"network_locking": []interface{}{
 						map[string]interface{}{
 							"relaxed_locking": false,
@@ -155,6 +171,9 @@ func TestExpandFeatures(t *testing.T) {
 				LogAnalyticsWorkspace: features.LogAnalyticsWorkspaceFeatures{
 					PermanentlyDeleteOnDestroy: false,
 				},
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
 				Network: features.NetworkFeatures{
 					RelaxedLocking: false,
 				},
@@ -253,6 +272,71 @@ func TestExpandFeaturesKeyVault(t *testing.T) {
 	}
 }
 
+func TestExpandFeaturesNameAvailability(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: true,
+				},
+			},
+		},
+		{
+			Name: "Check During Plan Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"name_availability": []interface{}{
+						map[string]interface{}{
+							"check_during_plan": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				NameAvailability: features.NameAvailabilityFeatures{
+					CheckDuringPlan: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.NameAvailability, testCase.Expected.NameAvailability) {
+			t.Fatalf("Expected %+v but got %+v", result.NameAvailability, testCase.Expected.NameAvailability)
+		}
+	}
+}
+
 func TestExpandFeaturesNetwork(t *testing.T) {
 	testData := []struct {
 		Name     string
@@ -6,26 +6,40 @@ import (
 	"os"
 	"strings"
 
+	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/authentication"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/recording"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
 func AzureProvider() terraform.ResourceProvider {
-	return azureProvider(false)
+	return azureProvider(false, recording.ModeLive, nil)
 }
 
 func TestAzureProvider() terraform.ResourceProvider {
-	return azureProvider(true)
+	return azureProvider(true, recording.ModeLive, nil)
 }
 
-func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
+// TestAzureProviderWithRecordingMode returns the Provider used in the Acceptance Tests, which records
+// (or replays) the requests made to Azure when a Recording Mode is specified
+func TestAzureProviderWithRecordingMode(recordingMode recording.Mode) terraform.ResourceProvider {
+	return azureProvider(true, recordingMode, nil)
+}
+
+// TestAzureProviderWithEnvironment returns the Provider used in the Acceptance Tests, which sends requests to
+// the endpoints within the specified Azure Environment (e.g. an Emulator) rather than those looked up by name
+func TestAzureProviderWithEnvironment(environment azure.Environment) terraform.ResourceProvider {
+	return azureProvider(true, recording.ModeLive, &environment)
+}
+
+func azureProvider(supportLegacyTestSuite bool, recordingMode recording.Mode, environment *azure.Environment) terraform.ResourceProvider {
 	// avoids this showing up in test output
 	debugLog := func(f string, v ...interface{}) {
 		if os.Getenv("TF_LOG") == "" {
@@ -97,6 +111,18 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
+	// remove any ignored tags from the data sources
+	for _, v := range dataSources {
+		removeIgnoredTagsFromDataSource(v)
+	}
+
+	// add the computed `tags_all` field to each resource which supports tags, and ensure
+	// the Resource IDs for each resource are consistently cased
+	for _, v := range resources {
+		addTagsAllToResource(v)
+		normalizeResourceIdsInResource(v)
+	}
+
 	p := &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"subscription_id": {
@@ -211,8 +237,20 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				Description: "This will disable the Terraform Partner ID which is used if a custom `partner_id` isn't specified.",
 			},
 
+			"default_tags": schemaDefaultTags(),
+
 			"features": schemaFeatures(supportLegacyTestSuite),
 
+			"ignore_tags": schemaIgnoreTags(),
+
+			"rate_limit": schemaRateLimit(),
+
+			"resource_provider_registrations": schemaResourceProviderRegistrations(),
+
+			"resource_providers_to_register": schemaResourceProvidersToRegister(),
+
+			"retry": schemaRetry(),
+
 			// Advanced feature flags
 			"skip_provider_registration": {
 				Type:        schema.TypeBool,
@@ -227,6 +265,13 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("ARM_STORAGE_USE_AZUREAD", false),
 				Description: "Should the AzureRM Provider use AzureAD to access the Storage Data Plane API's?",
 			},
+
+			"use_response_cache": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ARM_USE_RESPONSE_CACHE", false),
+				Description: "Should the AzureRM Provider cache the responses to GET requests made against Azure Resource Manager during a refresh, so that resources reading the same object share a single request?",
+			},
 		},
 
 		DataSourcesMap: dataSources,
@@ -242,12 +287,12 @@ func azureProvider(supportLegacyTestSuite bool) terraform.ResourceProvider {
 		}
 	}
 
-	p.ConfigureFunc = providerConfigure(p)
+	p.ConfigureFunc = providerConfigure(p, recordingMode, environment)
 
 	return p
 }
 
-func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
+func providerConfigure(p *schema.Provider, recordingMode recording.Mode, environment *azure.Environment) schema.ConfigureFunc {
 	return func(d *schema.ResourceData) (interface{}, error) {
 		var auxTenants []string
 		if v, ok := d.Get("auxiliary_tenant_ids").([]interface{}); ok && len(v) > 0 {
@@ -304,16 +349,22 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			terraformVersion = "0.11+compatible"
 		}
 
-		skipProviderRegistration := d.Get("skip_provider_registration").(bool)
+		resourceProviderRegistrations := expandResourceProviderRegistrations(d.Get("resource_provider_registrations").(string), d.Get("resource_providers_to_register").([]interface{}), d.Get("skip_provider_registration").(bool))
 		clientBuilder := clients.ClientBuilder{
-			AuthConfig:                  config,
-			SkipProviderRegistration:    skipProviderRegistration,
-			TerraformVersion:            terraformVersion,
-			PartnerId:                   d.Get("partner_id").(string),
-			DisableCorrelationRequestID: d.Get("disable_correlation_request_id").(bool),
-			DisableTerraformPartnerID:   d.Get("disable_terraform_partner_id").(bool),
-			Features:                    expandFeatures(d.Get("features").([]interface{})),
-			StorageUseAzureAD:           d.Get("storage_use_azuread").(bool),
+			AuthConfig:                    config,
+			ResourceProviderRegistrations: resourceProviderRegistrations,
+			TerraformVersion:              terraformVersion,
+			PartnerId:                     d.Get("partner_id").(string),
+			DisableCorrelationRequestID:   d.Get("disable_correlation_request_id").(bool),
+			DisableTerraformPartnerID:     d.Get("disable_terraform_partner_id").(bool),
+			Environment:                   environment,
+			Features:                      expandFeatures(d.Get("features").([]interface{})),
+			RateLimits:                    expandRateLimits(d.Get("rate_limit").([]interface{})),
+			RetryPolicy:                   expandRetryPolicy(d.Get("retry").([]interface{})),
+			RecordingMode:                 recordingMode,
+			StorageUseAzureAD:             d.Get("storage_use_azuread").(bool),
+			Tags:                          expandTagsConfig(d.Get("default_tags").(map[string]interface{}), d.Get("ignore_tags").([]interface{})),
+			UseResponseCache:              d.Get("use_response_cache").(bool),
 
 			// this field is intentionally not exposed in the provider block, since it's only used for
 			// platform level tracing
@@ -326,7 +377,13 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 
 		client.StopContext = p.StopContext()
 
-		if !skipProviderRegistration {
+		// the Resource Providers which are specified explicitly are validated against those supported by this
+		// Azure Environment, since Resource Providers which aren't found would otherwise be silently ignored
+		if err := resourceproviders.ValidateRegistrations(*utils.ExpandStringSlice(d.Get("resource_providers_to_register").([]interface{}))); err != nil {
+			return nil, err
+		}
+
+		if len(resourceProviderRegistrations.ResourceProviders) > 0 {
 			// List all the available providers and their registration state to avoid unnecessary
 			// requests. This also lets us check if the provider credentials are correct.
 			ctx := client.StopContext
@@ -338,7 +395,7 @@ func providerConfigure(p *schema.Provider) schema.ConfigureFunc {
 			}
 
 			availableResourceProviders := providerList.Values()
-			requiredResourceProviders := resourceproviders.Required()
+			requiredResourceProviders := resourceProviderRegistrations.ResourceProviders
 
 			if err := resourceproviders.EnsureRegistered(ctx, *client.Resource.ProvidersClient, availableResourceProviders, requiredResourceProviders); err != nil {
 				return nil, fmt.Errorf(resourceProviderRegistrationErrorFmt, err)
@@ -355,7 +412,9 @@ Terraform automatically attempts to register the Resource Providers it supports
 ensure it's able to provision resources.
 
 If you don't have permission to register Resource Providers you may wish to use the
-"skip_provider_registration" flag in the Provider block to disable this functionality.
+"resource_provider_registrations" field in the Provider block to register a smaller
+set of Resource Providers (or "none"), or the "skip_provider_registration" flag in the
+Provider block to disable this functionality.
 
 Please note that if you opt out of Resource Provider Registration and Terraform tries
 to provision a resource from a Resource Provider which is unregistered, then the errors
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func schemaRateLimit() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"reads_per_hour": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of read requests which should be sent to Azure Resource Manager per hour, per Subscription. A value of 0 disables rate limiting of read requests.",
+				},
+
+				"writes_per_hour": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+					Description:  "The maximum number of write requests which should be sent to Azure Resource Manager per hour, per Subscription. A value of 0 disables rate limiting of write requests.",
+				},
+			},
+		},
+	}
+}
+
+func expandRateLimits(input []interface{}) common.RateLimits {
+	// requests aren't rate limited unless this block is specified
+	limits := common.RateLimits{}
+
+	if len(input) == 0 || input[0] == nil {
+		return limits
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["reads_per_hour"]; ok {
+		limits.ReadsPerHour = v.(int)
+	}
+	if v, ok := val["writes_per_hour"]; ok {
+		limits.WritesPerHour = v.(int)
+	}
+
+	return limits
+}
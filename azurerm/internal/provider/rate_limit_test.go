@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandRateLimits(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected common.RateLimits
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: common.RateLimits{},
+		},
+		{
+			Name: "Reads Only",
+			Input: []interface{}{
+				map[string]interface{}{
+					"reads_per_hour":  12000,
+					"writes_per_hour": 0,
+				},
+			},
+			Expected: common.RateLimits{
+				ReadsPerHour: 12000,
+			},
+		},
+		{
+			Name: "Reads and Writes",
+			Input: []interface{}{
+				map[string]interface{}{
+					"reads_per_hour":  10000,
+					"writes_per_hour": 1000,
+				},
+			},
+			Expected: common.RateLimits{
+				ReadsPerHour:  10000,
+				WritesPerHour: 1000,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandRateLimits(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// normalizeResourceIdsInResource ensures the Resource IDs for the specified resource are consistently cased, since
+// some API's return Resource IDs using a different casing (e.g. `resourcegroups`) - by suppressing differences in
+// casing between Resource IDs for the `*_id` fields, and normalising the casing of both the ID of the resource and
+// these fields when the resource is read (see normalizeResourceId)
+func normalizeResourceIdsInResource(resource *pluginsdk.Resource) {
+	schema, fields := withCaseDifferenceForResourceIdFields(resource.Schema)
+	resource.Schema = schema
+
+	if resource.Read == nil {
+		return
+	}
+
+	existing := resource.Read
+	resource.Read = func(d *pluginsdk.ResourceData, meta interface{}) error {
+		previousId := d.Id()
+		if err := existing(d, meta); err != nil {
+			return err
+		}
+
+		// the resource has been removed
+		if d.Id() == "" {
+			return nil
+		}
+
+		if id := normalizeResourceId(previousId, d.Id()); id != d.Id() {
+			d.SetId(id)
+		}
+
+		for _, field := range fields {
+			v, ok := d.Get(field).(string)
+			if !ok || v == "" {
+				continue
+			}
+
+			previous, _ := d.GetChange(field)
+			previousValue, _ := previous.(string)
+			if normalized := normalizeResourceId(previousValue, v); normalized != v {
+				if err := d.Set(field, normalized); err != nil {
+					return fmt.Errorf("setting `%s`: %+v", field, err)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+// normalizeResourceId returns the specified Resource ID with the casing of the well-known segments normalised (see
+// resourceid.NormalizeCasing) - using the casing of the previous value when these only differ in casing, since this
+// was either specified by the user or output by a (generated) Resource ID Formatter, both of which use the expected
+// casing for the Resource Provider and Resource Type segments which can't otherwise be normalised.
+//
+// Values which aren't a Resource ID (e.g. a URI or a UUID) are returned unchanged.
+func normalizeResourceId(previous, input string) string {
+	if !isResourceId(input) {
+		return input
+	}
+
+	if previous != "" && strings.EqualFold(previous, input) {
+		input = previous
+	}
+
+	return resourceid.NormalizeCasing(input)
+}
+
+// isResourceId returns whether the specified value can be parsed as a Resource ID for any Scope (e.g. a Subscription,
+// a Resource Group or a Resource), with the names of the segments matched case-insensitively
+func isResourceId(input string) bool {
+	_, err := resourceid.ParseScope(input)
+	return err == nil
+}
+
+// resourceIdCaseDifference suppresses differences in casing when both values are Resource IDs - other values (for
+// example a UUID) are compared as-is
+func resourceIdCaseDifference(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	return strings.EqualFold(old, new) && isResourceId(old) && isResourceId(new)
+}
+
+// withCaseDifferenceForResourceIdFields returns a copy of the specified schema where differences in casing between
+// Resource IDs are suppressed for the `*_id` fields (including those within nested blocks) which can be specified by
+// the user and don't already have a DiffSuppressFunc - in addition to the names of the top-level fields whose casing
+// can be normalised.
+//
+// Whether a field contains a Resource ID is determined from its value, rather than from the schema, since the fields
+// are validated using a number of different (generated) validation functions.
+//
+// Since the same schema can be used by multiple resources, the fields (and nested blocks) are copied rather than
+// being updated in-place.
+func withCaseDifferenceForResourceIdFields(input map[string]*pluginsdk.Schema) (map[string]*pluginsdk.Schema, []string) {
+	output := make(map[string]*pluginsdk.Schema, len(input))
+	fields := make([]string, 0)
+	for name, s := range input {
+		output[name] = s
+		if s == nil {
+			continue
+		}
+
+		if nested, ok := s.Elem.(*pluginsdk.Resource); ok {
+			nestedSchema, _ := withCaseDifferenceForResourceIdFields(nested.Schema)
+			nestedCopy := *nested
+			nestedCopy.Schema = nestedSchema
+
+			copied := *s
+			copied.Elem = &nestedCopy
+			output[name] = &copied
+			continue
+		}
+
+		if s.Type != pluginsdk.TypeString || !strings.HasSuffix(name, "_id") || s.DiffSuppressFunc != nil {
+			continue
+		}
+		fields = append(fields, name)
+
+		// a DiffSuppressFunc can't be set for Computed-only fields, since there's no config to compare with
+		if !s.Required && !s.Optional {
+			continue
+		}
+
+		copied := *s
+		copied.DiffSuppressFunc = resourceIdCaseDifference
+		output[name] = &copied
+	}
+
+	return output, fields
+}
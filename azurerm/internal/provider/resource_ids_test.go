@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func TestNormalizeResourceIdsInResource(t *testing.T) {
+	const subnetId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1"
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"resource_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"subnet_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: networkValidate.SubnetID,
+			},
+
+			"custom_id": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     azure.ValidateResourceID,
+				DiffSuppressFunc: suppress.RFC3339Time,
+			},
+
+			"tenant_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+			},
+
+			"block": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:         pluginsdk.TypeString,
+							Required:     true,
+							ValidateFunc: networkValidate.SubnetID,
+						},
+					},
+				},
+			},
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			d.Set("resource_id", subnetId)
+			d.Set("subnet_id", subnetId)
+			d.Set("custom_id", subnetId)
+			return nil
+		},
+		Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Update: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+		Delete: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	original := resource.Schema
+	normalizeResourceIdsInResource(resource)
+
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating the Resource: %+v", err)
+	}
+
+	suppressed := map[string]bool{
+		"name":        false,
+		"resource_id": true,
+		"subnet_id":   true,
+		"tenant_id":   true,
+	}
+	for k, v := range suppressed {
+		if actual := resource.Schema[k].DiffSuppressFunc != nil; actual != v {
+			t.Fatalf("expected a DiffSuppressFunc for %q to be %t but got %t", k, v, actual)
+		}
+	}
+	// differences in casing are only suppressed for Resource IDs
+	if !resource.Schema["subnet_id"].DiffSuppressFunc("subnet_id", subnetId, strings.ToUpper(subnetId), nil) {
+		t.Fatalf("expected differences in casing to be suppressed for `subnet_id`")
+	}
+	if resource.Schema["subnet_id"].DiffSuppressFunc("subnet_id", subnetId, subnetId+"2", nil) {
+		t.Fatalf("expected a different Resource ID not to be suppressed for `subnet_id`")
+	}
+	if resource.Schema["tenant_id"].DiffSuppressFunc("tenant_id", "abcdef00-1234-9876-4563-123456789012", "ABCDEF00-1234-9876-4563-123456789012", nil) {
+		t.Fatalf("expected differences in casing not to be suppressed for `tenant_id`, which isn't a Resource ID")
+	}
+	// these are the same time, which would only be suppressed by the existing function
+	if !resource.Schema["custom_id"].DiffSuppressFunc("custom_id", "2021-01-01T00:00:00Z", "2021-01-01T00:00:00+00:00", nil) {
+		t.Fatalf("expected the existing DiffSuppressFunc for `custom_id` to be retained")
+	}
+	if resource.Schema["block"].Elem.(*pluginsdk.Resource).Schema["subnet_id"].DiffSuppressFunc == nil {
+		t.Fatalf("expected the DiffSuppressFunc to be set for `block.0.subnet_id`")
+	}
+
+	// the schema can be shared between resources, so shouldn't be updated in-place
+	if original["resource_id"].DiffSuppressFunc != nil || original["subnet_id"].DiffSuppressFunc != nil {
+		t.Fatalf("expected the original schema not to be updated")
+	}
+	if original["block"].Elem.(*pluginsdk.Resource).Schema["subnet_id"].DiffSuppressFunc != nil {
+		t.Fatalf("expected the original nested schema not to be updated")
+	}
+
+	d := resource.TestResourceData()
+	d.SetId("/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/Group1/PROVIDERS/Microsoft.Example/resources/resource1")
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("reading the Resource: %+v", err)
+	}
+
+	expectedId := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Example/resources/resource1"
+	if d.Id() != expectedId {
+		t.Fatalf("expected the ID to be %q but got %q", expectedId, d.Id())
+	}
+
+	expected := map[string]string{
+		"resource_id": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		"subnet_id":   "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		"custom_id":   subnetId,
+	}
+	for k, v := range expected {
+		if actual := d.Get(k).(string); actual != v {
+			t.Fatalf("expected %q to be %q but got %q", k, v, actual)
+		}
+	}
+}
+
+func TestNormalizeResourceIdsInResource_PreviousCasing(t *testing.T) {
+	const previousId = "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.Network/virtualNetworks/network1"
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"network_id": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			// some API's return the Resource Provider and Resource Type in a different casing
+			id := "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/providers/microsoft.network/virtualnetworks/network1"
+			d.SetId(id)
+			d.Set("network_id", id)
+			return nil
+		},
+	}
+	normalizeResourceIdsInResource(resource)
+
+	d := resource.Data(&pluginsdk.InstanceState{
+		ID: previousId,
+		Attributes: map[string]string{
+			"id":         previousId,
+			"network_id": previousId,
+		},
+	})
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("reading the Resource: %+v", err)
+	}
+
+	if d.Id() != previousId {
+		t.Fatalf("expected the ID to be %q but got %q", previousId, d.Id())
+	}
+	if actual := d.Get("network_id").(string); actual != previousId {
+		t.Fatalf("expected `network_id` to be %q but got %q", previousId, actual)
+	}
+}
+
+func TestNormalizeResourceIdsInResource_Removed(t *testing.T) {
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	}
+	normalizeResourceIdsInResource(resource)
+
+	d := resource.TestResourceData()
+	d.SetId("/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1")
+	if err := resource.Read(d, nil); err != nil {
+		t.Fatalf("reading the Resource: %+v", err)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected the ID to be empty but got %q", d.Id())
+	}
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func schemaResourceProviderRegistrations() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:        pluginsdk.TypeString,
+		Optional:    true,
+		DefaultFunc: schema.EnvDefaultFunc("ARM_RESOURCE_PROVIDER_REGISTRATIONS", resourceproviders.RegistrationSetAll),
+		ValidateFunc: validation.StringInSlice([]string{
+			resourceproviders.RegistrationSetAll,
+			resourceproviders.RegistrationSetCore,
+			resourceproviders.RegistrationSetNone,
+		}, false),
+		Description: "The set of Resource Providers which should be registered by the AzureRM Provider. Possible values are `all` (all of the Resource Providers supported by the AzureRM Provider), `core` (a small set of commonly used Resource Providers) and `none`.",
+	}
+}
+
+func schemaResourceProvidersToRegister() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		Elem: &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			ValidateFunc: resourceproviders.EnhancedValidate,
+		},
+		Description: "A list of additional Resource Providers which should be registered by the AzureRM Provider, for example `Microsoft.Compute`.",
+	}
+}
+
+func expandResourceProviderRegistrations(set string, input []interface{}, skipProviderRegistration bool) common.ResourceProviderRegistrations {
+	if skipProviderRegistration {
+		return common.ResourceProviderRegistrations{
+			Limited: true,
+		}
+	}
+
+	additional := make([]string, 0)
+	for _, v := range input {
+		if v == nil {
+			continue
+		}
+		additional = append(additional, v.(string))
+	}
+
+	return common.ResourceProviderRegistrations{
+		// when registering all of the Resource Providers the Provider supports, any Resource Provider
+		// not in this list can also be registered when it's first used
+		Limited:           set != resourceproviders.RegistrationSetAll,
+		ResourceProviders: resourceproviders.ForRegistrationSet(set, additional),
+	}
+}
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+)
+
+func TestExpandResourceProviderRegistrations(t *testing.T) {
+	testData := []struct {
+		Name                     string
+		Set                      string
+		Input                    []interface{}
+		SkipProviderRegistration bool
+		Expected                 common.ResourceProviderRegistrations
+	}{
+		{
+			Name:  "All",
+			Set:   "all",
+			Input: []interface{}{},
+			Expected: common.ResourceProviderRegistrations{
+				Limited:           false,
+				ResourceProviders: resourceproviders.Required(),
+			},
+		},
+		{
+			Name:  "Core",
+			Set:   "core",
+			Input: []interface{}{},
+			Expected: common.ResourceProviderRegistrations{
+				Limited:           true,
+				ResourceProviders: resourceproviders.Core(),
+			},
+		},
+		{
+			Name:  "None",
+			Set:   "none",
+			Input: []interface{}{},
+			Expected: common.ResourceProviderRegistrations{
+				Limited:           true,
+				ResourceProviders: map[string]struct{}{},
+			},
+		},
+		{
+			Name: "None with an Explicit List",
+			Set:  "none",
+			Input: []interface{}{
+				"Microsoft.Compute",
+				"Microsoft.Network",
+			},
+			Expected: common.ResourceProviderRegistrations{
+				Limited: true,
+				ResourceProviders: map[string]struct{}{
+					"Microsoft.Compute": {},
+					"Microsoft.Network": {},
+				},
+			},
+		},
+		{
+			Name: "Skip Provider Registration",
+			Set:  "all",
+			Input: []interface{}{
+				"Microsoft.Compute",
+			},
+			SkipProviderRegistration: true,
+			Expected: common.ResourceProviderRegistrations{
+				Limited: true,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandResourceProviderRegistrations(testCase.Set, testCase.Input, testCase.SkipProviderRegistration)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func schemaRetry() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"max_attempts": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      3,
+					ValidateFunc: validation.IntBetween(1, 20),
+					Description:  "The maximum number of times a request which failed with a transient error should be retried.",
+				},
+
+				"min_delay_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      30,
+					ValidateFunc: validation.IntBetween(1, 3600),
+					Description:  "The initial delay (in seconds) between retries, which is doubled on each subsequent attempt.",
+				},
+
+				"max_delay_in_seconds": {
+					Type:         pluginsdk.TypeInt,
+					Optional:     true,
+					Default:      300,
+					ValidateFunc: validation.IntBetween(1, 3600),
+					Description:  "The maximum delay (in seconds) between retries.",
+				},
+
+				"retryable_error_codes": {
+					Type:     pluginsdk.TypeList,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of additional Error Codes returned from Azure which should be retried.",
+				},
+			},
+		},
+	}
+}
+
+func expandRetryPolicy(input []interface{}) common.RetryPolicy {
+	// these are the defaults if omitted from the config
+	policy := common.DefaultRetryPolicy()
+
+	if len(input) == 0 || input[0] == nil {
+		return policy
+	}
+
+	val := input[0].(map[string]interface{})
+
+	if v, ok := val["max_attempts"]; ok && v.(int) > 0 {
+		policy.MaxAttempts = v.(int)
+	}
+	if v, ok := val["min_delay_in_seconds"]; ok && v.(int) > 0 {
+		policy.MinDelay = time.Duration(v.(int)) * time.Second
+	}
+	if v, ok := val["max_delay_in_seconds"]; ok && v.(int) > 0 {
+		policy.MaxDelay = time.Duration(v.(int)) * time.Second
+	}
+	if policy.MaxDelay < policy.MinDelay {
+		policy.MaxDelay = policy.MinDelay
+	}
+
+	errorCodes := make([]string, 0)
+	errorCodes = append(errorCodes, common.DefaultRetryableErrorCodes...)
+	if raw, ok := val["retryable_error_codes"]; ok {
+		for _, v := range raw.([]interface{}) {
+			if v == nil {
+				continue
+			}
+			errorCodes = append(errorCodes, v.(string))
+		}
+	}
+	policy.RetryableErrorCodes = errorCodes
+
+	return policy
+}
@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/common"
+)
+
+func TestExpandRetryPolicy(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		Expected common.RetryPolicy
+	}{
+		{
+			Name:     "Empty Block",
+			Input:    []interface{}{},
+			Expected: common.DefaultRetryPolicy(),
+		},
+		{
+			Name: "Defaults",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":          3,
+					"min_delay_in_seconds":  30,
+					"max_delay_in_seconds":  300,
+					"retryable_error_codes": []interface{}{},
+				},
+			},
+			Expected: common.RetryPolicy{
+				MaxAttempts:         3,
+				MinDelay:            30 * time.Second,
+				MaxDelay:            5 * time.Minute,
+				RetryableErrorCodes: common.DefaultRetryableErrorCodes,
+			},
+		},
+		{
+			Name: "Custom Values",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":          10,
+					"min_delay_in_seconds":  5,
+					"max_delay_in_seconds":  60,
+					"retryable_error_codes": []interface{}{"Conflict", "ResourceBusy"},
+				},
+			},
+			Expected: common.RetryPolicy{
+				MaxAttempts: 10,
+				MinDelay:    5 * time.Second,
+				MaxDelay:    time.Minute,
+				RetryableErrorCodes: append(append([]string{}, common.DefaultRetryableErrorCodes...),
+					"Conflict", "ResourceBusy"),
+			},
+		},
+		{
+			Name: "Max Delay Less Than Min Delay",
+			Input: []interface{}{
+				map[string]interface{}{
+					"max_attempts":          3,
+					"min_delay_in_seconds":  60,
+					"max_delay_in_seconds":  10,
+					"retryable_error_codes": []interface{}{},
+				},
+			},
+			Expected: common.RetryPolicy{
+				MaxAttempts:         3,
+				MinDelay:            time.Minute,
+				MaxDelay:            time.Minute,
+				RetryableErrorCodes: common.DefaultRetryableErrorCodes,
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandRetryPolicy(testCase.Input)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
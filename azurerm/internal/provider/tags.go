@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+func schemaDefaultTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeMap,
+		Optional:     true,
+		ValidateFunc: tags.Validate,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+		Description: "A mapping of tags which should be assigned to all resources which support tags.",
+	}
+}
+
+func schemaIgnoreTags() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"keys": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of tag keys which should be ignored on all resources.",
+				},
+
+				"key_prefixes": {
+					Type:     pluginsdk.TypeSet,
+					Optional: true,
+					Elem: &pluginsdk.Schema{
+						Type:         pluginsdk.TypeString,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+					Description: "A list of tag key prefixes, where tags with a matching key should be ignored on all resources.",
+				},
+			},
+		},
+	}
+}
+
+func expandTagsConfig(defaultTags map[string]interface{}, ignoreTags []interface{}) tags.Config {
+	output := tags.Config{
+		DefaultTags: tags.ToTypedObject(tags.Expand(defaultTags)),
+		IgnoreTags: tags.IgnoreConfig{
+			Keys:        []string{},
+			KeyPrefixes: []string{},
+		},
+	}
+
+	if len(ignoreTags) == 0 || ignoreTags[0] == nil {
+		return output
+	}
+
+	val := ignoreTags[0].(map[string]interface{})
+	if raw, ok := val["keys"]; ok {
+		for _, v := range raw.(*pluginsdk.Set).List() {
+			output.IgnoreTags.Keys = append(output.IgnoreTags.Keys, v.(string))
+		}
+	}
+	if raw, ok := val["key_prefixes"]; ok {
+		for _, v := range raw.(*pluginsdk.Set).List() {
+			output.IgnoreTags.KeyPrefixes = append(output.IgnoreTags.KeyPrefixes, v.(string))
+		}
+	}
+
+	return output
+}
+
+// addTagsAllToResource adds the computed `tags_all` field to the specified resource, which
+// contains the effective tags for the resource - including any `default_tags` configured
+// in the Provider block.
+//
+// The Default Tags are merged into the `tags` before the resource is created/updated, and
+// removed from the `tags` (and any ignored tags removed from both fields) once it's been read
+// - as such this applies to both Typed and Untyped resources, which expand/flatten their tags
+// without needing to be aware of the `default_tags` or `ignore_tags`.
+func addTagsAllToResource(resource *pluginsdk.Resource) {
+	if !tags.SupportsTagsAll(resource) {
+		return
+	}
+
+	resource.Schema["tags_all"] = tags.SchemaTagsAll()
+
+	existing := resource.CustomizeDiff
+	resource.CustomizeDiff = func(d *pluginsdk.ResourceDiff, meta interface{}) error {
+		if existing != nil {
+			if err := existing(d, meta); err != nil {
+				return err
+			}
+		}
+
+		// resources which don't support being updated can only update `tags_all` when they're being
+		// (re)created, otherwise a change to the `default_tags` would attempt to update the resource
+		if resource.Update == nil && d.Id() != "" && !d.HasChange("tags") {
+			return nil
+		}
+
+		return tagsConfigFromMeta(meta).CustomizeDiffTagsAll(d)
+	}
+
+	resource.Create = withTagsAll(resource.Create, true)
+	resource.Read = withTagsAll(resource.Read, false)
+	if resource.Update != nil {
+		resource.Update = withTagsAll(resource.Update, true)
+	}
+}
+
+// withTagsAll wraps the specified CRUD function, optionally merging the Default Tags into the `tags`
+// beforehand, and setting the `tags` and `tags_all` fields afterwards
+func withTagsAll(next func(*pluginsdk.ResourceData, interface{}) error, mergeDefaultTags bool) func(*pluginsdk.ResourceData, interface{}) error {
+	if next == nil {
+		return nil
+	}
+
+	return func(d *pluginsdk.ResourceData, meta interface{}) error {
+		config := tagsConfigFromMeta(meta)
+		configured, _ := d.Get("tags").(map[string]interface{})
+
+		if mergeDefaultTags {
+			if err := d.Set("tags", config.MergeDefaultTags(configured)); err != nil {
+				return fmt.Errorf("merging the Default Tags into `tags`: %+v", err)
+			}
+		}
+
+		err := next(d, meta)
+
+		// the resource can have been created even when an error is returned, or removed from the state
+		if d.Id() == "" {
+			return err
+		}
+		if tagsErr := config.SetTagsAll(d, configured); tagsErr != nil && err == nil {
+			return tagsErr
+		}
+
+		return err
+	}
+}
+
+// removeIgnoredTagsFromDataSource wraps the Read function for the specified data source (where it has
+// a `tags` field) so that any tags which should be ignored are removed from the `tags`
+func removeIgnoredTagsFromDataSource(dataSource *pluginsdk.Resource) {
+	if v, ok := dataSource.Schema["tags"]; !ok || v.Type != pluginsdk.TypeMap || dataSource.Read == nil {
+		return
+	}
+
+	read := dataSource.Read
+	dataSource.Read = func(d *pluginsdk.ResourceData, meta interface{}) error {
+		if err := read(d, meta); err != nil {
+			return err
+		}
+
+		existing, _ := d.Get("tags").(map[string]interface{})
+		if err := d.Set("tags", tagsConfigFromMeta(meta).RemoveIgnoredTags(existing)); err != nil {
+			return fmt.Errorf("setting `tags`: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+// tagsConfigFromMeta returns the Default/Ignored Tags configured in the Provider block for this Client
+func tagsConfigFromMeta(meta interface{}) tags.Config {
+	if client, ok := meta.(*clients.Client); ok && client != nil {
+		return client.Tags
+	}
+
+	return tags.Config{}
+}
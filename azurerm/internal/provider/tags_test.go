@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func TestExpandTagsConfig(t *testing.T) {
+	testData := []struct {
+		Name        string
+		DefaultTags map[string]interface{}
+		IgnoreTags  []interface{}
+		Expected    tags.Config
+	}{
+		{
+			Name:        "Empty",
+			DefaultTags: map[string]interface{}{},
+			IgnoreTags:  []interface{}{},
+			Expected: tags.Config{
+				DefaultTags: map[string]string{},
+				IgnoreTags: tags.IgnoreConfig{
+					Keys:        []string{},
+					KeyPrefixes: []string{},
+				},
+			},
+		},
+		{
+			Name: "Default Tags and Ignored Tags",
+			DefaultTags: map[string]interface{}{
+				"environment": "production",
+			},
+			IgnoreTags: []interface{}{
+				map[string]interface{}{
+					"keys":         pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"CreatedBy"}),
+					"key_prefixes": pluginsdk.NewSet(pluginsdk.HashString, []interface{}{"policy-"}),
+				},
+			},
+			Expected: tags.Config{
+				DefaultTags: map[string]string{
+					"environment": "production",
+				},
+				IgnoreTags: tags.IgnoreConfig{
+					Keys:        []string{"CreatedBy"},
+					KeyPrefixes: []string{"policy-"},
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandTagsConfig(testCase.DefaultTags, testCase.IgnoreTags)
+		if !reflect.DeepEqual(result, testCase.Expected) {
+			t.Fatalf("Expected %+v but got %+v", testCase.Expected, result)
+		}
+	}
+}
+
+func TestAddTagsAllToResource(t *testing.T) {
+	client := &clients.Client{
+		Tags: tags.Config{
+			DefaultTags: map[string]string{
+				"environment": "production",
+			},
+		},
+	}
+
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:     pluginsdk.TypeString,
+				Required: true,
+			},
+
+			"tags": tags.Schema(),
+		},
+		Update: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	addTagsAllToResource(resource)
+
+	if _, ok := resource.Schema["tags_all"]; !ok {
+		t.Fatalf("expected `tags_all` to be added to the Schema")
+	}
+	if err := resource.InternalValidate(nil, true); err != nil {
+		t.Fatalf("validating the Resource: %+v", err)
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name": "example",
+		"tags": map[string]interface{}{
+			"owner": "team1",
+		},
+	})
+	diff, err := resource.Diff(nil, config, client)
+	if err != nil {
+		t.Fatalf("calculating the diff: %+v", err)
+	}
+
+	expected := map[string]string{
+		"tags_all.%":           "2",
+		"tags_all.environment": "production",
+		"tags_all.owner":       "team1",
+	}
+	for k, v := range expected {
+		attr, ok := diff.Attributes[k]
+		if !ok {
+			t.Fatalf("expected a diff for %q but didn't get one", k)
+		}
+		if attr.New != v {
+			t.Fatalf("expected the new value for %q to be %q but got %q", k, v, attr.New)
+		}
+	}
+}
+
+func TestAddTagsAllToResource_CreateAndRead(t *testing.T) {
+	client := &clients.Client{
+		Tags: tags.Config{
+			DefaultTags: map[string]string{
+				"environment": "production",
+			},
+			IgnoreTags: tags.IgnoreConfig{
+				Keys: []string{"createdBy"},
+			},
+		},
+	}
+
+	// the tags "in Azure", which the Default Tags are assigned to along with an ignored tag
+	var remote map[string]interface{}
+	resource := &pluginsdk.Resource{
+		Schema: map[string]*pluginsdk.Schema{
+			"tags": tags.Schema(),
+		},
+		Create: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			remote = d.Get("tags").(map[string]interface{})
+			remote["createdBy"] = "someone"
+			d.SetId("example")
+			return nil
+		},
+		Read: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return d.Set("tags", remote)
+		},
+		Update: func(d *pluginsdk.ResourceData, meta interface{}) error {
+			return nil
+		},
+	}
+	addTagsAllToResource(resource)
+
+	d := schema.TestResourceDataRaw(t, resource.Schema, map[string]interface{}{
+		"tags": map[string]interface{}{
+			"owner": "team1",
+		},
+	})
+	if err := resource.Create(d, client); err != nil {
+		t.Fatalf("creating: %+v", err)
+	}
+
+	expectedRemote := map[string]interface{}{
+		"environment": "production",
+		"owner":       "team1",
+		"createdBy":   "someone",
+	}
+	if !reflect.DeepEqual(remote, expectedRemote) {
+		t.Fatalf("expected the Default Tags to be merged into the tags but got %+v", remote)
+	}
+
+	// reading the resource back (e.g. where a Typed Resource sets all of the tags) shouldn't cause a diff
+	if err := resource.Read(d, client); err != nil {
+		t.Fatalf("reading: %+v", err)
+	}
+
+	expectedTags := map[string]interface{}{
+		"owner": "team1",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	expectedTagsAll := map[string]interface{}{
+		"environment": "production",
+		"owner":       "team1",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTagsAll) {
+		t.Fatalf("expected `tags_all` to be %+v but got %+v", expectedTagsAll, actual)
+	}
+}
+
+func TestAddTagsAllToResource_Unsupported(t *testing.T) {
+	testData := map[string]*pluginsdk.Resource{
+		"no tags": {
+			Schema: map[string]*pluginsdk.Schema{
+				"name": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+				},
+			},
+		},
+		"computed tags": {
+			Schema: map[string]*pluginsdk.Schema{
+				"tags": tags.SchemaDataSource(),
+			},
+		},
+	}
+
+	for name, resource := range testData {
+		t.Logf("[DEBUG] Test Case: %q", name)
+		addTagsAllToResource(resource)
+		if _, ok := resource.Schema["tags_all"]; ok {
+			t.Fatalf("expected `tags_all` not to be added to the Schema")
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package recording
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Cassette is a recording of the requests made (and the responses returned) during a single test
+type Cassette struct {
+	// Name is the name of the test this Cassette was recorded for
+	Name string `json:"name"`
+
+	// Variables are the values generated during the test (for example random integers/strings) which
+	// are used within the requests, and so need to be the same when the test is replayed
+	Variables map[string][]string `json:"variables"`
+
+	// Interactions are the requests made during the test and the responses returned, in order
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request and the response which was returned for it
+type Interaction struct {
+	Request  Request  `json:"request"`
+	Response Response `json:"response"`
+}
+
+// Request is a (scrubbed) request which was sent to Azure
+type Request struct {
+	Method string `json:"method"`
+	URL    string `json:"url"`
+	Body   string `json:"body,omitempty"`
+}
+
+// Response is a (scrubbed) response which was returned from Azure
+type Response struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// key returns the key used to match a request against the recorded interactions
+func (r Request) key() string {
+	return fmt.Sprintf("%s %s %s", r.Method, r.URL, r.Body)
+}
+
+// cassettePath returns the path to the Cassette for the specified test
+func cassettePath(name string) string {
+	fileName := strings.NewReplacer("/", "_", "\\", "_", " ", "_").Replace(name)
+	return filepath.Join(directory(), fileName+".json")
+}
+
+func loadCassette(name string) (*Cassette, error) {
+	path := cassettePath(name)
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the recording %q doesn't exist - record it by running this test with `%s=%s`", path, ModeEnvVar, ModeRecord)
+		}
+
+		return nil, fmt.Errorf("reading the recording %q: %+v", path, err)
+	}
+
+	var cassette Cassette
+	if err := json.Unmarshal(contents, &cassette); err != nil {
+		return nil, fmt.Errorf("parsing the recording %q: %+v", path, err)
+	}
+
+	cassette.Name = name
+	if cassette.Variables == nil {
+		cassette.Variables = make(map[string][]string)
+	}
+
+	return &cassette, nil
+}
+
+func (c Cassette) save() error {
+	path := cassettePath(c.Name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("creating the directory for the recording %q: %+v", path, err)
+	}
+
+	contents, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("serializing the recording %q: %+v", path, err)
+	}
+
+	if err := ioutil.WriteFile(path, append(contents, '\n'), 0644); err != nil {
+		return fmt.Errorf("writing the recording %q: %+v", path, err)
+	}
+
+	return nil
+}
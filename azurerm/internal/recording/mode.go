@@ -0,0 +1,79 @@
+package recording
+
+import (
+	"os"
+	"strings"
+)
+
+// Mode defines whether requests made to Azure are sent as-is, recorded or replayed from a recording
+type Mode string
+
+const (
+	// ModeLive sends requests to Azure without recording them
+	ModeLive Mode = ""
+
+	// ModeRecord sends requests to Azure and records the responses into a Cassette
+	ModeRecord Mode = "record"
+
+	// ModeReplay returns the responses from a Cassette without sending any requests to Azure
+	ModeReplay Mode = "replay"
+)
+
+const (
+	// ModeEnvVar is the Environment Variable used to toggle recording/replaying requests
+	ModeEnvVar = "ARM_TEST_RECORDING_MODE"
+
+	// DirectoryEnvVar is the Environment Variable used to override the directory Cassettes are stored in
+	DirectoryEnvVar = "ARM_TEST_RECORDING_DIR"
+
+	// defaultDirectory is the directory (relative to the package being tested) Cassettes are stored in
+	defaultDirectory = "testdata/recordings"
+)
+
+const (
+	// PlaceholderSubscriptionId replaces the Subscription ID within recorded requests/responses
+	PlaceholderSubscriptionId = "00000000-0000-0000-0000-000000000000"
+
+	// PlaceholderTenantId replaces the Tenant ID within recorded requests/responses
+	PlaceholderTenantId = "00000000-0000-0000-0000-000000000001"
+
+	// PlaceholderClientId replaces the Client ID within recorded requests/responses
+	PlaceholderClientId = "00000000-0000-0000-0000-000000000002"
+
+	// PlaceholderObjectId replaces the Object ID of the authenticated principal within recorded requests/responses
+	PlaceholderObjectId = "00000000-0000-0000-0000-000000000003"
+
+	// redactedValue replaces the value of sensitive fields within recorded requests/responses
+	redactedValue = "REDACTED"
+)
+
+// CurrentMode returns the Mode configured using the `ARM_TEST_RECORDING_MODE` Environment Variable
+func CurrentMode() Mode {
+	switch strings.ToLower(os.Getenv(ModeEnvVar)) {
+	case string(ModeRecord):
+		return ModeRecord
+	case string(ModeReplay):
+		return ModeReplay
+	}
+
+	return ModeLive
+}
+
+// PlaceholderCredentials returns the Environment Variables (and their values) which are used to
+// authenticate when replaying a Cassette - since no requests are sent these don't need to be valid
+func PlaceholderCredentials() map[string]string {
+	return map[string]string{
+		"ARM_CLIENT_ID":       PlaceholderClientId,
+		"ARM_CLIENT_SECRET":   redactedValue,
+		"ARM_SUBSCRIPTION_ID": PlaceholderSubscriptionId,
+		"ARM_TENANT_ID":       PlaceholderTenantId,
+	}
+}
+
+func directory() string {
+	if v := os.Getenv(DirectoryEnvVar); v != "" {
+		return v
+	}
+
+	return defaultDirectory
+}
@@ -0,0 +1,246 @@
+package recording
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+// Recorder records the requests made to (and the responses returned from) Azure during a test into
+// a Cassette - or when replaying, returns the recorded responses without sending any requests.
+//
+// Since the Senders used by every Client route requests through the Recorder which is currently in
+// progress, only one Recorder can be in progress at a time - as such tests which are being recorded
+// or replayed can't be run in parallel.
+type Recorder struct {
+	mode Mode
+
+	lock       sync.Mutex
+	cassette   *Cassette
+	replayed   []bool
+	variables  map[string]int
+	references int
+}
+
+var (
+	active     *Recorder
+	activeLock = sync.Mutex{}
+)
+
+// Start starts recording (or replaying, depending on the current Mode) the requests made during the
+// specified test - when a Recorder is already in progress for this test that Recorder is returned.
+// The Caller is responsible for calling Stop once the test has completed.
+func Start(name string) (*Recorder, error) {
+	mode := CurrentMode()
+	if mode == ModeLive {
+		return nil, fmt.Errorf("recordings can only be started when `%s` is set to %q or %q", ModeEnvVar, ModeRecord, ModeReplay)
+	}
+
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	if active != nil {
+		if active.cassette.Name != name {
+			return nil, fmt.Errorf("unable to start the recording %q since the recording %q is in progress - tests which are recorded can't be run in parallel", name, active.cassette.Name)
+		}
+
+		active.references++
+		return active, nil
+	}
+
+	recorder := &Recorder{
+		mode:       mode,
+		variables:  make(map[string]int),
+		references: 1,
+	}
+
+	if mode == ModeReplay {
+		cassette, err := loadCassette(name)
+		if err != nil {
+			return nil, err
+		}
+		recorder.cassette = cassette
+		recorder.replayed = make([]bool, len(cassette.Interactions))
+	} else {
+		recorder.cassette = &Cassette{
+			Name:         name,
+			Variables:    make(map[string][]string),
+			Interactions: make([]Interaction, 0),
+		}
+	}
+
+	log.Printf("[DEBUG] Recording: started %s of %q", mode, name)
+	active = recorder
+	return recorder, nil
+}
+
+// Stop stops this Recorder, and when recording writes the Cassette to disk
+func (r *Recorder) Stop() error {
+	activeLock.Lock()
+	defer activeLock.Unlock()
+
+	r.references--
+	if r.references > 0 {
+		return nil
+	}
+
+	if active == r {
+		active = nil
+	}
+	log.Printf("[DEBUG] Recording: stopped %s of %q", r.mode, r.cassette.Name)
+
+	if r.mode != ModeRecord {
+		return nil
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return r.cassette.save()
+}
+
+// Mode returns whether this Recorder is recording or replaying requests
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// Variable returns a value which is used during the test, for example a random integer. When recording
+// the value is generated and stored in the Cassette, when replaying the recorded value is returned.
+// Variables can be retrieved multiple times, with each value being returned in the order it was generated.
+func (r *Recorder) Variable(name string, generate func() string) (string, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.mode == ModeRecord {
+		value := generate()
+		r.cassette.Variables[name] = append(r.cassette.Variables[name], value)
+		return value, nil
+	}
+
+	index := r.variables[name]
+	values := r.cassette.Variables[name]
+	if index >= len(values) {
+		return "", fmt.Errorf("the variable %q was retrieved %d times but only %d values were recorded in %q", name, index+1, len(values), r.cassette.Name)
+	}
+
+	r.variables[name]++
+	return values[index], nil
+}
+
+// WithRecording returns a SendDecorator which routes requests through the Recorder which is currently
+// in progress - when no Recorder is in progress requests are sent as-is, unless the Mode is Replay.
+func WithRecording(mode Mode) autorest.SendDecorator {
+	return func(s autorest.Sender) autorest.Sender {
+		return autorest.SenderFunc(func(r *http.Request) (*http.Response, error) {
+			if mode == ModeLive {
+				return s.Do(r)
+			}
+
+			activeLock.Lock()
+			recorder := active
+			activeLock.Unlock()
+
+			if recorder == nil {
+				if mode == ModeReplay {
+					return nil, fmt.Errorf("unable to replay %s %s since no recording is in progress", r.Method, r.URL)
+				}
+
+				return s.Do(r)
+			}
+
+			return recorder.do(s, r)
+		})
+	}
+}
+
+func (r *Recorder) do(s autorest.Sender, req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("reading the request body for %s %s: %+v", req.Method, req.URL, err)
+		}
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	request := Request{
+		Method: req.Method,
+		URL:    scrubURL(req.URL),
+		Body:   scrubBody(body),
+	}
+
+	if r.mode == ModeReplay {
+		return r.replay(req, request)
+	}
+
+	resp, err := s.Do(req)
+	if err != nil || resp == nil {
+		// there's no response to replay, so this isn't recorded
+		return resp, err
+	}
+
+	var responseBody []byte
+	if resp.Body != nil {
+		responseBody, err = ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = ioutil.NopCloser(bytes.NewReader(responseBody))
+		if err != nil {
+			return resp, fmt.Errorf("reading the response body for %s %s: %+v", req.Method, req.URL, err)
+		}
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.cassette.Interactions = append(r.cassette.Interactions, Interaction{
+		Request: request,
+		Response: Response{
+			StatusCode: resp.StatusCode,
+			Header:     scrubHeader(resp.Header),
+			Body:       scrubBody(responseBody),
+		},
+	})
+
+	return resp, nil
+}
+
+// replay returns the first recorded response for this request which hasn't already been replayed
+func (r *Recorder) replay(req *http.Request, request Request) (*http.Response, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := request.key()
+	for i, v := range r.cassette.Interactions {
+		if r.replayed[i] || v.Request.key() != key {
+			continue
+		}
+
+		r.replayed[i] = true
+		header := v.Response.Header.Clone()
+		if header == nil {
+			header = http.Header{}
+		}
+		// there's no need to wait between polling/retrying requests which are being replayed
+		header.Del("Retry-After")
+
+		return &http.Response{
+			Status:        http.StatusText(v.Response.StatusCode),
+			StatusCode:    v.Response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        header,
+			Body:          ioutil.NopCloser(bytes.NewReader([]byte(v.Response.Body))),
+			ContentLength: int64(len(v.Response.Body)),
+			Request:       req,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("no recorded response for %s %s was found in %q - this test may need to be re-recorded by setting `%s=%s`", request.Method, request.URL, r.cassette.Name, ModeEnvVar, ModeRecord)
+}
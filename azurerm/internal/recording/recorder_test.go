@@ -0,0 +1,222 @@
+package recording
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Azure/go-autorest/autorest"
+)
+
+const testSubscriptionId = "11111111-2222-3333-4444-555555555555"
+
+func setEnvironmentVariables(t *testing.T, mode Mode, directory string) func() {
+	values := map[string]string{
+		ModeEnvVar:            string(mode),
+		DirectoryEnvVar:       directory,
+		"ARM_SUBSCRIPTION_ID": testSubscriptionId,
+	}
+
+	existing := map[string]string{}
+	for k, v := range values {
+		existing[k] = os.Getenv(k)
+		if err := os.Setenv(k, v); err != nil {
+			t.Fatalf("setting %q: %+v", k, err)
+		}
+	}
+
+	return func() {
+		for k, v := range existing {
+			os.Setenv(k, v)
+		}
+	}
+}
+
+func sendRequest(t *testing.T, mode Mode, method, url, body string) (*http.Response, string, error) {
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("building request: %+v", err)
+	}
+
+	resp, err := autorest.DecorateSender(http.DefaultClient, WithRecording(mode)).Do(req)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading response body: %+v", err)
+	}
+
+	return resp, string(responseBody), nil
+}
+
+func TestRecorder_RecordAndReplay(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("creating temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count := atomic.AddInt32(&requests, 1)
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprintf(w, `{"id":"%s","count":%d,"properties":{"adminPassword":"P@ssw0rd1234!"}}`, r.URL.Path, count)
+	}))
+	defer server.Close()
+
+	resourceUrl := fmt.Sprintf("%s/subscriptions/%s/resourceGroups/example?api-version=2020-01-01", server.URL, testSubscriptionId)
+
+	// first record the requests
+	reset := setEnvironmentVariables(t, ModeRecord, directory)
+	recorder, err := Start(t.Name())
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	randomValue, err := recorder.Variable("random", func() string { return "abc123" })
+	if err != nil {
+		t.Fatalf("retrieving variable: %+v", err)
+	}
+	if _, _, err := sendRequest(t, ModeRecord, http.MethodPut, resourceUrl, `{"location":"westeurope","tags":{"b":"2","a":"1"}}`); err != nil {
+		t.Fatalf("sending PUT: %+v", err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err := sendRequest(t, ModeRecord, http.MethodGet, resourceUrl, ""); err != nil {
+			t.Fatalf("sending GET: %+v", err)
+		}
+	}
+	if err := recorder.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+	path := cassettePath(t.Name())
+	reset()
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading cassette: %+v", err)
+	}
+	if strings.Contains(string(contents), testSubscriptionId) {
+		t.Fatalf("expected the Subscription ID to be scrubbed from the cassette but it wasn't:\n%s", contents)
+	}
+	if strings.Contains(string(contents), "P@ssw0rd1234!") {
+		t.Fatalf("expected the password to be redacted from the cassette but it wasn't:\n%s", contents)
+	}
+
+	// then replay them, without the server being available
+	server.Close()
+	reset = setEnvironmentVariables(t, ModeReplay, directory)
+	defer reset()
+
+	recorder, err = Start(t.Name())
+	if err != nil {
+		t.Fatalf("starting replay: %+v", err)
+	}
+	defer recorder.Stop()
+
+	replayedValue, err := recorder.Variable("random", func() string { return "something-else" })
+	if err != nil {
+		t.Fatalf("retrieving variable: %+v", err)
+	}
+	if replayedValue != randomValue {
+		t.Fatalf("expected the replayed variable to be %q but got %q", randomValue, replayedValue)
+	}
+
+	// the order of the fields in the body shouldn't matter
+	resp, body, err := sendRequest(t, ModeReplay, http.MethodPut, resourceUrl, `{"tags":{"a":"1","b":"2"},"location":"westeurope"}`)
+	if err != nil {
+		t.Fatalf("replaying PUT: %+v", err)
+	}
+	if resp.Header.Get("Retry-After") != "" {
+		t.Fatalf("expected the Retry-After header to be removed when replaying")
+	}
+	if !strings.Contains(body, `"count":1`) {
+		t.Fatalf("expected the first response to be replayed for the PUT but got %s", body)
+	}
+
+	for i := 2; i <= 3; i++ {
+		_, body, err := sendRequest(t, ModeReplay, http.MethodGet, resourceUrl, "")
+		if err != nil {
+			t.Fatalf("replaying GET: %+v", err)
+		}
+		if !strings.Contains(body, fmt.Sprintf(`"count":%d`, i)) {
+			t.Fatalf("expected response %d to be replayed but got %s", i, body)
+		}
+	}
+
+	// each recorded response is only replayed once
+	if _, _, err := sendRequest(t, ModeReplay, http.MethodGet, resourceUrl, ""); err == nil {
+		t.Fatalf("expected an error when replaying a request which wasn't recorded")
+	}
+
+	if requests != 3 {
+		t.Fatalf("expected 3 requests to be sent to the server but got %d", requests)
+	}
+}
+
+func TestRecorder_ReplayWithoutRecording(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("creating temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	reset := setEnvironmentVariables(t, ModeReplay, directory)
+	defer reset()
+
+	if _, err := Start(t.Name()); err == nil {
+		t.Fatalf("expected an error when the recording doesn't exist")
+	}
+
+	if _, _, err := sendRequest(t, ModeReplay, http.MethodGet, "https://management.azure.com/subscriptions", ""); err == nil {
+		t.Fatalf("expected an error when replaying without a recording in progress")
+	}
+}
+
+func TestRecorder_StartIsReentrant(t *testing.T) {
+	directory, err := ioutil.TempDir("", "recordings")
+	if err != nil {
+		t.Fatalf("creating temp directory: %+v", err)
+	}
+	defer os.RemoveAll(directory)
+
+	reset := setEnvironmentVariables(t, ModeRecord, directory)
+	defer reset()
+
+	first, err := Start(t.Name())
+	if err != nil {
+		t.Fatalf("starting recording: %+v", err)
+	}
+	second, err := Start(t.Name())
+	if err != nil {
+		t.Fatalf("starting recording for the same test: %+v", err)
+	}
+	if first != second {
+		t.Fatalf("expected the same Recorder to be returned for the same test")
+	}
+
+	if _, err := Start("SomeOtherTest"); err == nil {
+		t.Fatalf("expected an error when starting a second recording whilst one is in progress")
+	}
+
+	if err := second.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+	if _, err := os.Stat(cassettePath(t.Name())); err == nil {
+		t.Fatalf("expected the cassette not to be written until the last reference is stopped")
+	}
+
+	if err := first.Stop(); err != nil {
+		t.Fatalf("stopping recording: %+v", err)
+	}
+	if _, err := os.Stat(cassettePath(t.Name())); err != nil {
+		t.Fatalf("expected the cassette to be written: %+v", err)
+	}
+}
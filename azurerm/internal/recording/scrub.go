@@ -0,0 +1,167 @@
+package recording
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// sensitiveFieldPatterns are (case-insensitive) substrings of the names of JSON fields whose
+// values are redacted from recorded requests/responses
+var sensitiveFieldPatterns = []string{
+	"accesskey",
+	"connectionstring",
+	"masterkey",
+	"password",
+	"primarykey",
+	"secondarykey",
+	"secret",
+	"sharedkey",
+}
+
+// ignoredHeaders are the headers which are never recorded
+var ignoredHeaders = []string{
+	"Authorization",
+	"Set-Cookie",
+}
+
+var (
+	scrubbedValues     = map[string]string{}
+	scrubbedValuesLock = sync.RWMutex{}
+)
+
+// ScrubValue registers a value (for example the Object ID of the authenticated principal) which
+// is replaced with the specified placeholder within recorded requests/responses
+func ScrubValue(value, placeholder string) {
+	if value == "" || value == placeholder {
+		return
+	}
+
+	scrubbedValuesLock.Lock()
+	defer scrubbedValuesLock.Unlock()
+
+	scrubbedValues[value] = placeholder
+}
+
+// valuesToScrub returns the values which should be replaced with placeholders, and their placeholders
+func valuesToScrub() map[string]string {
+	output := map[string]string{}
+	for variable, placeholder := range map[string]string{
+		"ARM_CLIENT_ID":       PlaceholderClientId,
+		"ARM_SUBSCRIPTION_ID": PlaceholderSubscriptionId,
+		"ARM_TENANT_ID":       PlaceholderTenantId,
+	} {
+		if v := os.Getenv(variable); v != "" && v != placeholder {
+			output[v] = placeholder
+		}
+	}
+
+	scrubbedValuesLock.RLock()
+	defer scrubbedValuesLock.RUnlock()
+	for k, v := range scrubbedValues {
+		output[k] = v
+	}
+
+	return output
+}
+
+// scrubString replaces any values which should be scrubbed (e.g. the Subscription ID) with their placeholders
+func scrubString(input string) string {
+	for value, placeholder := range valuesToScrub() {
+		input = regexp.MustCompile("(?i)"+regexp.QuoteMeta(value)).ReplaceAllString(input, placeholder)
+	}
+
+	return input
+}
+
+// scrubURL returns the scrubbed URL with the query string in a consistent order
+func scrubURL(input *url.URL) string {
+	output := *input
+	output.RawQuery = output.Query().Encode()
+	return scrubString(output.String())
+}
+
+// scrubBody returns the scrubbed body - JSON bodies are normalized, so that the order of the fields doesn't
+// matter when matching requests, and the values of any sensitive fields are redacted
+func scrubBody(input []byte) string {
+	if len(input) == 0 {
+		return ""
+	}
+
+	body := scrubString(string(input))
+
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return body
+	}
+
+	normalized, err := json.Marshal(redactSensitiveFields(parsed))
+	if err != nil {
+		return body
+	}
+
+	return string(normalized)
+}
+
+func scrubHeader(input http.Header) http.Header {
+	output := http.Header{}
+	for k, values := range input {
+		if isIgnoredHeader(k) {
+			continue
+		}
+
+		for _, v := range values {
+			output.Add(k, scrubString(v))
+		}
+	}
+
+	return output
+}
+
+func redactSensitiveFields(input interface{}) interface{} {
+	switch v := input.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if _, ok := value.(string); ok && isSensitiveField(key) {
+				v[key] = redactedValue
+				continue
+			}
+
+			v[key] = redactSensitiveFields(value)
+		}
+		return v
+
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redactSensitiveFields(value)
+		}
+		return v
+	}
+
+	return input
+}
+
+func isSensitiveField(name string) bool {
+	name = strings.ToLower(name)
+	for _, pattern := range sensitiveFieldPatterns {
+		if strings.Contains(name, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIgnoredHeader(name string) bool {
+	for _, v := range ignoredHeaders {
+		if strings.EqualFold(v, name) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,58 @@
+package recording
+
+import (
+	"testing"
+)
+
+func TestScrubBody(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    string
+		Expected string
+	}{
+		{
+			Name:     "Empty",
+			Input:    "",
+			Expected: "",
+		},
+		{
+			Name:     "Not JSON",
+			Input:    "hello world",
+			Expected: "hello world",
+		},
+		{
+			Name:     "JSON is normalized",
+			Input:    `{ "name": "example", "location": "westeurope" }`,
+			Expected: `{"location":"westeurope","name":"example"}`,
+		},
+		{
+			Name:     "Sensitive Fields are redacted",
+			Input:    `{"properties":{"adminPassword":"secret!","primaryKey":"abc","keys":[{"primaryConnectionString":"def"}],"passwordEnabled":true}}`,
+			Expected: `{"properties":{"adminPassword":"REDACTED","keys":[{"primaryConnectionString":"REDACTED"}],"passwordEnabled":true,"primaryKey":"REDACTED"}}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		actual := scrubBody([]byte(v.Input))
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestScrubValue(t *testing.T) {
+	ScrubValue("7c3bcb2e-0a2b-4e0b-8f2e-3b3c1e0f9a11", PlaceholderObjectId)
+	defer func() {
+		scrubbedValuesLock.Lock()
+		delete(scrubbedValues, "7c3bcb2e-0a2b-4e0b-8f2e-3b3c1e0f9a11")
+		scrubbedValuesLock.Unlock()
+	}()
+
+	expected := `{"objectId":"` + PlaceholderObjectId + `"}`
+	actual := scrubBody([]byte(`{"objectId":"7C3BCB2E-0A2B-4E0B-8F2E-3B3C1E0F9A11"}`))
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
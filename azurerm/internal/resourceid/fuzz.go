@@ -0,0 +1,110 @@
+package resourceid
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+)
+
+// InvalidResourceId is a Resource ID which can't be parsed, along with the name of the Segment which is invalid
+type InvalidResourceId struct {
+	Input   string
+	Segment string
+}
+
+// Format returns the Resource ID for the specified Segments using the specified values - where Static and Resource
+// Provider segments use their fixed value
+func Format(segments []Segment, values map[string]string) string {
+	output := ""
+	for _, segment := range segments {
+		value := values[segment.Name]
+		if segment.FixedValue != nil {
+			value = *segment.FixedValue
+		}
+
+		if segment.Type == ScopeSegmentType {
+			output += "/" + strings.TrimPrefix(value, "/")
+			continue
+		}
+
+		output += "/" + value
+	}
+
+	return output
+}
+
+// RandomValues returns random (valid) values for each of the Segments, which can be used to test
+// that a Resource ID round-trips through the Formatter and the Parser
+func RandomValues(segments []Segment, r *rand.Rand) map[string]string {
+	output := make(map[string]string, len(segments))
+	for _, segment := range segments {
+		switch segment.Type {
+		case ConstantSegmentType:
+			output[segment.Name] = segment.PossibleValues[r.Intn(len(segment.PossibleValues))]
+
+		case ResourceProviderSegmentType, StaticSegmentType:
+			output[segment.Name] = *segment.FixedValue
+
+		case ScopeSegmentType:
+			output[segment.Name] = randomScope(r)
+
+		case UserSpecifiedSegmentType:
+			output[segment.Name] = randomName(r)
+		}
+	}
+
+	return output
+}
+
+// InvalidResourceIds returns Resource IDs which can't be parsed, based on the specified (valid) values, along with
+// the Segment which is invalid - by changing the value of each Static, Resource Provider and Constant segment,
+// and by removing the value for each User Specified segment
+func InvalidResourceIds(segments []Segment, values map[string]string) []InvalidResourceId {
+	output := make([]InvalidResourceId, 0)
+	for i, segment := range segments {
+		var replacement Segment
+		switch segment.Type {
+		case ConstantSegmentType:
+			replacement = StaticSegment(segment.Name, fmt.Sprintf("%sInvalid", values[segment.Name]))
+
+		case ResourceProviderSegmentType, StaticSegmentType:
+			replacement = StaticSegment(segment.Name, fmt.Sprintf("%sInvalid", *segment.FixedValue))
+
+		case UserSpecifiedSegmentType:
+			replacement = StaticSegment(segment.Name, "")
+
+		default:
+			continue
+		}
+
+		invalid := make([]Segment, len(segments))
+		copy(invalid, segments)
+		invalid[i] = replacement
+		output = append(output, InvalidResourceId{
+			Input:   Format(invalid, values),
+			Segment: segment.Name,
+		})
+	}
+
+	return output
+}
+
+func randomName(r *rand.Rand) string {
+	const characters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_.()"
+	output := make([]byte, 1+r.Intn(24))
+	for i := range output {
+		output[i] = characters[r.Intn(len(characters))]
+	}
+	return string(output)
+}
+
+func randomScope(r *rand.Rand) string {
+	subscriptionId := fmt.Sprintf("%08x-%04x-%04x-%04x-%012x", r.Uint32(), r.Intn(0x10000), r.Intn(0x10000), r.Intn(0x10000), r.Int63n(0x1000000000000))
+	scopes := []string{
+		fmt.Sprintf("/subscriptions/%s", subscriptionId),
+		fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionId, randomName(r)),
+		fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/virtualMachines/%s", subscriptionId, randomName(r), randomName(r)),
+		fmt.Sprintf("/providers/Microsoft.Management/managementGroups/%s", randomName(r)),
+	}
+	return scopes[r.Intn(len(scopes))]
+}
@@ -0,0 +1,44 @@
+package resourceid
+
+import "strings"
+
+// NormalizeCasing returns the specified Resource ID with the casing of the well-known segment names normalised -
+// that is `subscriptions`, `resourceGroups`, `providers` and Management Groups (`Microsoft.Management/managementGroups`)
+// since some API's return these in a different casing (e.g. `resourcegroups`).
+//
+// The values within the Resource ID (e.g. the name of the Resource Group) are left as-is, and values which aren't
+// a Resource ID (e.g. a URI or a UUID) are returned unchanged.
+func NormalizeCasing(input string) string {
+	if !strings.HasPrefix(input, "/") {
+		return input
+	}
+
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	if len(parts)%2 != 0 {
+		return input
+	}
+
+	for i := 0; i < len(parts); i += 2 {
+		if parts[i] == "" || parts[i+1] == "" {
+			return input
+		}
+
+		switch {
+		case i == 0 && strings.EqualFold(parts[i], "subscriptions"):
+			parts[i] = "subscriptions"
+
+		case i == 2 && parts[0] == "subscriptions" && strings.EqualFold(parts[i], "resourceGroups"):
+			parts[i] = "resourceGroups"
+
+		case strings.EqualFold(parts[i], "providers"):
+			parts[i] = "providers"
+
+			if i+2 < len(parts) && strings.EqualFold(parts[i+1], "Microsoft.Management") && strings.EqualFold(parts[i+2], "managementGroups") {
+				parts[i+1] = "Microsoft.Management"
+				parts[i+2] = "managementGroups"
+			}
+		}
+	}
+
+	return "/" + strings.Join(parts, "/")
+}
@@ -0,0 +1,72 @@
+package resourceid
+
+import "testing"
+
+func TestNormalizeCasing(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			// empty
+			Input:    "",
+			Expected: "",
+		},
+		{
+			// not a resource id
+			Input:    "https://example.vault.azure.net/keys/Key1",
+			Expected: "https://example.vault.azure.net/keys/Key1",
+		},
+		{
+			// uneven number of segments
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS",
+			Expected: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS",
+		},
+		{
+			// empty segment
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS//",
+			Expected: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS//",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1",
+		},
+		{
+			Input:    "/Subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1",
+		},
+		{
+			// the resource provider and resource types are left as-is
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/Group1/Providers/microsoft.network/VirtualNetworks/Network1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/microsoft.network/VirtualNetworks/Network1",
+		},
+		{
+			// resourceGroups is only normalised within a subscription
+			Input:    "/providers/Microsoft.Billing/billingAccounts/12345678/ResourceGroups/Group1",
+			Expected: "/providers/Microsoft.Billing/billingAccounts/12345678/ResourceGroups/Group1",
+		},
+		{
+			// subscriptions is only normalised at the start of a resource id
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.ServiceBus/namespaces/Namespace1/topics/Topic1/Subscriptions/Subscription1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/Group1/providers/Microsoft.ServiceBus/namespaces/Namespace1/topics/Topic1/Subscriptions/Subscription1",
+		},
+		{
+			Input:    "/PROVIDERS/MICROSOFT.MANAGEMENT/MANAGEMENTGROUPS/Group1",
+			Expected: "/providers/Microsoft.Management/managementGroups/Group1",
+		},
+		{
+			// extension resource
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/Microsoft.Authorization/locks/Lock1",
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/Lock1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := NormalizeCasing(v.Input)
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
@@ -0,0 +1,188 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parser parses a Resource ID based on the Segments it's comprised of - unlike `azure.ParseAzureResourceID`
+// this doesn't assume that the Resource ID is made up of key/value pairs, and as such supports Static segments,
+// Scopes (e.g. `/{scope}/providers/Microsoft.Authorization/locks/{name}`) and Extension Resources - and returns
+// a SegmentError specifying which Segment was invalid when the Resource ID can't be parsed.
+type Parser struct {
+	segments []Segment
+}
+
+// ParseResult is the result of parsing a Resource ID
+type ParseResult struct {
+	// Parsed is a map of the Segment Name to the value for that Segment
+	Parsed map[string]string
+}
+
+// NewParser returns a Parser for a Resource ID made up of the specified Segments - at most one of which
+// can be a Scope segment
+func NewParser(segments []Segment) Parser {
+	return Parser{
+		segments: segments,
+	}
+}
+
+// Parse parses the specified Resource ID - when `insensitively` is true the values of Static, Resource Provider
+// and Constant segments are matched case-insensitively, and the expected casing is returned.
+func (p Parser) Parse(input string, insensitively bool) (*ParseResult, error) {
+	if input == "" {
+		return nil, fmt.Errorf("the Resource ID was empty")
+	}
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("parsing the Resource ID %q: expected the Resource ID to start with a `/`", input)
+	}
+
+	scopeIndex := -1
+	for i, segment := range p.segments {
+		if segment.Type != ScopeSegmentType {
+			continue
+		}
+
+		if scopeIndex != -1 {
+			return nil, fmt.Errorf("parsing the Resource ID %q: only a single Scope segment is supported but got %q and %q", input, p.segments[scopeIndex].Name, segment.Name)
+		}
+		scopeIndex = i
+	}
+
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	result := ParseResult{
+		Parsed: make(map[string]string),
+	}
+
+	if scopeIndex == -1 {
+		if _, err := p.parseSegments(input, p.segments, parts, 0, insensitively, result.Parsed); err != nil {
+			return nil, err
+		}
+
+		return &result, nil
+	}
+
+	// the segments before the scope are matched from the start of the Resource ID
+	prefix := p.segments[:scopeIndex]
+	prefixParts := parts
+	if len(prefixParts) > len(prefix) {
+		prefixParts = parts[:len(prefix)]
+	}
+	if _, err := p.parseSegments(input, prefix, prefixParts, 0, insensitively, result.Parsed); err != nil {
+		return nil, err
+	}
+
+	scope := p.segments[scopeIndex]
+	remaining := parts[len(prefix):]
+	if len(remaining) == 0 {
+		return nil, SegmentError{
+			ResourceId: input,
+			Segment:    scope,
+			Position:   len(prefix),
+			Message:    "was missing",
+		}
+	}
+
+	// the segments after the scope are matched from the end of the Resource ID, with the scope being everything
+	// in-between. When these don't match we look for the most likely position of the scope (where the most
+	// segments match) to be able to return the segment which is invalid.
+	suffix := p.segments[scopeIndex+1:]
+	scopeLength := len(remaining) - len(suffix)
+	if scopeLength >= 1 {
+		if _, err := p.parseSegments(input, suffix, remaining[scopeLength:], len(prefix)+scopeLength, insensitively, result.Parsed); err == nil {
+			for i, v := range remaining[:scopeLength] {
+				if v == "" {
+					return nil, SegmentError{
+						ResourceId: input,
+						Segment:    scope,
+						Position:   len(prefix) + i,
+						Message:    "contained an empty segment",
+					}
+				}
+			}
+
+			scopeValue := "/" + strings.Join(remaining[:scopeLength], "/")
+			if _, err := ParseScope(scopeValue); err != nil {
+				return nil, SegmentError{
+					ResourceId: input,
+					Segment:    scope,
+					Position:   len(prefix),
+					Message:    fmt.Sprintf("was not a valid Scope: %+v", err),
+				}
+			}
+
+			result.Parsed[scope.Name] = scopeValue
+			return &result, nil
+		}
+	}
+
+	bestLength := -1
+	bestScore := -1
+	for length := 0; length <= len(remaining); length++ {
+		score := p.scoreSegments(suffix, remaining[length:], insensitively)
+		if score > bestScore || (score == bestScore && length == scopeLength) {
+			bestLength = length
+			bestScore = score
+		}
+	}
+
+	if bestLength == 0 {
+		return nil, SegmentError{
+			ResourceId: input,
+			Segment:    scope,
+			Position:   len(prefix),
+			Message:    "was missing",
+		}
+	}
+
+	_, err := p.parseSegments(input, suffix, remaining[bestLength:], len(prefix)+bestLength, insensitively, make(map[string]string))
+	return nil, err
+}
+
+// scoreSegments returns the number of Segments which match the path segments at the same position
+func (p Parser) scoreSegments(segments []Segment, parts []string, insensitively bool) int {
+	score := 0
+	for i, segment := range segments {
+		if i >= len(parts) {
+			break
+		}
+
+		if _, message := segment.matches(parts[i], insensitively); message == nil {
+			score++
+		}
+	}
+
+	return score
+}
+
+// parseSegments parses the specified path segments (which start at the specified offset within the Resource ID)
+// returning the number of Segments which were matched prior to any error
+func (p Parser) parseSegments(input string, segments []Segment, parts []string, offset int, insensitively bool, parsed map[string]string) (int, error) {
+	for i, segment := range segments {
+		if i >= len(parts) {
+			return i, SegmentError{
+				ResourceId: input,
+				Segment:    segment,
+				Position:   offset + i,
+				Message:    "was missing",
+			}
+		}
+
+		value, message := segment.matches(parts[i], insensitively)
+		if message != nil {
+			return i, SegmentError{
+				ResourceId: input,
+				Segment:    segment,
+				Position:   offset + i,
+				Message:    *message,
+			}
+		}
+		parsed[segment.Name] = value
+	}
+
+	if len(parts) > len(segments) {
+		return len(segments), fmt.Errorf("parsing the Resource ID %q: unexpected segment %q at position %d", input, parts[len(segments)], offset+len(segments))
+	}
+
+	return len(segments), nil
+}
@@ -0,0 +1,214 @@
+package resourceid
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestParser(t *testing.T) {
+	lockSegments := []Segment{
+		ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1"),
+		StaticSegment("staticProviders", "providers"),
+		ResourceProviderSegment("staticMicrosoftAuthorization", "Microsoft.Authorization"),
+		StaticSegment("staticLocks", "locks"),
+		UserSpecifiedSegment("name", "lock1"),
+	}
+	skuSegments := []Segment{
+		StaticSegment("staticSubscriptions", "subscriptions"),
+		UserSpecifiedSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		StaticSegment("staticProviders", "providers"),
+		ResourceProviderSegment("staticMicrosoftEmulator", "Microsoft.Emulator"),
+		StaticSegment("staticSkus", "skus"),
+		ConstantSegment("sku", []string{"Basic", "Standard"}, "Basic"),
+	}
+
+	testData := []struct {
+		Name          string
+		Segments      []Segment
+		Input         string
+		Insensitively bool
+		Expected      map[string]string
+		Segment       string
+	}{
+		{
+			Name:     "Empty",
+			Segments: skuSegments,
+			Input:    "",
+		},
+		{
+			Name:     "Missing leading slash",
+			Segments: skuSegments,
+			Input:    "subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Emulator/skus/Basic",
+		},
+		{
+			Name:     "Valid",
+			Segments: skuSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Emulator/skus/Basic",
+			Expected: map[string]string{
+				"subscriptionId": "12345678-1234-9876-4563-123456789012",
+				"sku":            "Basic",
+			},
+		},
+		{
+			Name:     "Missing Constant",
+			Segments: skuSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Emulator/skus",
+			Segment:  "sku",
+		},
+		{
+			Name:     "Invalid Constant",
+			Segments: skuSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Emulator/skus/Premium",
+			Segment:  "sku",
+		},
+		{
+			Name:     "Wrong Resource Provider",
+			Segments: skuSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Other/skus/Basic",
+			Segment:  "staticMicrosoftEmulator",
+		},
+		{
+			Name:     "Empty User Specified",
+			Segments: skuSegments,
+			Input:    "/subscriptions//providers/Microsoft.Emulator/skus/Basic",
+			Segment:  "subscriptionId",
+		},
+		{
+			Name:     "Upper-cased",
+			Segments: skuSegments,
+			Input:    "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.EMULATOR/SKUS/BASIC",
+			Segment:  "staticSubscriptions",
+		},
+		{
+			Name:          "Upper-cased Insensitively",
+			Segments:      skuSegments,
+			Input:         "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.EMULATOR/SKUS/BASIC",
+			Insensitively: true,
+			Expected: map[string]string{
+				"subscriptionId":          "12345678-1234-9876-4563-123456789012",
+				"staticMicrosoftEmulator": "Microsoft.Emulator",
+				"sku":                     "Basic",
+			},
+		},
+		{
+			Name:     "Extra Segments",
+			Segments: skuSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Emulator/skus/Basic/extra",
+		},
+		{
+			Name:     "Scope is a Subscription",
+			Segments: lockSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: map[string]string{
+				"scope": "/subscriptions/12345678-1234-9876-4563-123456789012",
+				"name":  "lock1",
+			},
+		},
+		{
+			Name:     "Scope is a Resource",
+			Segments: lockSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: map[string]string{
+				"scope": "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1",
+				"name":  "lock1",
+			},
+		},
+		{
+			Name:     "Scope is missing",
+			Segments: lockSegments,
+			Input:    "/providers/Microsoft.Authorization/locks/lock1",
+			Segment:  "scope",
+		},
+		{
+			Name:     "Scope contains an empty segment",
+			Segments: lockSegments,
+			Input:    "/subscriptions//providers/Microsoft.Authorization/locks/lock1",
+			Segment:  "scope",
+		},
+		{
+			Name:     "Missing Name after a Scope",
+			Segments: lockSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks",
+			Segment:  "name",
+		},
+		{
+			Name:     "Wrong Static Segment after a Scope",
+			Segments: lockSegments,
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/assignments/lock1",
+			Segment:  "staticLocks",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		actual, err := NewParser(v.Segments).Parse(v.Input, v.Insensitively)
+		if err != nil {
+			if v.Expected != nil {
+				t.Fatalf("Expected no error but got: %+v", err)
+			}
+
+			if v.Segment != "" {
+				var segmentErr SegmentError
+				if !errors.As(err, &segmentErr) {
+					t.Fatalf("Expected a SegmentError but got: %+v", err)
+				}
+				if segmentErr.Segment.Name != v.Segment {
+					t.Fatalf("Expected the segment %q to be invalid but got %q: %+v", v.Segment, segmentErr.Segment.Name, err)
+				}
+			}
+
+			continue
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		for key, value := range v.Expected {
+			if actual.Parsed[key] != value {
+				t.Fatalf("Expected %q to be %q but got %q", key, value, actual.Parsed[key])
+			}
+		}
+	}
+}
+
+func TestParserRoundTrip(t *testing.T) {
+	segments := []Segment{
+		ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012"),
+		StaticSegment("staticProviders", "providers"),
+		ResourceProviderSegment("staticMicrosoftEmulator", "Microsoft.Emulator"),
+		StaticSegment("staticThings", "things"),
+		UserSpecifiedSegment("thingName", "thing1"),
+		StaticSegment("staticSkus", "skus"),
+		ConstantSegment("sku", []string{"Basic", "Standard"}, "Basic"),
+	}
+	parser := NewParser(segments)
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		values := RandomValues(segments, r)
+		input := Format(segments, values)
+
+		actual, err := parser.Parse(input, false)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", input, err)
+		}
+		for _, segment := range segments {
+			if actual.Parsed[segment.Name] != values[segment.Name] {
+				t.Fatalf("Expected %q to be %q but got %q for %q", segment.Name, values[segment.Name], actual.Parsed[segment.Name], input)
+			}
+		}
+
+		for _, invalid := range InvalidResourceIds(segments, values) {
+			_, err := parser.Parse(invalid.Input, false)
+			var segmentErr SegmentError
+			if !errors.As(err, &segmentErr) {
+				t.Fatalf("Expected a SegmentError for %q but got: %+v", invalid.Input, err)
+			}
+			if segmentErr.Segment.Name != invalid.Segment {
+				t.Fatalf("Expected the segment %q to be invalid for %q but got %q", invalid.Segment, invalid.Input, segmentErr.Segment.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopeType is the type of Scope which an Extension Resource exists within
+type ScopeType string
+
+const (
+	// ManagementGroupScopeType is a Management Group, e.g. `/providers/Microsoft.Management/managementGroups/group1`
+	ManagementGroupScopeType ScopeType = "ManagementGroup"
+
+	// ResourceGroupScopeType is a Resource Group, e.g. `/subscriptions/{subscriptionId}/resourceGroups/group1`
+	ResourceGroupScopeType ScopeType = "ResourceGroup"
+
+	// ResourceScopeType is a Resource (or a Child Resource) within a Management Group, Subscription,
+	// Resource Group, or the Tenant - e.g. `/subscriptions/{subscriptionId}/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1`
+	ResourceScopeType ScopeType = "Resource"
+
+	// SubscriptionScopeType is a Subscription, e.g. `/subscriptions/{subscriptionId}`
+	SubscriptionScopeType ScopeType = "Subscription"
+)
+
+// Scope is a parsed Scope for an Extension Resource
+type Scope struct {
+	// Type is the type of this Scope
+	Type ScopeType
+
+	// ManagementGroupName is the name of the Management Group this Scope is within, if any
+	ManagementGroupName string
+
+	// SubscriptionId is the ID of the Subscription this Scope is within, if any
+	SubscriptionId string
+
+	// ResourceGroup is the name of the Resource Group this Scope is within, if any
+	ResourceGroup string
+
+	// ResourceProvider is the Resource Provider of the Resource, when this Scope is a Resource - e.g. `Microsoft.Network`
+	ResourceProvider string
+
+	// ResourceType is the Resource Type of the Resource, when this Scope is a Resource - which for a Child Resource
+	// includes the types of the parent Resources, e.g. `virtualNetworks/subnets`
+	ResourceType string
+
+	// ResourceName is the name of the Resource, when this Scope is a Resource
+	ResourceName string
+}
+
+// ParseScope parses the specified Scope, which can be a Management Group, a Subscription, a Resource Group or
+// a Resource (including Child Resources and Extension Resources) - where the names of segments (for example
+// `resourceGroups`) are matched case-insensitively, since the Scope is passed to the API as-is.
+func ParseScope(input string) (*Scope, error) {
+	if input == "" {
+		return nil, fmt.Errorf("the Scope was empty")
+	}
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("parsing the Scope %q: expected the Scope to start with a `/`", input)
+	}
+
+	parts := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	for i, v := range parts {
+		if v == "" {
+			return nil, fmt.Errorf("parsing the Scope %q: the segment at position %d was empty", input, i)
+		}
+	}
+
+	scope := Scope{}
+	index := 0
+	switch {
+	case strings.EqualFold(parts[0], "subscriptions"):
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("parsing the Scope %q: the Subscription ID was missing", input)
+		}
+		scope.Type = SubscriptionScopeType
+		scope.SubscriptionId = parts[1]
+		index = 2
+
+		if len(parts) > 2 && strings.EqualFold(parts[2], "resourceGroups") {
+			if len(parts) < 4 {
+				return nil, fmt.Errorf("parsing the Scope %q: the Resource Group name was missing", input)
+			}
+			scope.Type = ResourceGroupScopeType
+			scope.ResourceGroup = parts[3]
+			index = 4
+		}
+
+	case len(parts) >= 3 && strings.EqualFold(parts[0], "providers") && strings.EqualFold(parts[1], "Microsoft.Management") && strings.EqualFold(parts[2], "managementGroups"):
+		if len(parts) < 4 {
+			return nil, fmt.Errorf("parsing the Scope %q: the Management Group name was missing", input)
+		}
+		scope.Type = ManagementGroupScopeType
+		scope.ManagementGroupName = parts[3]
+		index = 4
+
+	case strings.EqualFold(parts[0], "providers"):
+		// a Resource within the Tenant, e.g. a Billing Account
+
+	default:
+		return nil, fmt.Errorf("parsing the Scope %q: expected the Scope to start with `/subscriptions`, `/providers/Microsoft.Management/managementGroups` or `/providers`", input)
+	}
+
+	// anything after this is a Resource, which can be a Child Resource (e.g. `virtualNetworks/network1/subnets/subnet1`)
+	// or an Extension Resource of another Resource (e.g. `.../providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Insights/...`)
+	for index < len(parts) {
+		if !strings.EqualFold(parts[index], "providers") {
+			return nil, fmt.Errorf("parsing the Scope %q: expected the segment at position %d to be `providers` but got %q", input, index, parts[index])
+		}
+		if index+1 >= len(parts) {
+			return nil, fmt.Errorf("parsing the Scope %q: the Resource Provider was missing", input)
+		}
+		resourceProvider := parts[index+1]
+		index += 2
+
+		resourceTypes := make([]string, 0)
+		resourceName := ""
+		for index < len(parts) && !strings.EqualFold(parts[index], "providers") {
+			if index+1 >= len(parts) {
+				return nil, fmt.Errorf("parsing the Scope %q: the name for the Resource Type %q was missing", input, parts[index])
+			}
+			resourceTypes = append(resourceTypes, parts[index])
+			resourceName = parts[index+1]
+			index += 2
+		}
+		if len(resourceTypes) == 0 {
+			return nil, fmt.Errorf("parsing the Scope %q: the Resource Type for the Resource Provider %q was missing", input, resourceProvider)
+		}
+
+		scope.Type = ResourceScopeType
+		scope.ResourceProvider = resourceProvider
+		scope.ResourceType = strings.Join(resourceTypes, "/")
+		scope.ResourceName = resourceName
+	}
+
+	if scope.Type == "" {
+		return nil, fmt.Errorf("parsing the Scope %q: the Resource Provider was missing", input)
+	}
+
+	return &scope, nil
+}
@@ -0,0 +1,172 @@
+package resourceid
+
+import "testing"
+
+func TestParseScope(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected *Scope
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// missing leading slash
+			Input: "subscriptions/12345678-1234-9876-4563-123456789012",
+		},
+		{
+			// trailing slash
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
+		},
+		{
+			// missing subscription id
+			Input: "/subscriptions",
+		},
+		{
+			// unknown root
+			Input: "/resourceGroups/group1",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Expected: &Scope{
+				Type:           SubscriptionScopeType,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+			},
+		},
+		{
+			// missing resource group name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups",
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: &Scope{
+				Type:           ResourceGroupScopeType,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+			},
+		},
+		{
+			// lower-cased resource group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourcegroups/group1",
+			Expected: &Scope{
+				Type:           ResourceGroupScopeType,
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "group1",
+			},
+		},
+		{
+			Input: "/providers/Microsoft.Management/managementGroups/group1",
+			Expected: &Scope{
+				Type:                ManagementGroupScopeType,
+				ManagementGroupName: "group1",
+			},
+		},
+		{
+			// missing management group name
+			Input: "/providers/Microsoft.Management/managementGroups",
+		},
+		{
+			// missing resource provider
+			Input: "/providers",
+		},
+		{
+			Input: "/providers/Microsoft.Billing/billingAccounts/account1/enrollmentAccounts/enrollment1",
+			Expected: &Scope{
+				Type:             ResourceScopeType,
+				ResourceProvider: "Microsoft.Billing",
+				ResourceType:     "billingAccounts/enrollmentAccounts",
+				ResourceName:     "enrollment1",
+			},
+		},
+		{
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &Scope{
+				Type:             ResourceScopeType,
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "group1",
+				ResourceProvider: "Microsoft.Network",
+				ResourceType:     "virtualNetworks",
+				ResourceName:     "network1",
+			},
+		},
+		{
+			// child resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &Scope{
+				Type:             ResourceScopeType,
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "group1",
+				ResourceProvider: "Microsoft.Network",
+				ResourceType:     "virtualNetworks/subnets",
+				ResourceName:     "subnet1",
+			},
+		},
+		{
+			// missing child resource name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets",
+		},
+		{
+			// missing resource type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network",
+		},
+		{
+			// unexpected segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/virtualNetworks/network1",
+		},
+		{
+			// resource within a subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/pricings/VirtualMachines",
+			Expected: &Scope{
+				Type:             ResourceScopeType,
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceProvider: "Microsoft.Security",
+				ResourceType:     "pricings",
+				ResourceName:     "VirtualMachines",
+			},
+		},
+		{
+			// resource within a management group
+			Input: "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/policyDefinitions/definition1",
+			Expected: &Scope{
+				Type:                ResourceScopeType,
+				ManagementGroupName: "group1",
+				ResourceProvider:    "Microsoft.Authorization",
+				ResourceType:        "policyDefinitions",
+				ResourceName:        "definition1",
+			},
+		},
+		{
+			// extension resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Compute/virtualMachines/vm1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &Scope{
+				Type:             ResourceScopeType,
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "group1",
+				ResourceProvider: "Microsoft.Authorization",
+				ResourceType:     "locks",
+				ResourceName:     "lock1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ParseScope(v.Input)
+		if err != nil {
+			if v.Expected == nil {
+				continue
+			}
+
+			t.Fatalf("Expected a value but got an error: %+v", err)
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
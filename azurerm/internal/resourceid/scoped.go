@@ -0,0 +1,87 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ScopedId is the ID of an Extension Resource - a resource which exists within another Scope (a Management Group,
+// a Subscription, a Resource Group or another Resource) such as a Management Lock or a Role Assignment, in the format
+// `{scope}/providers/{provider}/{resourceType}/{name}`
+type ScopedId struct {
+	// Scope is the ID of the Scope this Extension Resource exists within
+	Scope string
+
+	// Provider is the Resource Provider of this Extension Resource, e.g. `Microsoft.Authorization`
+	Provider string
+
+	// ResourceType is the Resource Type of this Extension Resource, e.g. `locks`
+	ResourceType string
+
+	// Name is the name of this Extension Resource
+	Name string
+}
+
+func NewScopedID(scope, provider, resourceType, name string) ScopedId {
+	return ScopedId{
+		Scope:        scope,
+		Provider:     provider,
+		ResourceType: resourceType,
+		Name:         name,
+	}
+}
+
+func (id ScopedId) String() string {
+	return fmt.Sprintf("%s/%s: (Name %q / Scope %q)", id.Provider, id.ResourceType, id.Name, id.Scope)
+}
+
+func (id ScopedId) ID() string {
+	fmtString := "/%s/providers/%s/%s/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.Provider, id.ResourceType, id.Name)
+}
+
+// Segments returns the Segments which make up this Scoped ID
+func (id ScopedId) Segments() []Segment {
+	return ScopedSegments(id.Provider, id.ResourceType)
+}
+
+// ParsedScope parses the Scope this Extension Resource exists within
+func (id ScopedId) ParsedScope() (*Scope, error) {
+	return ParseScope(id.Scope)
+}
+
+// ScopedSegments returns the Segments for an Extension Resource of the specified Resource Provider
+// (e.g. `Microsoft.Authorization`) and Resource Type (e.g. `locks`) within any Scope
+func ScopedSegments(provider, resourceType string) []Segment {
+	return []Segment{
+		ScopeSegment("scope", "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1"),
+		StaticSegment("staticProviders", "providers"),
+		ResourceProviderSegment("provider", provider),
+		StaticSegment("resourceType", resourceType),
+		UserSpecifiedSegment("name", "name1"),
+	}
+}
+
+// ParseScopedID parses the specified ID as an Extension Resource of the specified Resource Provider
+// and Resource Type, within any Scope
+func ParseScopedID(input, provider, resourceType string) (*ScopedId, error) {
+	return parseScopedID(input, provider, resourceType, false)
+}
+
+// ParseScopedIDInsensitively parses the specified ID as an Extension Resource of the specified Resource Provider
+// and Resource Type, within any Scope - matching the Resource Provider and Resource Type insensitively.
+//
+// This should only be used to parse an ID for rewriting, ParseScopedID should be used for validation etc.
+func ParseScopedIDInsensitively(input, provider, resourceType string) (*ScopedId, error) {
+	return parseScopedID(input, provider, resourceType, true)
+}
+
+func parseScopedID(input, provider, resourceType string, insensitively bool) (*ScopedId, error) {
+	result, err := NewParser(ScopedSegments(provider, resourceType)).Parse(input, insensitively)
+	if err != nil {
+		return nil, err
+	}
+
+	id := NewScopedID(result.Parsed["scope"], provider, resourceType, result.Parsed["name"])
+	return &id, nil
+}
@@ -0,0 +1,142 @@
+package resourceid
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+var _ Formatter = ScopedId{}
+
+func TestScopedIDFormatter(t *testing.T) {
+	actual := NewScopedID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "Microsoft.Authorization", "locks", "lock1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestParseScopedID(t *testing.T) {
+	testData := []struct {
+		Input         string
+		Insensitively bool
+		Expected      *ScopedId
+		Segment       string
+	}{
+		{
+			// empty
+			Input: "",
+		},
+		{
+			// missing scope
+			Input:   "/providers/Microsoft.Authorization/locks/lock1",
+			Segment: "scope",
+		},
+		{
+			// invalid scope
+			Input:   "/resourceGroups/group1/providers/Microsoft.Authorization/locks/lock1",
+			Segment: "scope",
+		},
+		{
+			// missing name
+			Input:   "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/",
+			Segment: "name",
+		},
+		{
+			// wrong provider
+			Input:   "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Security/locks/lock1",
+			Segment: "provider",
+		},
+		{
+			// wrong resource type
+			Input:   "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/roleAssignments/lock1",
+			Segment: "resourceType",
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedId{Scope: "/subscriptions/12345678-1234-9876-4563-123456789012", Provider: "Microsoft.Authorization", ResourceType: "locks", Name: "lock1"},
+		},
+		{
+			Input:    "/providers/Microsoft.Management/managementGroups/group1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedId{Scope: "/providers/Microsoft.Management/managementGroups/group1", Provider: "Microsoft.Authorization", ResourceType: "locks", Name: "lock1"},
+		},
+		{
+			Input:    "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1/providers/Microsoft.Authorization/locks/lock1",
+			Expected: &ScopedId{Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1", Provider: "Microsoft.Authorization", ResourceType: "locks", Name: "lock1"},
+		},
+		{
+			// upper-cased
+			Input:   "/subscriptions/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/lock1",
+			Segment: "staticProviders",
+		},
+		{
+			// upper-cased insensitively
+			Input:         "/subscriptions/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/lock1",
+			Insensitively: true,
+			Expected:      &ScopedId{Scope: "/subscriptions/12345678-1234-9876-4563-123456789012", Provider: "Microsoft.Authorization", ResourceType: "locks", Name: "lock1"},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		parse := ParseScopedID
+		if v.Insensitively {
+			parse = ParseScopedIDInsensitively
+		}
+		actual, err := parse(v.Input, "Microsoft.Authorization", "locks")
+		if err != nil {
+			if v.Expected != nil {
+				t.Fatalf("Expected a value but got an error: %+v", err)
+			}
+
+			if v.Segment != "" {
+				var segmentErr SegmentError
+				if !errors.As(err, &segmentErr) {
+					t.Fatalf("Expected a SegmentError but got: %+v", err)
+				}
+				if segmentErr.Segment.Name != v.Segment {
+					t.Fatalf("Expected the segment %q to be invalid but got %q: %+v", v.Segment, segmentErr.Segment.Name, err)
+				}
+			}
+
+			continue
+		}
+		if v.Expected == nil {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		if *actual != *v.Expected {
+			t.Fatalf("Expected %+v but got %+v", *v.Expected, *actual)
+		}
+	}
+}
+
+func TestScopedIDRoundTrip(t *testing.T) {
+	segments := ScopedSegments("Microsoft.Authorization", "locks")
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		values := RandomValues(segments, r)
+		input := NewScopedID(values["scope"], "Microsoft.Authorization", "locks", values["name"]).ID()
+
+		actual, err := ParseScopedID(input, "Microsoft.Authorization", "locks")
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", input, err)
+		}
+		if actual.Scope != values["scope"] {
+			t.Fatalf("Expected %q but got %q for Scope", values["scope"], actual.Scope)
+		}
+		if actual.Name != values["name"] {
+			t.Fatalf("Expected %q but got %q for Name", values["name"], actual.Name)
+		}
+
+		scope, err := actual.ParsedScope()
+		if err != nil {
+			t.Fatalf("parsing the Scope %q: %+v", actual.Scope, err)
+		}
+		if scope.Type == "" {
+			t.Fatalf("Expected the Scope %q to have a Type", actual.Scope)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package resourceid
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SegmentType is the type of a Segment within a Resource ID
+type SegmentType string
+
+const (
+	// ConstantSegmentType is a user-specified value which must be one of a fixed set of values (e.g. a SKU)
+	ConstantSegmentType SegmentType = "Constant"
+
+	// ResourceProviderSegmentType is a Resource Provider Namespace, e.g. `Microsoft.Network`
+	ResourceProviderSegmentType SegmentType = "ResourceProvider"
+
+	// ScopeSegmentType is a Resource ID for any scope (e.g. a Subscription, a Resource Group or another resource)
+	// which is made up of one or more path segments, and is validated using ParseScope
+	ScopeSegmentType SegmentType = "Scope"
+
+	// StaticSegmentType is a fixed value, e.g. `resourceGroups`
+	StaticSegmentType SegmentType = "Static"
+
+	// UserSpecifiedSegmentType is a user-specified value, e.g. the name of a Resource Group
+	UserSpecifiedSegmentType SegmentType = "UserSpecified"
+)
+
+// Segment is a single segment within a Resource ID
+type Segment struct {
+	// Name is the name of this Segment, which is used to refer to this Segment in the parsed result (and in errors)
+	Name string
+
+	// Type is the type of this Segment
+	Type SegmentType
+
+	// FixedValue is the value this Segment must have, for Static and Resource Provider segments
+	FixedValue *string
+
+	// PossibleValues are the values this Segment can have, for Constant segments
+	PossibleValues []string
+
+	// ExampleValue is an example value for this Segment, used in documentation and tests
+	ExampleValue string
+}
+
+// ConstantSegment returns a Segment whose value must be one of the specified possible values
+func ConstantSegment(name string, possibleValues []string, exampleValue string) Segment {
+	return Segment{
+		Name:           name,
+		Type:           ConstantSegmentType,
+		PossibleValues: possibleValues,
+		ExampleValue:   exampleValue,
+	}
+}
+
+// ResourceProviderSegment returns a Segment for the specified Resource Provider Namespace, e.g. `Microsoft.Network`
+func ResourceProviderSegment(name, resourceProvider string) Segment {
+	return Segment{
+		Name:         name,
+		Type:         ResourceProviderSegmentType,
+		FixedValue:   &resourceProvider,
+		ExampleValue: resourceProvider,
+	}
+}
+
+// ScopeSegment returns a Segment which can be any Resource ID, e.g. a Subscription or Resource Group ID
+func ScopeSegment(name, exampleValue string) Segment {
+	return Segment{
+		Name:         name,
+		Type:         ScopeSegmentType,
+		ExampleValue: exampleValue,
+	}
+}
+
+// StaticSegment returns a Segment with a fixed value, e.g. `resourceGroups`
+func StaticSegment(name, value string) Segment {
+	return Segment{
+		Name:         name,
+		Type:         StaticSegmentType,
+		FixedValue:   &value,
+		ExampleValue: value,
+	}
+}
+
+// UserSpecifiedSegment returns a Segment which can be any (non-empty) value, e.g. the name of a resource
+func UserSpecifiedSegment(name, exampleValue string) Segment {
+	return Segment{
+		Name:         name,
+		Type:         UserSpecifiedSegmentType,
+		ExampleValue: exampleValue,
+	}
+}
+
+// SegmentError is returned when a Resource ID can't be parsed, and specifies the Segment which was invalid
+type SegmentError struct {
+	// ResourceId is the Resource ID which was being parsed
+	ResourceId string
+
+	// Segment is the Segment which was invalid
+	Segment Segment
+
+	// Position is the (zero-based) index of the path segment within the Resource ID which was invalid
+	Position int
+
+	// Message describes why the Segment was invalid
+	Message string
+}
+
+func (e SegmentError) Error() string {
+	return fmt.Sprintf("parsing the Resource ID %q: the segment %q (at position %d) %s", e.ResourceId, e.Segment.Name, e.Position, e.Message)
+}
+
+func (s Segment) matches(value string, insensitively bool) (string, *string) {
+	if value == "" {
+		msg := "was empty"
+		return "", &msg
+	}
+
+	switch s.Type {
+	case StaticSegmentType, ResourceProviderSegmentType:
+		if value == *s.FixedValue || (insensitively && strings.EqualFold(value, *s.FixedValue)) {
+			return *s.FixedValue, nil
+		}
+
+		msg := fmt.Sprintf("was expected to be %q but got %q", *s.FixedValue, value)
+		return "", &msg
+
+	case ConstantSegmentType:
+		for _, v := range s.PossibleValues {
+			if value == v || (insensitively && strings.EqualFold(value, v)) {
+				return v, nil
+			}
+		}
+
+		msg := fmt.Sprintf("was expected to be one of [%s] but got %q", strings.Join(s.PossibleValues, ", "), value)
+		return "", &msg
+	}
+
+	return value, nil
+}
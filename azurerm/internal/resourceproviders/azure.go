@@ -3,12 +3,23 @@ package resourceproviders
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2017-03-09/resources/mgmt/resources"
 )
 
-func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*[]string, error) {
+type supportedResourceProviders struct {
+	// Names is a list of the Resource Providers which are supported on this Azure Endpoint
+	Names *[]string
+
+	// ResourceTypeLocations is a map of the lower-cased Resource Type (e.g. `microsoft.compute/virtualmachines`)
+	// to the Locations in which this Resource Type is available
+	ResourceTypeLocations map[string][]string
+}
+
+func availableResourceProviders(ctx context.Context, client *resources.ProvidersClient) (*supportedResourceProviders, error) {
 	providerNames := make([]string, 0)
+	resourceTypeLocations := make(map[string][]string)
 	providers, err := client.ListComplete(ctx, nil, "")
 	if err != nil {
 		return nil, fmt.Errorf("listing Resource Providers: %+v", err)
@@ -17,6 +28,17 @@ func availableResourceProviders(ctx context.Context, client *resources.Providers
 		provider := providers.Value()
 		if provider.Namespace != nil {
 			providerNames = append(providerNames, *provider.Namespace)
+
+			if provider.ResourceTypes != nil {
+				for _, resourceType := range *provider.ResourceTypes {
+					if resourceType.ResourceType == nil || resourceType.Locations == nil {
+						continue
+					}
+
+					key := strings.ToLower(fmt.Sprintf("%s/%s", *provider.Namespace, *resourceType.ResourceType))
+					resourceTypeLocations[key] = *resourceType.Locations
+				}
+			}
 		}
 
 		if err := providers.NextWithContext(ctx); err != nil {
@@ -24,5 +46,8 @@ func availableResourceProviders(ctx context.Context, client *resources.Providers
 		}
 	}
 
-	return &providerNames, nil
+	return &supportedResourceProviders{
+		Names:                 &providerNames,
+		ResourceTypeLocations: resourceTypeLocations,
+	}, nil
 }
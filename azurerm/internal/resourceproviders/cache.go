@@ -10,6 +10,9 @@ import (
 // cachedResourceProviders can be (validly) nil - as such this shouldn't be relied on
 var cachedResourceProviders *[]string
 
+// cachedResourceTypeLocations can be (validly) nil - as such this shouldn't be relied on
+var cachedResourceTypeLocations map[string][]string
+
 // CacheSupportedProviders attempts to retrieve the supported Resource Providers from the Resource Manager API
 // and caches them, for used in enhanced validation
 func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersClient) {
@@ -19,5 +22,6 @@ func CacheSupportedProviders(ctx context.Context, client *resources.ProvidersCli
 		return
 	}
 
-	cachedResourceProviders = providers
+	cachedResourceProviders = providers.Names
+	cachedResourceTypeLocations = providers.ResourceTypeLocations
 }
@@ -0,0 +1,18 @@
+package resourceproviders
+
+// Core returns the Resource Providers which are used by the most common resources within the
+// AzureRM Provider (e.g. Networking, Compute, Storage and Key Vault) - which are registered when
+// the Provider is configured to only register the core set of Resource Providers
+func Core() map[string]struct{} {
+	// NOTE: Resource Providers in this list are case sensitive
+	return map[string]struct{}{
+		"Microsoft.Authorization":   {},
+		"Microsoft.Compute":         {},
+		"Microsoft.KeyVault":        {},
+		"microsoft.insights":        {},
+		"Microsoft.ManagedIdentity": {},
+		"Microsoft.Network":         {},
+		"Microsoft.Resources":       {},
+		"Microsoft.Storage":         {},
+	}
+}
@@ -0,0 +1,22 @@
+package resourceproviders
+
+import (
+	"strings"
+)
+
+// SupportedLocationsForResourceType returns the Locations in which the specified Resource Type
+// (e.g. `Microsoft.Compute/virtualMachines`) is available within this Azure Environment.
+//
+// NOTE: this is best-effort - nil is returned when the supported Locations aren't known
+func SupportedLocationsForResourceType(resourceType string) *[]string {
+	if cachedResourceTypeLocations == nil {
+		return nil
+	}
+
+	locations, ok := cachedResourceTypeLocations[strings.ToLower(resourceType)]
+	if !ok {
+		return nil
+	}
+
+	return &locations
+}
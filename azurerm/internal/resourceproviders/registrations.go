@@ -0,0 +1,74 @@
+package resourceproviders
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// RegistrationSetAll registers all of the Resource Providers supported by the AzureRM Provider
+	RegistrationSetAll = "all"
+
+	// RegistrationSetCore registers only the core set of Resource Providers
+	RegistrationSetCore = "core"
+
+	// RegistrationSetNone doesn't register any Resource Providers, other than those specified explicitly
+	RegistrationSetNone = "none"
+)
+
+// ForRegistrationSet returns the Resource Providers which should be registered for the specified
+// Registration Set, combined with the additional Resource Providers specified
+func ForRegistrationSet(set string, additional []string) map[string]struct{} {
+	output := make(map[string]struct{})
+
+	switch set {
+	case RegistrationSetAll:
+		output = Required()
+	case RegistrationSetCore:
+		output = Core()
+	}
+
+	for _, v := range additional {
+		output[v] = struct{}{}
+	}
+
+	return output
+}
+
+// ValidateRegistrations validates that each of the specified Resource Providers is supported by this
+// Azure Environment, using the cached list of Resource Providers.
+//
+// NOTE: this is best-effort - when the list of Resource Providers hasn't been cached no error is returned
+func ValidateRegistrations(input []string) error {
+	if cachedResourceProviders == nil {
+		return nil
+	}
+
+	errors := make([]string, 0)
+	for _, v := range input {
+		found := false
+		for _, provider := range *cachedResourceProviders {
+			if provider == v {
+				found = true
+				break
+			}
+
+			// Resource Providers are registered using the casing returned from the API
+			if strings.EqualFold(provider, v) {
+				errors = append(errors, fmt.Sprintf("the Resource Provider %q was not found - did you mean %q?", v, provider))
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			errors = append(errors, fmt.Sprintf("the Resource Provider %q was not found in the list of supported Resource Providers", v))
+		}
+	}
+
+	if len(errors) > 0 {
+		return fmt.Errorf("validating the Resource Providers to register:\n\n%s", strings.Join(errors, "\n"))
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package resourceproviders
+
+import (
+	"testing"
+)
+
+func TestForRegistrationSet(t *testing.T) {
+	testData := []struct {
+		Name       string
+		Set        string
+		Additional []string
+		Contains   []string
+		Count      int
+	}{
+		{
+			Name:  "None",
+			Set:   RegistrationSetNone,
+			Count: 0,
+		},
+		{
+			Name:       "None with Additional",
+			Set:        RegistrationSetNone,
+			Additional: []string{"Microsoft.Compute", "Contoso.Widgets"},
+			Contains:   []string{"Microsoft.Compute", "Contoso.Widgets"},
+			Count:      2,
+		},
+		{
+			Name:     "Core",
+			Set:      RegistrationSetCore,
+			Contains: []string{"Microsoft.Compute", "Microsoft.Network"},
+			Count:    len(Core()),
+		},
+		{
+			Name:       "Core with Additional",
+			Set:        RegistrationSetCore,
+			Additional: []string{"Microsoft.Compute", "Microsoft.Web"},
+			Contains:   []string{"Microsoft.Compute", "Microsoft.Web"},
+			Count:      len(Core()) + 1,
+		},
+		{
+			Name:     "All",
+			Set:      RegistrationSetAll,
+			Contains: []string{"Microsoft.Compute", "Microsoft.Web"},
+			Count:    len(Required()),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		actual := ForRegistrationSet(v.Set, v.Additional)
+		if len(actual) != v.Count {
+			t.Fatalf("expected %d Resource Providers but got %d", v.Count, len(actual))
+		}
+		for _, rp := range v.Contains {
+			if _, ok := actual[rp]; !ok {
+				t.Fatalf("expected %q to be registered but it wasn't", rp)
+			}
+		}
+	}
+}
+
+func TestCoreIsSubsetOfRequired(t *testing.T) {
+	required := Required()
+	for rp := range Core() {
+		if _, ok := required[rp]; !ok {
+			t.Fatalf("expected the core Resource Provider %q to be in the list of Required Resource Providers", rp)
+		}
+	}
+}
+
+func TestValidateRegistrations(t *testing.T) {
+	testData := []struct {
+		Name   string
+		Input  []string
+		Cached *[]string
+		Valid  bool
+	}{
+		{
+			Name:   "Unavailable Cache",
+			Input:  []string{"Contoso.Widgets"},
+			Cached: nil,
+			Valid:  true,
+		},
+		{
+			Name:   "Supported",
+			Input:  []string{"Microsoft.Compute", "microsoft.insights"},
+			Cached: &[]string{"Microsoft.Compute", "microsoft.insights", "Microsoft.Network"},
+			Valid:  true,
+		},
+		{
+			Name:   "Different Casing",
+			Input:  []string{"Microsoft.compute"},
+			Cached: &[]string{"Microsoft.Compute"},
+			Valid:  false,
+		},
+		{
+			Name:   "Unsupported",
+			Input:  []string{"Contoso.Widgets"},
+			Cached: &[]string{"Microsoft.Compute"},
+			Valid:  false,
+		},
+	}
+	defer func() {
+		cachedResourceProviders = nil
+	}()
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		cachedResourceProviders = v.Cached
+		err := ValidateRegistrations(v.Input)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
@@ -9,6 +9,17 @@ import (
 // to StdOut - in Terraform's perspective that's proxied via the Plugin SDK
 type ConsoleLogger struct{}
 
+// Debug prints out a message prefixed with `[DEBUG]` verbatim
+func (ConsoleLogger) Debug(message string) {
+	log.Print(fmt.Sprintf("[DEBUG] %s", message))
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
 // Info prints out a message prefixed with `[INFO]` verbatim
 func (ConsoleLogger) Info(message string) {
 	log.Print(fmt.Sprintf("[INFO] %s", message))
@@ -30,3 +41,14 @@ func (l ConsoleLogger) Warn(message string) {
 func (l ConsoleLogger) Warnf(format string, args ...interface{}) {
 	l.Warn(fmt.Sprintf(format, args...))
 }
+
+// Error prints out a message prefixed with `[ERROR]` verbatim
+func (ConsoleLogger) Error(message string) {
+	log.Print(fmt.Sprintf("[ERROR] %s", message))
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` formatted
+// with the specified arguments
+func (l ConsoleLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
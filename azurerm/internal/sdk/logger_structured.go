@@ -0,0 +1,117 @@
+package sdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Operation is the Terraform operation being performed against a Resource or Data Source
+type Operation string
+
+const (
+	OperationCreate        Operation = "create"
+	OperationCustomizeDiff Operation = "customizediff"
+	OperationDelete        Operation = "delete"
+	OperationImport        Operation = "import"
+	OperationRead          Operation = "read"
+	OperationUpdate        Operation = "update"
+)
+
+// StructuredLogger provides a Logger implementation which prefixes each log message
+// with the Resource Type, Operation, Resource ID and Correlation Request ID - allowing
+// the lifecycle of a single Resource to be found within Terraform's debug log
+//
+// Example Output:
+//
+// [INFO] resource_type="azurerm_example" operation="create" resource_id="/subscriptions/..." correlation_request_id="...": message
+type StructuredLogger struct {
+	// correlationRequestId is the Correlation Request ID sent to Azure
+	correlationRequestId string
+
+	// logger is the underlying Logger which the prefixed messages are written to
+	logger Logger
+
+	// operation is the Terraform operation being performed
+	operation Operation
+
+	// resourceId returns the current Resource ID - this is a func since the
+	// ID is only available part-way through the Create operation
+	resourceId func() string
+
+	// resourceType is the Terraform Resource Type, e.g. `azurerm_example`
+	resourceType string
+}
+
+// NewStructuredLogger returns a StructuredLogger which writes to the specified Logger
+func NewStructuredLogger(logger Logger, resourceType string, operation Operation, resourceId func() string, correlationRequestId string) StructuredLogger {
+	return StructuredLogger{
+		correlationRequestId: correlationRequestId,
+		logger:               logger,
+		operation:            operation,
+		resourceId:           resourceId,
+		resourceType:         resourceType,
+	}
+}
+
+// Debug prints out a message prefixed with `[DEBUG]` and the resource context verbatim
+func (l StructuredLogger) Debug(message string) {
+	l.logger.Debug(l.prefix(message))
+}
+
+// Debugf prints out a message prefixed with `[DEBUG]` and the resource context
+// formatted with the specified arguments
+func (l StructuredLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+// Info prints out a message prefixed with `[INFO]` and the resource context verbatim
+func (l StructuredLogger) Info(message string) {
+	l.logger.Info(l.prefix(message))
+}
+
+// Infof prints out a message prefixed with `[INFO]` and the resource context
+// formatted with the specified arguments
+func (l StructuredLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+// Warn prints out a message prefixed with `[WARN]` and the resource context verbatim
+func (l StructuredLogger) Warn(message string) {
+	l.logger.Warn(l.prefix(message))
+}
+
+// Warnf prints out a message prefixed with `[WARN]` and the resource context
+// formatted with the specified arguments
+func (l StructuredLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+// Error prints out a message prefixed with `[ERROR]` and the resource context verbatim
+func (l StructuredLogger) Error(message string) {
+	l.logger.Error(l.prefix(message))
+}
+
+// Errorf prints out a message prefixed with `[ERROR]` and the resource context
+// formatted with the specified arguments
+func (l StructuredLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+func (l StructuredLogger) prefix(message string) string {
+	fields := []string{
+		fmt.Sprintf("resource_type=%q", l.resourceType),
+		fmt.Sprintf("operation=%q", string(l.operation)),
+	}
+
+	if l.resourceId != nil {
+		if id := l.resourceId(); id != "" {
+			fields = append(fields, fmt.Sprintf("resource_id=%q", id))
+		}
+	}
+
+	if l.correlationRequestId != "" {
+		fields = append(fields, fmt.Sprintf("correlation_request_id=%q", l.correlationRequestId))
+	}
+
+	return fmt.Sprintf("%s: %s", strings.Join(fields, " "), message)
+}
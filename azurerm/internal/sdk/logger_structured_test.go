@@ -0,0 +1,82 @@
+package sdk
+
+import (
+	"fmt"
+	"testing"
+)
+
+type testLogger struct {
+	messages *[]string
+}
+
+func (l testLogger) Debug(message string) {
+	*l.messages = append(*l.messages, fmt.Sprintf("[DEBUG] %s", message))
+}
+
+func (l testLogger) Debugf(format string, args ...interface{}) {
+	l.Debug(fmt.Sprintf(format, args...))
+}
+
+func (l testLogger) Info(message string) {
+	*l.messages = append(*l.messages, fmt.Sprintf("[INFO] %s", message))
+}
+
+func (l testLogger) Infof(format string, args ...interface{}) {
+	l.Info(fmt.Sprintf(format, args...))
+}
+
+func (l testLogger) Warn(message string) {
+	*l.messages = append(*l.messages, fmt.Sprintf("[WARN] %s", message))
+}
+
+func (l testLogger) Warnf(format string, args ...interface{}) {
+	l.Warn(fmt.Sprintf(format, args...))
+}
+
+func (l testLogger) Error(message string) {
+	*l.messages = append(*l.messages, fmt.Sprintf("[ERROR] %s", message))
+}
+
+func (l testLogger) Errorf(format string, args ...interface{}) {
+	l.Error(fmt.Sprintf(format, args...))
+}
+
+func TestStructuredLogger(t *testing.T) {
+	resourceId := ""
+	messages := make([]string, 0)
+	logger := NewStructuredLogger(testLogger{messages: &messages}, "azurerm_example", OperationCreate, func() string {
+		return resourceId
+	}, "11111111-1111-1111-1111-111111111111")
+
+	logger.Debugf("checking for existing %q..", "example")
+	resourceId = "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1"
+	logger.Info("created")
+	logger.Warnf("retrying %d", 1)
+	logger.Error("failed")
+
+	expected := []string{
+		`[DEBUG] resource_type="azurerm_example" operation="create" correlation_request_id="11111111-1111-1111-1111-111111111111": checking for existing "example"..`,
+		`[INFO] resource_type="azurerm_example" operation="create" resource_id="/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1" correlation_request_id="11111111-1111-1111-1111-111111111111": created`,
+		`[WARN] resource_type="azurerm_example" operation="create" resource_id="/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1" correlation_request_id="11111111-1111-1111-1111-111111111111": retrying 1`,
+		`[ERROR] resource_type="azurerm_example" operation="create" resource_id="/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1" correlation_request_id="11111111-1111-1111-1111-111111111111": failed`,
+	}
+	if len(messages) != len(expected) {
+		t.Fatalf("expected %d messages but got %d", len(expected), len(messages))
+	}
+	for i, v := range expected {
+		if messages[i] != v {
+			t.Fatalf("expected message %d to be %q but got %q", i, v, messages[i])
+		}
+	}
+}
+
+func TestStructuredLogger_NoCorrelationRequestID(t *testing.T) {
+	messages := make([]string, 0)
+	logger := NewStructuredLogger(testLogger{messages: &messages}, "azurerm_example", OperationRead, nil, "")
+	logger.Info("hello")
+
+	expected := `[INFO] resource_type="azurerm_example" operation="read": hello`
+	if len(messages) != 1 || messages[0] != expected {
+		t.Fatalf("expected %q but got %+v", expected, messages)
+	}
+}
@@ -63,10 +63,6 @@ type Resource interface {
 	IDValidationFunc() pluginsdk.SchemaValidateFunc
 }
 
-// TODO: ResourceWithCustomizeDiff
-// TODO: ResourceWithStateMigration
-// TODO: a generic state migration for updating ID's
-
 type ResourceWithCustomImporter interface {
 	Resource
 
@@ -88,6 +84,66 @@ type ResourceWithUpdate interface {
 	Update() ResourceFunc
 }
 
+// ResourceWithCustomizeDiff is an optional interface
+//
+// Resources implementing this interface will have the CustomizeDiff function
+// called during `terraform plan`, allowing the values within the plan to be
+// validated (or changed) prior to the Resource being Created/Updated.
+type ResourceWithCustomizeDiff interface {
+	Resource
+
+	// CustomizeDiff returns a ResourceFunc that runs the Custom Diff logic
+	// NOTE: the ResourceMetaData passed into this function contains the
+	// ResourceDiff rather than the ResourceData - as such values should
+	// be retrieved using DecodeDiff rather than Decode
+	CustomizeDiff() ResourceFunc
+}
+
+// ResourceWithGeneratedSchema is an optional interface
+//
+// Resources implementing this interface will have the Schema for the fields
+// within their Model Object generated from its struct tags (see GenerateSchema),
+// rather than defining each of these in the Arguments and Attributes.
+//
+// Fields which can't be expressed using struct tags (for example those using
+// a custom ValidateFunc) can continue to be returned from the Arguments and
+// Attributes, and are skipped when generating the Schema.
+type ResourceWithGeneratedSchema interface {
+	Resource
+
+	// GeneratesSchemaFromModelObject signifies that the Schema for this Resource
+	// should be generated from the struct tags on its Model Object
+	GeneratesSchemaFromModelObject()
+}
+
+// ResourceWithStateMigration is an optional interface
+//
+// Resources implementing this interface will have their State upgraded
+// from older Schema Versions to the current Schema Version when the State
+// is read - which allows for fields (or the Resource ID) to be changed
+// without requiring users to re-import the Resource.
+type ResourceWithStateMigration interface {
+	Resource
+
+	// StateUpgraders returns a StateUpgradeData containing the current Schema
+	// Version of this Resource and the upgrades necessary to reach it
+	StateUpgraders() StateUpgradeData
+}
+
+// StateUpgradeData contains the current Schema Version for this Resource
+// and a map of the State Upgrades necessary to reach this version
+type StateUpgradeData struct {
+	// SchemaVersion is the current version of the Schema for this Resource
+	SchemaVersion int
+
+	// Upgraders is a map of the Schema Version to the State Upgrade which should
+	// be run to upgrade the State from this version to the next version
+	//
+	// NOTE: this must contain an entry for every version from 0 through to
+	// SchemaVersion - 1, for example SchemaVersion 2 requires upgrades for 0 and 1
+	Upgraders map[int]pluginsdk.StateUpgrade
+}
+
 // ResourceWithDeprecation is an optional interface
 //
 // Resources implementing this interface will be marked as Deprecated
@@ -127,13 +183,18 @@ type ResourceMetaData struct {
 	// for example, to determine if a field has changes
 	ResourceData *schema.ResourceData
 
+	// ResourceDiff is a reference to the ResourceDiff object from Terraform's Plugin SDK
+	// This is only populated during CustomizeDiff and should be used to check/change the
+	// values within the plan
+	ResourceDiff *schema.ResourceDiff
+
 	// serializationDebugLogger is used for testing purposes
 	serializationDebugLogger Logger
 }
 
 // MarkAsGone marks this resource as removed in the Remote API, so this is no longer available
 func (rmd ResourceMetaData) MarkAsGone(idFormatter resourceid.Formatter) error {
-	rmd.Logger.Infof("[DEBUG] %s was not found - removing from state", idFormatter)
+	rmd.Logger.Debugf("%s was not found - removing from state", idFormatter)
 	rmd.ResourceData.SetId("")
 	return nil
 }
@@ -19,9 +19,31 @@ import (
 // var person Person
 // if err := metadata.Decode(&person); err != nil { .. }
 func (rmd ResourceMetaData) Decode(input interface{}) error {
+	if rmd.ResourceData == nil {
+		return fmt.Errorf("ResourceData was nil")
+	}
 	return decodeReflectedType(input, rmd.ResourceData, rmd.serializationDebugLogger)
 }
 
+// DecodeDiff will decode the Terraform Plan (during CustomizeDiff) into the
+// specified object
+// NOTE: this object must be passed by value - and must contain `tfschema`
+// struct tags for all fields
+//
+// Example Usage:
+//
+// type Person struct {
+//	 Name string `tfschema:"name"
+// }
+// var person Person
+// if err := metadata.DecodeDiff(&person); err != nil { .. }
+func (rmd ResourceMetaData) DecodeDiff(input interface{}) error {
+	if rmd.ResourceDiff == nil {
+		return fmt.Errorf("ResourceDiff was nil")
+	}
+	return decodeReflectedType(input, rmd.ResourceDiff, rmd.serializationDebugLogger)
+}
+
 // stateRetriever is a convenience wrapper around the Plugin SDK to be able to test it more accurately
 type stateRetriever interface {
 	Get(key string) interface{}
@@ -35,23 +57,24 @@ func decodeReflectedType(input interface{}, stateRetriever stateRetriever, debug
 	}
 
 	objType := reflect.TypeOf(input).Elem()
-	for i := 0; i < objType.NumField(); i++ {
-		field := objType.Field(i)
-		debugLogger.Infof("Field", field)
-
-		if val, exists := field.Tag.Lookup("tfschema"); exists {
-			tfschemaValue, valExists := stateRetriever.GetOkExists(val)
-			if !valExists {
-				continue
-			}
+	fields, err := modelFields("", objType)
+	if err != nil {
+		return err
+	}
 
-			debugLogger.Infof("TFSchemaValue: ", tfschemaValue)
-			debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(i).Type())
+	for _, field := range fields {
+		debugLogger.Infof("Field", field.field)
 
-			fieldName := reflect.ValueOf(input).Elem().Field(i).String()
-			if err := setValue(input, tfschemaValue, i, fieldName, debugLogger); err != nil {
-				return err
-			}
+		tfschemaValue, valExists := stateRetriever.GetOkExists(field.tfschemaTag)
+		if !valExists {
+			continue
+		}
+
+		debugLogger.Infof("TFSchemaValue: ", tfschemaValue)
+		debugLogger.Infof("Input Type: ", reflect.ValueOf(input).Elem().Field(field.index).Type())
+
+		if err := setValue(input, tfschemaValue, field.index, field.path, debugLogger); err != nil {
+			return err
 		}
 	}
 	return nil
@@ -71,134 +94,200 @@ func setValue(input, tfschemaValue interface{}, index int, fieldName string, deb
 		}
 	}()
 
+	fieldVal := reflect.ValueOf(input).Elem().Field(index)
+	return setFieldValue(fieldVal, tfschemaValue, fieldName, debugLogger)
+}
+
+func setFieldValue(fieldVal reflect.Value, tfschemaValue interface{}, fieldName string, debugLogger Logger) error {
+	// pointers allow differentiating between a value which is unset (nil) and the zero value
+	if fieldVal.Kind() == reflect.Ptr {
+		if tfschemaValue == nil {
+			return nil
+		}
+
+		// a pointer to a nested object is only set when the block is present
+		if fieldVal.Type().Elem().Kind() == reflect.Struct {
+			items := listFromSchemaValue(tfschemaValue)
+			if len(items) == 0 {
+				return nil
+			}
+		}
+
+		newVal := reflect.New(fieldVal.Type().Elem())
+		if err := setFieldValue(newVal.Elem(), tfschemaValue, fieldName, debugLogger); err != nil {
+			return err
+		}
+		fieldVal.Set(newVal)
+		return nil
+	}
+
+	// nested blocks (e.g. a List with MaxItems 1) can be represented as a nested object
+	if fieldVal.Kind() == reflect.Struct {
+		items := listFromSchemaValue(tfschemaValue)
+		if len(items) == 0 {
+			return nil
+		}
+		if len(items) > 1 {
+			return fmt.Errorf("expected at most 1 item for %q but got %d", fieldName, len(items))
+		}
+
+		debugLogger.Infof("[STRUCT] Decode %+v", items[0])
+		nestedConfig, ok := items[0].(map[string]interface{})
+		if !ok || nestedConfig == nil {
+			return nil
+		}
+		return setNestedObjectValue(fieldVal, nestedConfig, fieldName, debugLogger)
+	}
+
 	if v, ok := tfschemaValue.(string); ok {
 		debugLogger.Infof("[String] Decode %+v", v)
-		debugLogger.Infof("Input %+v", reflect.ValueOf(input))
-		debugLogger.Infof("Input Elem %+v", reflect.ValueOf(input).Elem())
-		reflect.ValueOf(input).Elem().Field(index).SetString(v)
+		fieldVal.SetString(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		fieldVal.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int32); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(int64(v))
+		fieldVal.SetInt(int64(v))
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(int64); ok {
 		debugLogger.Infof("[INT] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetInt(v)
+		fieldVal.SetInt(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(float64); ok {
 		debugLogger.Infof("[Float] Decode %+v", v)
-		reflect.ValueOf(input).Elem().Field(index).SetFloat(v)
+		fieldVal.SetFloat(v)
 		return nil
 	}
 
 	// Doesn't work for empty bools?
 	if v, ok := tfschemaValue.(bool); ok {
 		debugLogger.Infof("[BOOL] Decode %+v", v)
-
-		reflect.ValueOf(input).Elem().Field(index).SetBool(v)
+		fieldVal.SetBool(v)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.(*schema.Set); ok {
-		return setListValue(input, index, fieldName, v.List(), debugLogger)
+		return setListValue(fieldVal, fieldName, v.List(), debugLogger)
 	}
 
 	if mapConfig, ok := tfschemaValue.(map[string]interface{}); ok {
-		mapOutput := reflect.MakeMap(reflect.ValueOf(input).Elem().Field(index).Type())
+		mapOutput := reflect.MakeMap(fieldVal.Type())
 		for key, val := range mapConfig {
 			mapOutput.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(val))
 		}
 
-		reflect.ValueOf(input).Elem().Field(index).Set(mapOutput)
+		fieldVal.Set(mapOutput)
 		return nil
 	}
 
 	if v, ok := tfschemaValue.([]interface{}); ok {
-		return setListValue(input, index, fieldName, v, debugLogger)
+		return setListValue(fieldVal, fieldName, v, debugLogger)
 	}
 
 	return nil
 }
 
-func setListValue(input interface{}, index int, fieldName string, v []interface{}, debugLogger Logger) error {
-	switch fieldType := reflect.ValueOf(input).Elem().Field(index).Type(); fieldType {
+func setListValue(fieldVal reflect.Value, fieldName string, v []interface{}, debugLogger Logger) error {
+	switch fieldType := fieldVal.Type(); fieldType {
 	case reflect.TypeOf([]string{}):
 		stringSlice := reflect.MakeSlice(reflect.TypeOf([]string{}), len(v), len(v))
 		for i, stringVal := range v {
 			stringSlice.Index(i).SetString(stringVal.(string))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(stringSlice)
+		fieldVal.Set(stringSlice)
 
 	case reflect.TypeOf([]int{}):
 		iSlice := reflect.MakeSlice(reflect.TypeOf([]int{}), len(v), len(v))
 		for i, iVal := range v {
 			iSlice.Index(i).SetInt(int64(iVal.(int)))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(iSlice)
+		fieldVal.Set(iSlice)
 
 	case reflect.TypeOf([]float64{}):
 		fSlice := reflect.MakeSlice(reflect.TypeOf([]float64{}), len(v), len(v))
 		for i, fVal := range v {
 			fSlice.Index(i).SetFloat(fVal.(float64))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(fSlice)
+		fieldVal.Set(fSlice)
 
 	case reflect.TypeOf([]bool{}):
 		bSlice := reflect.MakeSlice(reflect.TypeOf([]bool{}), len(v), len(v))
 		for i, bVal := range v {
 			bSlice.Index(i).SetBool(bVal.(bool))
 		}
-		reflect.ValueOf(input).Elem().Field(index).Set(bSlice)
+		fieldVal.Set(bSlice)
 
 	default:
-		valueToSet := reflect.MakeSlice(reflect.ValueOf(input).Elem().Field(index).Type(), 0, 0)
+		valueToSet := reflect.MakeSlice(fieldType, 0, 0)
 		debugLogger.Infof("List Type", valueToSet.Type())
 
+		// the elements within the slice can either be objects, or pointers to objects
+		elemType := fieldType.Elem()
+		isPointer := elemType.Kind() == reflect.Ptr
+		if isPointer {
+			elemType = elemType.Elem()
+		}
+
 		for _, mapVal := range v {
 			if test, ok := mapVal.(map[string]interface{}); ok && test != nil {
-				elem := reflect.New(fieldType.Elem())
+				elem := reflect.New(elemType)
 				debugLogger.Infof("element ", elem)
-				for j := 0; j < elem.Type().Elem().NumField(); j++ {
-					nestedField := elem.Type().Elem().Field(j)
-					debugLogger.Infof("nestedField ", nestedField)
-
-					if val, exists := nestedField.Tag.Lookup("tfschema"); exists {
-						nestedTFSchemaValue := test[val]
-						if err := setValue(elem.Interface(), nestedTFSchemaValue, j, fieldName, debugLogger); err != nil {
-							return err
-						}
-					}
+				if err := setNestedObjectValue(elem.Elem(), test, fieldName, debugLogger); err != nil {
+					return err
 				}
 
-				if !elem.CanSet() {
+				if !isPointer {
 					elem = elem.Elem()
 				}
-
-				if valueToSet.Kind() == reflect.Ptr {
-					valueToSet.Elem().Set(reflect.Append(valueToSet.Elem(), elem))
-				} else {
-					valueToSet = reflect.Append(valueToSet, elem)
-				}
+				valueToSet = reflect.Append(valueToSet, elem)
 
 				debugLogger.Infof("value to set type after changes", valueToSet.Type())
 			}
 		}
 
-		valueToSet = reflect.Indirect(valueToSet)
-		fieldToSet := reflect.ValueOf(input).Elem().Field(index)
-		fieldToSet.Set(reflect.Indirect(valueToSet))
+		fieldVal.Set(valueToSet)
+	}
+
+	return nil
+}
+
+// setNestedObjectValue decodes the nested configuration into the specified object
+func setNestedObjectValue(objVal reflect.Value, nestedConfig map[string]interface{}, fieldName string, debugLogger Logger) error {
+	nestedFields, err := modelFields(fieldName, objVal.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, nestedField := range nestedFields {
+		debugLogger.Infof("nestedField ", nestedField.field)
+
+		nestedTFSchemaValue := nestedConfig[nestedField.tfschemaTag]
+		if err := setValue(objVal.Addr().Interface(), nestedTFSchemaValue, nestedField.index, nestedField.path, debugLogger); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// listFromSchemaValue returns the items within the List or Set returned from the Plugin SDK
+func listFromSchemaValue(input interface{}) []interface{} {
+	if v, ok := input.(*schema.Set); ok && v != nil {
+		return v.List()
+	}
+
+	if v, ok := input.([]interface{}); ok {
+		return v
 	}
 
 	return nil
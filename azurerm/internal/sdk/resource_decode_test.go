@@ -3,6 +3,8 @@ package sdk
 import (
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
 
 type decodeTestData struct {
@@ -698,6 +700,258 @@ func TestResourceDecode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceDecode_NestedObject(t *testing.T) {
+	type Inner struct {
+		Key   string `tfschema:"key"`
+		Value int    `tfschema:"value"`
+	}
+	type Type struct {
+		Inner Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key":   "hello",
+					"value": 42,
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: Inner{
+				Key:   "hello",
+				Value: 42,
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedObjectEmpty(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Inner Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{},
+		},
+		Input:    &Type{},
+		Expected: &Type{},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedObjectMultipleItems(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Inner Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key": "first",
+				},
+				map[string]interface{}{
+					"key": "second",
+				},
+			},
+		},
+		Input:       &Type{},
+		ExpectError: true,
+	}.test(t)
+}
+
+func TestResourceDecode_NestedObjectPointer(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Present *Inner `tfschema:"present"`
+		Empty   *Inner `tfschema:"empty"`
+		Omitted *Inner `tfschema:"omitted"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"present": []interface{}{
+				map[string]interface{}{
+					"key": "hello",
+				},
+			},
+			"empty": []interface{}{},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Present: &Inner{
+				Key: "hello",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_NestedObjectWithinList(t *testing.T) {
+	type Innermost struct {
+		Value string `tfschema:"value"`
+	}
+	type Inner struct {
+		Key       string     `tfschema:"key"`
+		Innermost *Innermost `tfschema:"innermost"`
+	}
+	type Type struct {
+		Inner []Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key": "first",
+					"innermost": []interface{}{
+						map[string]interface{}{
+							"value": "hello",
+						},
+					},
+				},
+				map[string]interface{}{
+					"key": "second",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []Inner{
+				{
+					Key: "first",
+					Innermost: &Innermost{
+						Value: "hello",
+					},
+				},
+				{
+					Key: "second",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SliceOfPointers(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key": "first",
+				},
+				map[string]interface{}{
+					"key": "second",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []*Inner{
+				{
+					Key: "first",
+				},
+				{
+					Key: "second",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_SetOfObjects(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Inner []Inner `tfschema:"inner"`
+		Block Inner   `tfschema:"block"`
+	}
+	hashFunc := func(v interface{}) int {
+		return schema.HashString(v.(map[string]interface{})["key"])
+	}
+	decodeTestData{
+		State: map[string]interface{}{
+			"inner": schema.NewSet(hashFunc, []interface{}{
+				map[string]interface{}{
+					"key": "hello",
+				},
+			}),
+			"block": schema.NewSet(hashFunc, []interface{}{
+				map[string]interface{}{
+					"key": "world",
+				},
+			}),
+		},
+		Input: &Type{},
+		Expected: &Type{
+			Inner: []Inner{
+				{
+					Key: "hello",
+				},
+			},
+			Block: Inner{
+				Key: "world",
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceDecode_Pointers(t *testing.T) {
+	type Inner struct {
+		String  *string `tfschema:"string"`
+		Omitted *string `tfschema:"omitted"`
+	}
+	type Type struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Omitted *string  `tfschema:"omitted"`
+		Inner   []Inner  `tfschema:"inner"`
+	}
+	emptyString := ""
+	zero := 0
+	zeroFloat := 0.0
+	disabled := false
+	nested := "nested"
+	decodeTestData{
+		State: map[string]interface{}{
+			"string":  "",
+			"number":  0,
+			"price":   0.0,
+			"enabled": false,
+			"inner": []interface{}{
+				map[string]interface{}{
+					"string": "nested",
+				},
+			},
+		},
+		Input: &Type{},
+		Expected: &Type{
+			String:  &emptyString,
+			Number:  &zero,
+			Price:   &zeroFloat,
+			Enabled: &disabled,
+			Inner: []Inner{
+				{
+					String: &nested,
+				},
+			},
+		},
+	}.test(t)
+}
+
 func (testData decodeTestData) test(t *testing.T) {
 	debugLogger := ConsoleLogger{}
 	state := testData.stateWrapper()
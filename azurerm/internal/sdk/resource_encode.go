@@ -45,97 +45,136 @@ func recurse(objType reflect.Type, objVal reflect.Value, fieldName string, debug
 	}()
 
 	output = make(map[string]interface{})
-	for i := 0; i < objType.NumField(); i++ {
-		field := objType.Field(i)
-		fieldVal := objVal.Field(i)
-		if tfschemaTag, exists := field.Tag.Lookup("tfschema"); exists {
-			switch field.Type.Kind() {
-			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-				iv := fieldVal.Int()
-				debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
-				output[tfschemaTag] = iv
-
-			case reflect.Float32, reflect.Float64:
-				fv := fieldVal.Float()
-				debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
-				output[tfschemaTag] = fv
-
-			case reflect.String:
-				sv := fieldVal.String()
-				debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
-				output[tfschemaTag] = sv
-
-			case reflect.Bool:
-				bv := fieldVal.Bool()
-				debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
-				output[tfschemaTag] = bv
-
-			case reflect.Map:
-				iter := fieldVal.MapRange()
-				attr := make(map[string]interface{})
-				for iter.Next() {
-					attr[iter.Key().String()] = iter.Value().Interface()
+	fields, err := modelFields("", objType)
+	if err != nil {
+		return output, err
+	}
+
+	for _, field := range fields {
+		fieldVal := objVal.Field(field.index)
+
+		// pointers allow differentiating between a value which is unset (nil) and the zero value
+		// as such nil values are set to null (or an empty block for nested objects), rather than
+		// the zero value - which clears any existing value from the state
+		if field.isPointer() {
+			if fieldVal.IsNil() {
+				debugLogger.Infof("Setting %q to null since it's nil", field.tfschemaTag)
+				if field.field.Type.Elem().Kind() == reflect.Struct {
+					output[field.tfschemaTag] = []interface{}{}
+				} else {
+					output[field.tfschemaTag] = nil
 				}
-				output[tfschemaTag] = attr
-
-			case reflect.Slice:
-				sv := fieldVal.Slice(0, fieldVal.Len())
-				attr := make([]interface{}, sv.Len())
-				switch sv.Type() {
-				case reflect.TypeOf([]string{}):
-					debugLogger.Infof("Setting %q to []string", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]string, 0)
-					}
+				continue
+			}
 
-				case reflect.TypeOf([]int{}):
-					debugLogger.Infof("Setting %q to []int", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]int, 0)
-					}
+			fieldVal = fieldVal.Elem()
+		}
 
-				case reflect.TypeOf([]float64{}):
-					debugLogger.Infof("Setting %q to []float64", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]float64, 0)
-					}
+		val, err := encodeValue(field.field.Name, field.tfschemaTag, fieldVal, debugLogger)
+		if err != nil {
+			return output, err
+		}
+		output[field.tfschemaTag] = val
+	}
 
-				case reflect.TypeOf([]bool{}):
-					debugLogger.Infof("Setting %q to []bool", tfschemaTag)
-					if sv.Len() > 0 {
-						output[tfschemaTag] = sv.Interface()
-					} else {
-						output[tfschemaTag] = make([]bool, 0)
-					}
+	return output, nil
+}
 
-				default:
-					for i := 0; i < sv.Len(); i++ {
-						debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
-						debugLogger.Infof("[SLICE] Type %+v", sv.Type())
-						nestedType := sv.Index(i).Type()
-						nestedValue := sv.Index(i)
-
-						fieldName := field.Name
-						serialized, err := recurse(nestedType, nestedValue, fieldName, debugLogger)
-						if err != nil {
-							return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), exists)
-						}
-						attr[i] = serialized
+func encodeValue(fieldName string, tfschemaTag string, fieldVal reflect.Value, debugLogger Logger) (interface{}, error) {
+	switch fieldVal.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		iv := fieldVal.Int()
+		debugLogger.Infof("Setting %q to %d", tfschemaTag, iv)
+		return iv, nil
+
+	case reflect.Float32, reflect.Float64:
+		fv := fieldVal.Float()
+		debugLogger.Infof("Setting %q to %f", tfschemaTag, fv)
+		return fv, nil
+
+	case reflect.String:
+		sv := fieldVal.String()
+		debugLogger.Infof("Setting %q to %q", tfschemaTag, sv)
+		return sv, nil
+
+	case reflect.Bool:
+		bv := fieldVal.Bool()
+		debugLogger.Infof("Setting %q to %t", tfschemaTag, bv)
+		return bv, nil
+
+	case reflect.Map:
+		iter := fieldVal.MapRange()
+		attr := make(map[string]interface{})
+		for iter.Next() {
+			attr[iter.Key().String()] = iter.Value().Interface()
+		}
+		return attr, nil
+
+	case reflect.Struct:
+		// nested objects are represented as a block (e.g. a List with MaxItems 1) in the Schema
+		debugLogger.Infof("[STRUCT] Setting %q", tfschemaTag)
+		serialized, err := recurse(fieldVal.Type(), fieldVal, fieldName, debugLogger)
+		if err != nil {
+			return nil, fmt.Errorf("serializing nested object %q: %+v", fieldVal.Type(), err)
+		}
+		return []interface{}{serialized}, nil
+
+	case reflect.Slice:
+		sv := fieldVal.Slice(0, fieldVal.Len())
+		switch sv.Type() {
+		case reflect.TypeOf([]string{}):
+			debugLogger.Infof("Setting %q to []string", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]string, 0), nil
+
+		case reflect.TypeOf([]int{}):
+			debugLogger.Infof("Setting %q to []int", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]int, 0), nil
+
+		case reflect.TypeOf([]float64{}):
+			debugLogger.Infof("Setting %q to []float64", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]float64, 0), nil
+
+		case reflect.TypeOf([]bool{}):
+			debugLogger.Infof("Setting %q to []bool", tfschemaTag)
+			if sv.Len() > 0 {
+				return sv.Interface(), nil
+			}
+			return make([]bool, 0), nil
+
+		default:
+			attr := make([]interface{}, 0)
+			for i := 0; i < sv.Len(); i++ {
+				debugLogger.Infof("[SLICE] Index %d is %q", i, sv.Index(i).Interface())
+				debugLogger.Infof("[SLICE] Type %+v", sv.Type())
+				nestedValue := sv.Index(i)
+
+				// the elements within the slice can either be objects, or pointers to objects
+				if nestedValue.Kind() == reflect.Ptr {
+					if nestedValue.IsNil() {
+						continue
 					}
-					debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
-					output[tfschemaTag] = attr
+					nestedValue = nestedValue.Elem()
 				}
-			default:
-				return output, fmt.Errorf("unknown type %+v for key %q", field.Type.Kind(), tfschemaTag)
+
+				serialized, err := recurse(nestedValue.Type(), nestedValue, fieldName, debugLogger)
+				if err != nil {
+					return nil, fmt.Errorf("serializing nested object %q: %+v", sv.Type(), err)
+				}
+				attr = append(attr, serialized)
 			}
+			debugLogger.Infof("[SLICE] Setting %q to %+v", tfschemaTag, attr)
+			return attr, nil
 		}
 	}
 
-	return output, nil
+	return nil, fmt.Errorf("unknown type %+v for key %q", fieldVal.Kind(), tfschemaTag)
 }
@@ -647,6 +647,185 @@ func TestResourceEncode_NestedThreeLevelsDeepMultipleItems(t *testing.T) {
 	}.test(t)
 }
 
+func TestResourceEncode_NestedObject(t *testing.T) {
+	type Inner struct {
+		Key   string `tfschema:"key"`
+		Value int    `tfschema:"value"`
+	}
+	type Type struct {
+		Inner Inner `tfschema:"inner"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Inner: Inner{
+				Key:   "hello",
+				Value: 42,
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key":   "hello",
+					"value": int64(42),
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedObjectPointer(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Present *Inner `tfschema:"present"`
+		Omitted *Inner `tfschema:"omitted"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Present: &Inner{
+				Key: "hello",
+			},
+		},
+		Expected: map[string]interface{}{
+			"present": []interface{}{
+				map[string]interface{}{
+					"key": "hello",
+				},
+			},
+			"omitted": []interface{}{},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NestedObjectWithinList(t *testing.T) {
+	type Innermost struct {
+		Value string `tfschema:"value"`
+	}
+	type Inner struct {
+		Key       string     `tfschema:"key"`
+		Innermost *Innermost `tfschema:"innermost"`
+	}
+	type Type struct {
+		Inner []Inner `tfschema:"inner"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Inner: []Inner{
+				{
+					Key: "first",
+					Innermost: &Innermost{
+						Value: "hello",
+					},
+				},
+				{
+					Key: "second",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key": "first",
+					"innermost": []interface{}{
+						map[string]interface{}{
+							"value": "hello",
+						},
+					},
+				},
+				map[string]interface{}{
+					"key":       "second",
+					"innermost": []interface{}{},
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_SliceOfPointers(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		Inner []*Inner `tfschema:"inner"`
+	}
+	encodeTestData{
+		Input: &Type{
+			Inner: []*Inner{
+				{
+					Key: "first",
+				},
+				nil,
+				{
+					Key: "second",
+				},
+			},
+		},
+		Expected: map[string]interface{}{
+			"inner": []interface{}{
+				map[string]interface{}{
+					"key": "first",
+				},
+				map[string]interface{}{
+					"key": "second",
+				},
+			},
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_Pointers(t *testing.T) {
+	type Type struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Omitted *string  `tfschema:"omitted"`
+	}
+	emptyString := ""
+	zero := 0
+	zeroFloat := 0.0
+	disabled := false
+	encodeTestData{
+		Input: &Type{
+			String:  &emptyString,
+			Number:  &zero,
+			Price:   &zeroFloat,
+			Enabled: &disabled,
+		},
+		Expected: map[string]interface{}{
+			"string":  "",
+			"number":  int64(0),
+			"price":   float64(0),
+			"enabled": false,
+			"omitted": nil,
+		},
+	}.test(t)
+}
+
+func TestResourceEncode_NilPointers(t *testing.T) {
+	type Nested struct {
+		Key string `tfschema:"key"`
+	}
+	type Type struct {
+		String  *string  `tfschema:"string"`
+		Number  *int     `tfschema:"number"`
+		Price   *float64 `tfschema:"price"`
+		Enabled *bool    `tfschema:"enabled"`
+		Nested  *Nested  `tfschema:"nested"`
+	}
+	encodeTestData{
+		Input: &Type{},
+		Expected: map[string]interface{}{
+			"string":  nil,
+			"number":  nil,
+			"price":   nil,
+			"enabled": nil,
+			"nested":  []interface{}{},
+		},
+	}.test(t)
+}
+
 func (testData encodeTestData) test(t *testing.T) {
 	objType := reflect.TypeOf(testData.Input).Elem()
 	objVal := reflect.ValueOf(testData.Input).Elem()
@@ -0,0 +1,94 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// modelField is a field within a Model Object, which is mapped to a field within the Schema
+// using the `tfschema` struct tag
+//
+// This is used to walk the Model Object when Encoding, Decoding, Validating and Generating
+// the Schema for it - so that each of these treat the Model Object in the same way.
+type modelField struct {
+	// index is the index of this field within the Model Object
+	index int
+
+	// path is the path to this field from the top-level Model Object, e.g. `Network.Name`
+	path string
+
+	// tfschemaTag is the name of this field within the Schema
+	tfschemaTag string
+
+	// field is the struct field within the Model Object
+	field reflect.StructField
+
+	// valueType is the type of this field, with any pointer removed - since pointers are
+	// used to differentiate between an unset value and the zero value
+	valueType reflect.Type
+}
+
+// isPointer returns whether this field is a pointer
+func (f modelField) isPointer() bool {
+	return f.field.Type.Kind() == reflect.Ptr
+}
+
+// nestedObjectType returns the type of the nested object within this field (either directly,
+// or as the items within a slice) - if any
+func (f modelField) nestedObjectType() (reflect.Type, bool) {
+	switch f.valueType.Kind() {
+	case reflect.Struct:
+		return f.valueType, true
+
+	case reflect.Slice:
+		elemType := f.valueType.Elem()
+		if elemType.Kind() == reflect.Ptr {
+			elemType = elemType.Elem()
+		}
+		if elemType.Kind() == reflect.Struct {
+			return elemType, true
+		}
+	}
+
+	return nil, false
+}
+
+// modelFields returns the fields within the specified Model Object (in the order they're defined),
+// returning an error if any field is missing a `tfschema` struct tag or a tag is used more than once
+func modelFields(prefix string, objType reflect.Type) ([]modelField, error) {
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("need a struct but got %s", objType.Kind())
+	}
+
+	out := make([]modelField, 0)
+	seen := make(map[string]struct{})
+	for i := 0; i < objType.NumField(); i++ {
+		field := objType.Field(i)
+		path := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
+
+		tfschemaTag, exists := field.Tag.Lookup("tfschema")
+		if !exists {
+			return nil, fmt.Errorf("field %q is missing an `tfschema` label", path)
+		}
+		if _, alreadyExists := seen[tfschemaTag]; alreadyExists {
+			return nil, fmt.Errorf("%q already exists in the schema", tfschemaTag)
+		}
+		seen[tfschemaTag] = struct{}{}
+
+		valueType := field.Type
+		if valueType.Kind() == reflect.Ptr {
+			valueType = valueType.Elem()
+		}
+
+		out = append(out, modelField{
+			index:       i,
+			path:        path,
+			tfschemaTag: tfschemaTag,
+			field:       field,
+			valueType:   valueType,
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,295 @@
+package sdk
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// GeneratedSchema contains the Arguments and Attributes generated from a Model Object
+type GeneratedSchema struct {
+	// Arguments contains the user-configurable fields (that is Required, Optional
+	// or Optional and Computed) for this Model Object
+	Arguments map[string]*pluginsdk.Schema
+
+	// Attributes contains the read-only (e.g. Computed-only) fields for this Model Object
+	Attributes map[string]*pluginsdk.Schema
+}
+
+// GenerateSchema generates the Schema for the specified Model Object from its struct tags,
+// meaning that the Schema and the Model Object cannot drift apart.
+//
+// In addition to the `tfschema` tag (which defines the name of the field in the Schema)
+// the following struct tags are supported:
+//
+// * `required:"true"` - marks the field as Required
+// * `optional:"true"` - marks the field as Optional
+// * `computed:"true"` - marks the field as Computed (when neither Required or Optional is specified
+//    this field is returned as an Attribute rather than an Argument)
+// * `forcenew:"true"` - marks the field as ForceNew
+// * `sensitive:"true"` - marks the field as Sensitive
+// * `set:"true"` - generates a Set rather than a List for a slice field (a nested object
+//    is generated as a List with MaxItems 1)
+// * `minitems:"1"` / `maxitems:"1"` - the minimum/maximum number of items in a List or Set
+// * `validation:"string_is_not_empty"` - the validation function(s) to use, separated by a semi-colon,
+//    see `schemaValidateFuncs` and `schemaValidateFuncsWithArgs` for the supported functions
+//
+// Example Usage:
+//
+// type Person struct {
+//	 Name string `tfschema:"name" required:"true" forcenew:"true" validation:"string_is_not_empty"`
+//	 Age  int    `tfschema:"age" computed:"true"`
+// }
+func GenerateSchema(input interface{}) (*GeneratedSchema, error) {
+	objType := reflect.TypeOf(input)
+	if objType == nil {
+		return nil, fmt.Errorf("need a struct")
+	}
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	if objType.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("need a struct but got %s", objType.Kind())
+	}
+
+	return generateSchema(objType, nil)
+}
+
+// generateSchema generates the Schema for the specified Model Object, skipping any top-level
+// fields which are already defined in the `existing` Schema
+func generateSchema(objType reflect.Type, existing map[string]*pluginsdk.Schema) (*GeneratedSchema, error) {
+	fields, err := modelFields("", objType)
+	if err != nil {
+		return nil, err
+	}
+
+	out := GeneratedSchema{
+		Arguments:  make(map[string]*pluginsdk.Schema),
+		Attributes: make(map[string]*pluginsdk.Schema),
+	}
+	for _, field := range fields {
+		if _, ok := existing[field.tfschemaTag]; ok {
+			continue
+		}
+
+		v, err := generateSchemaForField(field)
+		if err != nil {
+			return nil, err
+		}
+
+		if v.Computed && !(v.Optional || v.Required) {
+			out.Attributes[field.tfschemaTag] = v
+			continue
+		}
+
+		out.Arguments[field.tfschemaTag] = v
+	}
+
+	return &out, nil
+}
+
+// schemaForResource returns the Arguments and Attributes for the specified Resource - including those
+// generated from its Model Object when it implements ResourceWithGeneratedSchema
+func schemaForResource(resource Resource) (map[string]*pluginsdk.Schema, map[string]*pluginsdk.Schema, error) {
+	arguments := resource.Arguments()
+	attributes := resource.Attributes()
+	if _, ok := resource.(ResourceWithGeneratedSchema); !ok {
+		return arguments, attributes, nil
+	}
+
+	existing := make(map[string]*pluginsdk.Schema)
+	for k, v := range arguments {
+		existing[k] = v
+	}
+	for k, v := range attributes {
+		existing[k] = v
+	}
+
+	objType := reflect.TypeOf(resource.ModelObject())
+	if objType == nil {
+		return nil, nil, fmt.Errorf("need a struct")
+	}
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	generated, err := generateSchema(objType, existing)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for k, v := range arguments {
+		generated.Arguments[k] = v
+	}
+	for k, v := range attributes {
+		generated.Attributes[k] = v
+	}
+
+	return generated.Arguments, generated.Attributes, nil
+}
+
+func generateSchemaFields(prefix string, objType reflect.Type) (map[string]*pluginsdk.Schema, error) {
+	fields, err := modelFields(prefix, objType)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]*pluginsdk.Schema)
+	for _, field := range fields {
+		fieldSchema, err := generateSchemaForField(field)
+		if err != nil {
+			return nil, err
+		}
+
+		out[field.tfschemaTag] = fieldSchema
+	}
+
+	return out, nil
+}
+
+func generateSchemaForField(input modelField) (*pluginsdk.Schema, error) {
+	field := input.field
+	fieldName := input.path
+	out := pluginsdk.Schema{
+		Required:  tagIsTrue(field, "required"),
+		Optional:  tagIsTrue(field, "optional"),
+		Computed:  tagIsTrue(field, "computed"),
+		ForceNew:  tagIsTrue(field, "forcenew"),
+		Sensitive: tagIsTrue(field, "sensitive"),
+	}
+
+	if out.Required && (out.Optional || out.Computed) {
+		return nil, fmt.Errorf("field %q cannot be Required and Optional/Computed", fieldName)
+	}
+	if !out.Required && !out.Optional && !out.Computed {
+		return nil, fmt.Errorf("field %q must be one of Required, Optional or Computed", fieldName)
+	}
+	if out.ForceNew && !(out.Required || out.Optional) {
+		return nil, fmt.Errorf("field %q is Computed-only and so cannot be ForceNew", fieldName)
+	}
+
+	// pointers are used to differentiate between unset and zero values, so are the same type in the Schema
+	fieldType := input.valueType
+	nestedType, isNested := input.nestedObjectType()
+
+	switch fieldType.Kind() {
+	case reflect.Struct:
+		// nested objects are represented as a List containing a single block
+		out.Type = pluginsdk.TypeList
+		out.MaxItems = 1
+
+		nested, err := generateSchemaFields(fieldName, nestedType)
+		if err != nil {
+			return nil, err
+		}
+		out.Elem = &pluginsdk.Resource{
+			Schema: nested,
+		}
+
+	case reflect.Slice:
+		out.Type = pluginsdk.TypeList
+		if tagIsTrue(field, "set") {
+			out.Type = pluginsdk.TypeSet
+		}
+
+		if isNested {
+			nested, err := generateSchemaFields(fieldName, nestedType)
+			if err != nil {
+				return nil, err
+			}
+			out.Elem = &pluginsdk.Resource{
+				Schema: nested,
+			}
+		} else {
+			elemType, err := schemaTypeForPrimitive(fieldType.Elem())
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+			}
+			out.Elem = &pluginsdk.Schema{
+				Type: elemType,
+			}
+		}
+
+		var err error
+		if out.MinItems, err = tagAsInt(field, "minitems"); err != nil {
+			return nil, fmt.Errorf("parsing `minitems` for field %q: %+v", fieldName, err)
+		}
+		if out.MaxItems, err = tagAsInt(field, "maxitems"); err != nil {
+			return nil, fmt.Errorf("parsing `maxitems` for field %q: %+v", fieldName, err)
+		}
+
+	case reflect.Map:
+		if fieldType.Key().Kind() != reflect.String {
+			return nil, fmt.Errorf("field %q must be a map with a string key", fieldName)
+		}
+
+		out.Type = pluginsdk.TypeMap
+		elemType, err := schemaTypeForPrimitive(fieldType.Elem())
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+		}
+		out.Elem = &pluginsdk.Schema{
+			Type: elemType,
+		}
+
+	default:
+		schemaType, err := schemaTypeForPrimitive(fieldType)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %+v", fieldName, err)
+		}
+		out.Type = schemaType
+	}
+
+	if validationTag, exists := field.Tag.Lookup("validation"); exists && validationTag != "" {
+		if out.Type != pluginsdk.TypeString && out.Type != pluginsdk.TypeInt && out.Type != pluginsdk.TypeFloat && out.Type != pluginsdk.TypeBool {
+			// the Plugin SDK only supports validation functions on primitive types
+			return nil, fmt.Errorf("field %q: validation is only supported for primitive types", fieldName)
+		}
+
+		validateFunc, err := parseSchemaValidateFunc(validationTag)
+		if err != nil {
+			return nil, fmt.Errorf("parsing `validation` for field %q: %+v", fieldName, err)
+		}
+		out.ValidateFunc = validateFunc
+	}
+
+	return &out, nil
+}
+
+func schemaTypeForPrimitive(input reflect.Type) (pluginsdk.ValueType, error) {
+	switch input.Kind() {
+	case reflect.String:
+		return pluginsdk.TypeString, nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return pluginsdk.TypeInt, nil
+
+	case reflect.Float32, reflect.Float64:
+		return pluginsdk.TypeFloat, nil
+
+	case reflect.Bool:
+		return pluginsdk.TypeBool, nil
+	}
+
+	return pluginsdk.TypeInvalid, fmt.Errorf("unsupported type %+v", input.Kind())
+}
+
+func tagIsTrue(field reflect.StructField, tagName string) bool {
+	v, exists := field.Tag.Lookup(tagName)
+	if !exists {
+		return false
+	}
+
+	val, err := strconv.ParseBool(v)
+	return err == nil && val
+}
+
+func tagAsInt(field reflect.StructField, tagName string) (int, error) {
+	v, exists := field.Tag.Lookup(tagName)
+	if !exists {
+		return 0, nil
+	}
+
+	return strconv.Atoi(v)
+}
@@ -0,0 +1,324 @@
+package sdk
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func TestGenerateSchema_TopLevel(t *testing.T) {
+	type Model struct {
+		Name          string            `tfschema:"name" required:"true" forcenew:"true" validation:"string_is_not_empty"`
+		Number        int               `tfschema:"number" optional:"true" validation:"int_between(1,10)"`
+		Price         float64           `tfschema:"price" optional:"true" computed:"true"`
+		Enabled       bool              `tfschema:"enabled" optional:"true"`
+		Secret        string            `tfschema:"secret" optional:"true" sensitive:"true"`
+		ListOfStrings []string          `tfschema:"list_of_strings" optional:"true" maxitems:"2"`
+		SetOfStrings  []string          `tfschema:"set_of_strings" optional:"true" set:"true"`
+		Tags          map[string]string `tfschema:"tags" optional:"true"`
+		Output        string            `tfschema:"output" computed:"true"`
+	}
+
+	actual, err := GenerateSchema(Model{})
+	if err != nil {
+		t.Fatalf("generating schema: %+v", err)
+	}
+
+	if len(actual.Arguments) != 8 {
+		t.Fatalf("expected 8 arguments but got %d", len(actual.Arguments))
+	}
+	if len(actual.Attributes) != 1 {
+		t.Fatalf("expected 1 attribute but got %d", len(actual.Attributes))
+	}
+
+	name := actual.Arguments["name"]
+	if name.Type != pluginsdk.TypeString || !name.Required || !name.ForceNew || name.ValidateFunc == nil {
+		t.Fatalf("expected `name` to be a Required, ForceNew String with validation but got %+v", name)
+	}
+	if _, errs := name.ValidateFunc("", "name"); len(errs) == 0 {
+		t.Fatalf("expected validation to fail for an empty `name`")
+	}
+
+	number := actual.Arguments["number"]
+	if number.Type != pluginsdk.TypeInt || !number.Optional || number.ValidateFunc == nil {
+		t.Fatalf("expected `number` to be an Optional Int with validation but got %+v", number)
+	}
+	if _, errs := number.ValidateFunc(11, "number"); len(errs) == 0 {
+		t.Fatalf("expected validation to fail for a `number` of 11")
+	}
+
+	price := actual.Arguments["price"]
+	if price.Type != pluginsdk.TypeFloat || !price.Optional || !price.Computed {
+		t.Fatalf("expected `price` to be an Optional & Computed Float but got %+v", price)
+	}
+
+	if enabled := actual.Arguments["enabled"]; enabled.Type != pluginsdk.TypeBool {
+		t.Fatalf("expected `enabled` to be a Bool but got %+v", enabled)
+	}
+
+	if secret := actual.Arguments["secret"]; !secret.Sensitive {
+		t.Fatalf("expected `secret` to be Sensitive but got %+v", secret)
+	}
+
+	listOfStrings := actual.Arguments["list_of_strings"]
+	if listOfStrings.Type != pluginsdk.TypeList || listOfStrings.MaxItems != 2 {
+		t.Fatalf("expected `list_of_strings` to be a List with MaxItems 2 but got %+v", listOfStrings)
+	}
+	if elem, ok := listOfStrings.Elem.(*pluginsdk.Schema); !ok || elem.Type != pluginsdk.TypeString {
+		t.Fatalf("expected `list_of_strings` to contain Strings but got %+v", listOfStrings.Elem)
+	}
+
+	if setOfStrings := actual.Arguments["set_of_strings"]; setOfStrings.Type != pluginsdk.TypeSet {
+		t.Fatalf("expected `set_of_strings` to be a Set but got %+v", setOfStrings)
+	}
+
+	tags := actual.Arguments["tags"]
+	if tags.Type != pluginsdk.TypeMap {
+		t.Fatalf("expected `tags` to be a Map but got %+v", tags)
+	}
+	if elem, ok := tags.Elem.(*pluginsdk.Schema); !ok || elem.Type != pluginsdk.TypeString {
+		t.Fatalf("expected `tags` to contain Strings but got %+v", tags.Elem)
+	}
+
+	if output := actual.Attributes["output"]; output.Type != pluginsdk.TypeString || !output.Computed {
+		t.Fatalf("expected `output` to be a Computed String but got %+v", output)
+	}
+}
+
+func TestGenerateSchema_Nested(t *testing.T) {
+	type Inner struct {
+		Key   string `tfschema:"key" required:"true"`
+		Value string `tfschema:"value" computed:"true"`
+	}
+	type Model struct {
+		Block []Inner `tfschema:"block" optional:"true" maxitems:"1"`
+		Items []Inner `tfschema:"items" computed:"true"`
+	}
+
+	actual, err := GenerateSchema(&Model{})
+	if err != nil {
+		t.Fatalf("generating schema: %+v", err)
+	}
+
+	block, ok := actual.Arguments["block"]
+	if !ok {
+		t.Fatalf("expected `block` to be an Argument")
+	}
+	if block.Type != pluginsdk.TypeList || block.MaxItems != 1 {
+		t.Fatalf("expected `block` to be a List with MaxItems 1 but got %+v", block)
+	}
+	resource, ok := block.Elem.(*pluginsdk.Resource)
+	if !ok {
+		t.Fatalf("expected `block` to contain a Resource but got %+v", block.Elem)
+	}
+	if key := resource.Schema["key"]; key == nil || !key.Required {
+		t.Fatalf("expected `block.key` to be Required but got %+v", key)
+	}
+	if value := resource.Schema["value"]; value == nil || !value.Computed {
+		t.Fatalf("expected `block.value` to be Computed but got %+v", value)
+	}
+
+	if _, ok := actual.Attributes["items"]; !ok {
+		t.Fatalf("expected `items` to be an Attribute")
+	}
+}
+
+func TestGenerateSchema_NestedObjectsAndPointers(t *testing.T) {
+	type Inner struct {
+		Key *string `tfschema:"key" optional:"true"`
+	}
+	type Model struct {
+		Name    *string  `tfschema:"name" optional:"true"`
+		Block   Inner    `tfschema:"block" optional:"true"`
+		Pointer *Inner   `tfschema:"pointer" optional:"true"`
+		Items   []*Inner `tfschema:"items" optional:"true"`
+	}
+
+	actual, err := GenerateSchema(Model{})
+	if err != nil {
+		t.Fatalf("generating schema: %+v", err)
+	}
+
+	if name := actual.Arguments["name"]; name.Type != pluginsdk.TypeString {
+		t.Fatalf("expected `name` to be a String but got %+v", name)
+	}
+
+	for _, key := range []string{"block", "pointer"} {
+		block := actual.Arguments[key]
+		if block.Type != pluginsdk.TypeList || block.MaxItems != 1 {
+			t.Fatalf("expected %q to be a List with MaxItems 1 but got %+v", key, block)
+		}
+		if _, ok := block.Elem.(*pluginsdk.Resource); !ok {
+			t.Fatalf("expected %q to contain a Resource but got %+v", key, block.Elem)
+		}
+	}
+
+	items := actual.Arguments["items"]
+	if items.Type != pluginsdk.TypeList || items.MaxItems != 0 {
+		t.Fatalf("expected `items` to be a List without MaxItems but got %+v", items)
+	}
+	if _, ok := items.Elem.(*pluginsdk.Resource); !ok {
+		t.Fatalf("expected `items` to contain a Resource but got %+v", items.Elem)
+	}
+}
+
+func TestGenerateSchema_Invalid(t *testing.T) {
+	type MissingTag struct {
+		Name string `required:"true"`
+	}
+	type MissingBehaviour struct {
+		Name string `tfschema:"name"`
+	}
+	type RequiredAndOptional struct {
+		Name string `tfschema:"name" required:"true" optional:"true"`
+	}
+	type ComputedForceNew struct {
+		Name string `tfschema:"name" computed:"true" forcenew:"true"`
+	}
+	type UnknownValidation struct {
+		Name string `tfschema:"name" required:"true" validation:"does_not_exist"`
+	}
+	type InvalidValidationArgs struct {
+		Number int `tfschema:"number" required:"true" validation:"int_between(1)"`
+	}
+	type ValidationOnList struct {
+		Names []string `tfschema:"names" required:"true" validation:"string_is_not_empty"`
+	}
+	type InvalidMaxItems struct {
+		Names []string `tfschema:"names" required:"true" maxitems:"one"`
+	}
+	type Inner struct {
+		Name string `tfschema:"name"`
+	}
+	type InvalidNested struct {
+		Inner []Inner `tfschema:"inner" optional:"true"`
+	}
+	type UnsupportedType struct {
+		Channel chan string `tfschema:"channel" optional:"true"`
+	}
+
+	testData := []struct {
+		name  string
+		input interface{}
+	}{
+		{name: "not a struct", input: "hello"},
+		{name: "missing tfschema tag", input: MissingTag{}},
+		{name: "missing required/optional/computed", input: MissingBehaviour{}},
+		{name: "required and optional", input: RequiredAndOptional{}},
+		{name: "computed and forcenew", input: ComputedForceNew{}},
+		{name: "unknown validation", input: UnknownValidation{}},
+		{name: "invalid validation arguments", input: InvalidValidationArgs{}},
+		{name: "validation on a list", input: ValidationOnList{}},
+		{name: "invalid maxitems", input: InvalidMaxItems{}},
+		{name: "invalid nested object", input: InvalidNested{}},
+		{name: "unsupported type", input: UnsupportedType{}},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		if _, err := GenerateSchema(v.input); err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestParseSchemaValidateFunc(t *testing.T) {
+	testData := []struct {
+		input       string
+		value       interface{}
+		expectError bool
+		expectValid bool
+	}{
+		{input: "string_is_not_empty", value: "hello", expectValid: true},
+		{input: "string_is_not_empty", value: "", expectValid: false},
+		{input: "string_in_slice(Basic,Standard)", value: "Standard", expectValid: true},
+		{input: "string_in_slice(Basic,Standard)", value: "Premium", expectValid: false},
+		{input: "string_len_between(1, 3)", value: "abc", expectValid: true},
+		{input: "string_len_between(1, 3)", value: "abcd", expectValid: false},
+		{input: "string_is_not_empty;string_len_between(1,3)", value: "abcd", expectValid: false},
+		{input: "int_at_least(5)", value: 5, expectValid: true},
+		{input: "int_at_most(5)", value: 6, expectValid: false},
+		{input: "float_between(0.5,1.5)", value: 1.0, expectValid: true},
+		{input: "", expectError: true},
+		{input: "unknown", expectError: true},
+		{input: "unknown(1)", expectError: true},
+		{input: "int_between(1,2", expectError: true},
+		{input: "int_between(a,b)", expectError: true},
+		{input: "string_in_slice()", expectError: true},
+	}
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.input)
+
+		validateFunc, err := parseSchemaValidateFunc(v.input)
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		_, errs := validateFunc(v.value, "field")
+		if valid := len(errs) == 0; valid != v.expectValid {
+			t.Fatalf("expected the value %+v to be valid %t but got %t", v.value, v.expectValid, valid)
+		}
+	}
+}
+
+func TestGenerateSchema_DecodeRoundTrip(t *testing.T) {
+	type Inner struct {
+		Key string `tfschema:"key" required:"true"`
+	}
+	type Model struct {
+		Name   string            `tfschema:"name" required:"true"`
+		Number int               `tfschema:"number" optional:"true"`
+		Block  []Inner           `tfschema:"block" optional:"true" maxitems:"1"`
+		Tags   map[string]string `tfschema:"tags" optional:"true"`
+	}
+
+	generated, err := GenerateSchema(Model{})
+	if err != nil {
+		t.Fatalf("generating schema: %+v", err)
+	}
+
+	resourceSchema, err := combineSchema(generated.Arguments, generated.Attributes)
+	if err != nil {
+		t.Fatalf("combining schema: %+v", err)
+	}
+
+	d := schema.TestResourceDataRaw(t, *resourceSchema, map[string]interface{}{
+		"name":   "example",
+		"number": 42,
+		"block": []interface{}{
+			map[string]interface{}{
+				"key": "value",
+			},
+		},
+		"tags": map[string]interface{}{
+			"hello": "world",
+		},
+	})
+	metadata := ResourceMetaData{
+		ResourceData:             d,
+		serializationDebugLogger: NullLogger{},
+	}
+
+	var actual Model
+	if err := metadata.Decode(&actual); err != nil {
+		t.Fatalf("decoding: %+v", err)
+	}
+
+	if actual.Name != "example" || actual.Number != 42 {
+		t.Fatalf("expected the top-level fields to be decoded but got %+v", actual)
+	}
+	if len(actual.Block) != 1 || actual.Block[0].Key != "value" {
+		t.Fatalf("expected `block` to be decoded but got %+v", actual.Block)
+	}
+	if actual.Tags["hello"] != "world" {
+		t.Fatalf("expected `tags` to be decoded but got %+v", actual.Tags)
+	}
+}
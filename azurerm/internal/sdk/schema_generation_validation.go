@@ -0,0 +1,172 @@
+package sdk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+)
+
+// schemaValidateFuncs is a list of the validation functions which can be referenced
+// from the `validation` struct tag by name, e.g. `validation:"string_is_not_empty"`
+var schemaValidateFuncs = map[string]pluginsdk.SchemaValidateFunc{
+	"is_cidr":                   validation.IsCIDR,
+	"is_ip_address":             validation.IsIPAddress,
+	"is_ipv4_address":           validation.IsIPv4Address,
+	"is_ipv6_address":           validation.IsIPv6Address,
+	"is_port_number":            validation.IsPortNumber,
+	"is_rfc3339_time":           validation.IsRFC3339Time,
+	"is_url_with_http_or_https": validation.IsURLWithHTTPorHTTPS,
+	"is_url_with_https":         validation.IsURLWithHTTPS,
+	"is_uuid":                   validation.IsUUID,
+	"no_zero_values":            validation.NoZeroValues,
+	"string_is_base64":          validation.StringIsBase64,
+	"string_is_json":            validation.StringIsJSON,
+	"string_is_not_empty":       validation.StringIsNotEmpty,
+	"string_is_not_white_space": validation.StringIsNotWhiteSpace,
+}
+
+// schemaValidateFuncsWithArgs is a list of the validation functions which take arguments
+// and can be referenced from the `validation` struct tag, e.g. `validation:"int_between(1,10)"`
+var schemaValidateFuncsWithArgs = map[string]func(args []string) (pluginsdk.SchemaValidateFunc, error){
+	"float_between": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		values, err := parseFloatArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validation.FloatBetween(values[0], values[1]), nil
+	},
+	"int_at_least": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		values, err := parseIntArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validation.IntAtLeast(values[0]), nil
+	},
+	"int_at_most": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		values, err := parseIntArgs(args, 1)
+		if err != nil {
+			return nil, err
+		}
+		return validation.IntAtMost(values[0]), nil
+	},
+	"int_between": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		values, err := parseIntArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validation.IntBetween(values[0], values[1]), nil
+	},
+	"string_in_slice": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		if len(args) == 0 {
+			return nil, fmt.Errorf("expected at least one value")
+		}
+		return validation.StringInSlice(args, false), nil
+	},
+	"string_len_between": func(args []string) (pluginsdk.SchemaValidateFunc, error) {
+		values, err := parseIntArgs(args, 2)
+		if err != nil {
+			return nil, err
+		}
+		return validation.StringLenBetween(values[0], values[1]), nil
+	},
+}
+
+// parseSchemaValidateFunc parses the value of a `validation` struct tag into a SchemaValidateFunc
+// this is either the name of a validation function (e.g. `string_is_not_empty`) or the name of
+// a validation function taking arguments (e.g. `int_between(1,10)`) - multiple validation functions
+// can be specified by separating these with a semi-colon (e.g. `string_is_not_empty;string_len_between(1,10)`)
+func parseSchemaValidateFunc(input string) (pluginsdk.SchemaValidateFunc, error) {
+	validateFuncs := make([]pluginsdk.SchemaValidateFunc, 0)
+	for _, v := range strings.Split(input, ";") {
+		v = strings.TrimSpace(v)
+		if v == "" {
+			continue
+		}
+
+		validateFunc, err := parseSingleSchemaValidateFunc(v)
+		if err != nil {
+			return nil, err
+		}
+		validateFuncs = append(validateFuncs, validateFunc)
+	}
+
+	if len(validateFuncs) == 0 {
+		return nil, fmt.Errorf("no validation functions were specified")
+	}
+	if len(validateFuncs) == 1 {
+		return validateFuncs[0], nil
+	}
+
+	return validation.All(validateFuncs...), nil
+}
+
+func parseSingleSchemaValidateFunc(input string) (pluginsdk.SchemaValidateFunc, error) {
+	index := strings.Index(input, "(")
+	if index == -1 {
+		validateFunc, ok := schemaValidateFuncs[input]
+		if !ok {
+			return nil, fmt.Errorf("unknown validation function %q", input)
+		}
+		return validateFunc, nil
+	}
+
+	if !strings.HasSuffix(input, ")") {
+		return nil, fmt.Errorf("expected %q to end with `)`", input)
+	}
+
+	name := input[0:index]
+	builder, ok := schemaValidateFuncsWithArgs[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown validation function %q", name)
+	}
+
+	args := make([]string, 0)
+	for _, arg := range strings.Split(strings.TrimSuffix(input[index+1:], ")"), ",") {
+		arg = strings.TrimSpace(arg)
+		if arg == "" {
+			continue
+		}
+		args = append(args, arg)
+	}
+
+	validateFunc, err := builder(args)
+	if err != nil {
+		return nil, fmt.Errorf("parsing arguments for %q: %+v", name, err)
+	}
+	return validateFunc, nil
+}
+
+func parseIntArgs(args []string, expected int) ([]int, error) {
+	if len(args) != expected {
+		return nil, fmt.Errorf("expected %d arguments but got %d", expected, len(args))
+	}
+
+	out := make([]int, 0)
+	for _, arg := range args {
+		v, err := strconv.Atoi(arg)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as an int: %+v", arg, err)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+func parseFloatArgs(args []string, expected int) ([]float64, error) {
+	if len(args) != expected {
+		return nil, fmt.Errorf("expected %d arguments but got %d", expected, len(args))
+	}
+
+	out := make([]float64, 0)
+	for _, arg := range args {
+		v, err := strconv.ParseFloat(arg, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %q as a float: %+v", arg, err)
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
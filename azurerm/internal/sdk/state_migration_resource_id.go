@@ -0,0 +1,93 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// ResourceIDParseFunc parses the specified Resource ID into a Formatter
+// this is intended to wrap one of the generated `parse` functions, for example:
+//
+// func(input string) (resourceid.Formatter, error) {
+//	 return parse.ProfileID(input)
+// }
+type ResourceIDParseFunc func(input string) (resourceid.Formatter, error)
+
+var _ pluginsdk.StateUpgrade = ResourceIDStateUpgrade{}
+
+// ResourceIDStateUpgrade is a generic State Upgrade which parses the Resource ID
+// (and optionally, other fields containing Resource ID's) using the ID Parser
+// generated for this Resource and then re-formats it - meaning that the casing
+// and segments of the ID are normalized to the canonical format, for example
+// `resourcegroups` becomes `resourceGroups`.
+//
+// This avoids the need for bespoke State Migrations when only the format of the ID changes.
+type ResourceIDStateUpgrade struct {
+	// SchemaAtVersion is a point-in-time reference to the Schema at the time of this version
+	// as with other State Upgrades this shouldn't reference the current Schema
+	SchemaAtVersion map[string]*pluginsdk.Schema
+
+	// ParseFunc parses the Resource ID for this Resource
+	ParseFunc ResourceIDParseFunc
+
+	// AdditionalFields is an optional map of top-level field names to the ParseFunc
+	// which should be used to re-format the Resource ID contained within that field
+	//
+	// NOTE: empty values for these fields are left as-is, since these may be Optional
+	AdditionalFields map[string]ResourceIDParseFunc
+}
+
+// Schema returns the point-in-time Schema for this version of the Resource
+func (u ResourceIDStateUpgrade) Schema() map[string]*pluginsdk.Schema {
+	return u.SchemaAtVersion
+}
+
+// UpgradeFunc returns a StateUpgraderFunc which normalizes the Resource ID(s) in the State
+func (u ResourceIDStateUpgrade) UpgradeFunc() pluginsdk.StateUpgraderFunc {
+	return func(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+		if u.ParseFunc == nil {
+			return rawState, fmt.Errorf("a ParseFunc must be specified for the Resource ID")
+		}
+
+		oldId, _ := rawState["id"].(string)
+		newId, err := normalizeResourceID(oldId, u.ParseFunc)
+		if err != nil {
+			return rawState, fmt.Errorf("parsing Resource ID %q: %+v", oldId, err)
+		}
+		log.Printf("[DEBUG] Updating ID from %q to %q", oldId, newId)
+		rawState["id"] = newId
+
+		for field, parseFunc := range u.AdditionalFields {
+			oldValue, ok := rawState[field].(string)
+			if !ok || oldValue == "" {
+				continue
+			}
+
+			newValue, err := normalizeResourceID(oldValue, parseFunc)
+			if err != nil {
+				return rawState, fmt.Errorf("parsing %q for the field %q: %+v", oldValue, field, err)
+			}
+			log.Printf("[DEBUG] Updating %q from %q to %q", field, oldValue, newValue)
+			rawState[field] = newValue
+		}
+
+		return rawState, nil
+	}
+}
+
+func normalizeResourceID(input string, parseFunc ResourceIDParseFunc) (string, error) {
+	if input == "" {
+		return "", fmt.Errorf("the Resource ID was empty")
+	}
+
+	id, err := parseFunc(input)
+	if err != nil {
+		return "", err
+	}
+
+	return id.ID(), nil
+}
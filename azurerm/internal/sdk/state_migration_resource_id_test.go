@@ -0,0 +1,140 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type testProfileId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Name           string
+}
+
+func (id testProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Cdn/profiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+}
+
+func testProfileID(input string) (resourceid.Formatter, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := testProfileId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+	if resourceId.Name, err = id.PopSegment("profiles"); err != nil {
+		return nil, err
+	}
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return resourceId, nil
+}
+
+func TestResourceIDStateUpgrade(t *testing.T) {
+	testData := []struct {
+		name        string
+		input       map[string]interface{}
+		expected    map[string]interface{}
+		expectError bool
+	}{
+		{
+			name: "missing id",
+			input: map[string]interface{}{
+				"id": "",
+			},
+			expectError: true,
+		},
+		{
+			name: "invalid id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1",
+			},
+			expectError: true,
+		},
+		{
+			name: "old id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+			},
+		},
+		{
+			name: "new id",
+			input: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+			},
+			expected: map[string]interface{}{
+				"id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+			},
+		},
+		{
+			name: "old id and additional field",
+			input: map[string]interface{}{
+				"id":         "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+				"profile_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/Microsoft.Cdn/profiles/profile2",
+			},
+			expected: map[string]interface{}{
+				"id":         "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+				"profile_id": "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile2",
+			},
+		},
+		{
+			name: "old id and empty additional field",
+			input: map[string]interface{}{
+				"id":         "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+				"profile_id": "",
+			},
+			expected: map[string]interface{}{
+				"id":         "/subscriptions/12345678-1234-5678-1234-123456789012/resourceGroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+				"profile_id": "",
+			},
+		},
+		{
+			name: "old id and invalid additional field",
+			input: map[string]interface{}{
+				"id":         "/subscriptions/12345678-1234-5678-1234-123456789012/resourcegroups/group1/providers/Microsoft.Cdn/profiles/profile1",
+				"profile_id": "/subscriptions/12345678-1234-5678-1234-123456789012",
+			},
+			expectError: true,
+		},
+	}
+
+	upgrade := ResourceIDStateUpgrade{
+		ParseFunc: testProfileID,
+		AdditionalFields: map[string]ResourceIDParseFunc{
+			"profile_id": testProfileID,
+		},
+	}
+	for _, test := range testData {
+		t.Logf("Testing %q..", test.name)
+		result, err := upgrade.UpgradeFunc()(context.TODO(), test.input, nil)
+		if err != nil {
+			if test.expectError {
+				continue
+			}
+
+			t.Fatalf("expected no error but got: %+v", err)
+		}
+		if test.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		for k, v := range test.expected {
+			if result[k] != v {
+				t.Fatalf("expected %q to be %q but got %q", k, v, result[k])
+			}
+		}
+	}
+}
@@ -45,13 +45,15 @@ func combineSchema(arguments map[string]*schema.Schema, attributes map[string]*s
 	return &out, nil
 }
 
-func runArgs(d *schema.ResourceData, meta interface{}, logger Logger) (context.Context, ResourceMetaData) {
+// runArgs returns the Context and ResourceMetaData for the specified operation
+// the Logger is wrapped so that each line includes information about the Resource
+func runArgs(d *schema.ResourceData, meta interface{}, logger Logger, resourceType string, operation Operation) (context.Context, ResourceMetaData) {
 	// NOTE: this is wrapped as a result of this function, so this is "fine" being unwrapped
 	stopContext := meta.(*clients.Client).StopContext
 	client := meta.(*clients.Client)
 	metaData := ResourceMetaData{
 		Client:                   client,
-		Logger:                   logger,
+		Logger:                   NewStructuredLogger(logger, resourceType, operation, d.Id, client.CorrelationRequestId),
 		ResourceData:             d,
 		serializationDebugLogger: NullLogger{},
 	}
@@ -6,10 +6,14 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
 )
 
+// defaultCustomizeDiffTimeout is the timeout used for CustomizeDiff when the Resource doesn't define one
+const defaultCustomizeDiffTimeout = 5 * time.Minute
+
 // ResourceWrapper is a wrapper for converting a Resource implementation
 // into the object used by the Terraform Plugin SDK
 type ResourceWrapper struct {
@@ -27,7 +31,12 @@ func NewResourceWrapper(resource Resource) ResourceWrapper {
 
 // Resource returns the Terraform Plugin SDK type for this Resource implementation
 func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
-	resourceSchema, err := combineSchema(rw.resource.Arguments(), rw.resource.Attributes())
+	arguments, attributes, err := schemaForResource(rw.resource)
+	if err != nil {
+		return nil, fmt.Errorf("generating Schema for %q: %+v", rw.resource.ResourceType(), err)
+	}
+
+	resourceSchema, err := combineSchema(arguments, attributes)
 	if err != nil {
 		return nil, fmt.Errorf("building Schema: %+v", err)
 	}
@@ -45,7 +54,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		Schema: *resourceSchema,
 
 		Create: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), OperationCreate)
 			wrappedCtx, cancel := timeouts.ForCreate(ctx, d)
 			defer cancel()
 			err := rw.resource.Create().Func(wrappedCtx, metaData)
@@ -60,13 +69,13 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 
 		// looks like these could be reused, easiest if they're not
 		Read: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), OperationRead)
 			wrappedCtx, cancel := timeouts.ForRead(ctx, d)
 			defer cancel()
 			return rw.resource.Read().Func(wrappedCtx, metaData)
 		},
 		Delete: func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), OperationDelete)
 			wrappedCtx, cancel := timeouts.ForDelete(ctx, d)
 			defer cancel()
 			return rw.resource.Delete().Func(wrappedCtx, metaData)
@@ -81,8 +90,11 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			fn := rw.resource.IDValidationFunc()
 			warnings, errors := fn(id, "id")
 			if len(warnings) > 0 {
+				logger := NewStructuredLogger(rw.logger, rw.resource.ResourceType(), OperationImport, func() string {
+					return id
+				}, "")
 				for _, warning := range warnings {
-					rw.logger.Warn(warning)
+					logger.Warn(warning)
 				}
 			}
 			if len(errors) > 0 {
@@ -96,7 +108,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 			return nil
 		}, func(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}) ([]*pluginsdk.ResourceData, error) {
 			if v, ok := rw.resource.(ResourceWithCustomImporter); ok {
-				_, metaData := runArgs(d, meta, rw.logger)
+				_, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), OperationImport)
 
 				err := v.CustomImporter()(ctx, metaData)
 				if err != nil {
@@ -114,7 +126,7 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 	// implementations can opt to interface
 	if v, ok := rw.resource.(ResourceWithUpdate); ok {
 		resource.Update = func(d *schema.ResourceData, meta interface{}) error {
-			ctx, metaData := runArgs(d, meta, rw.logger)
+			ctx, metaData := runArgs(d, meta, rw.logger, rw.resource.ResourceType(), OperationUpdate)
 			wrappedCtx, cancel := timeouts.ForUpdate(ctx, d)
 			defer cancel()
 
@@ -139,8 +151,43 @@ func (rw *ResourceWrapper) Resource() (*schema.Resource, error) {
 		resource.DeprecationMessage = message
 	}
 
-	// TODO: CustomizeDiff
-	// TODO: State Migrations
+	if v, ok := rw.resource.(ResourceWithCustomizeDiff); ok {
+		customizeDiff := v.CustomizeDiff()
+		if customizeDiff.Timeout <= 0 {
+			customizeDiff.Timeout = defaultCustomizeDiffTimeout
+		}
+
+		resource.CustomizeDiff = pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			client := meta.(*clients.Client)
+			metaData := ResourceMetaData{
+				Client:                   client,
+				Logger:                   NewStructuredLogger(rw.logger, rw.resource.ResourceType(), OperationCustomizeDiff, d.Id, client.CorrelationRequestId),
+				ResourceDiff:             d,
+				serializationDebugLogger: NullLogger{},
+			}
+
+			// CustomizeDiff doesn't have a user-configurable timeout, as such
+			// this uses the default timeout defined by the Resource
+			wrappedCtx, cancel := context.WithTimeout(ctx, customizeDiff.Timeout)
+			defer cancel()
+			return customizeDiff.Func(wrappedCtx, metaData)
+		})
+	}
+
+	if v, ok := rw.resource.(ResourceWithStateMigration); ok {
+		upgrades := v.StateUpgraders()
+		if len(upgrades.Upgraders) != upgrades.SchemaVersion {
+			return nil, fmt.Errorf("Resource %q has a SchemaVersion of %d but defines %d State Upgrades", rw.resource.ResourceType(), upgrades.SchemaVersion, len(upgrades.Upgraders))
+		}
+		for version := 0; version < upgrades.SchemaVersion; version++ {
+			if _, ok := upgrades.Upgraders[version]; !ok {
+				return nil, fmt.Errorf("Resource %q is missing a State Upgrade for version %d", rw.resource.ResourceType(), version)
+			}
+		}
+
+		resource.SchemaVersion = upgrades.SchemaVersion
+		resource.StateUpgraders = pluginsdk.StateUpgrades(upgrades.Upgraders)
+	}
 
 	return &resource, nil
 }
@@ -0,0 +1,352 @@
+package sdk
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+type testResourceModel struct {
+	Name string `tfschema:"name"`
+}
+
+type testResource struct{}
+
+func (testResource) Arguments() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"name": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+	}
+}
+
+func (testResource) Attributes() map[string]*schema.Schema {
+	return map[string]*schema.Schema{}
+}
+
+func (testResource) ModelObject() interface{} {
+	return testResourceModel{}
+}
+
+func (testResource) ResourceType() string {
+	return "azurerm_example"
+}
+
+func (testResource) Create() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (testResource) Read() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (testResource) Delete() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (testResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return nil
+}
+
+type testResourceWithCustomizeDiff struct {
+	testResource
+}
+
+func (testResourceWithCustomizeDiff) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func: func(_ context.Context, _ ResourceMetaData) error {
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+// testResourceWithDecodedDiff decodes the plan during CustomizeDiff, without defining a Timeout
+type testResourceWithDecodedDiff struct {
+	testResource
+	decoded *testResourceModel
+}
+
+func (r testResourceWithDecodedDiff) CustomizeDiff() ResourceFunc {
+	return ResourceFunc{
+		Func: func(ctx context.Context, metadata ResourceMetaData) error {
+			if err := ctx.Err(); err != nil {
+				return fmt.Errorf("context was done: %+v", err)
+			}
+
+			if err := metadata.DecodeDiff(r.decoded); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if r.decoded.Name == "invalid" {
+				return fmt.Errorf("`name` cannot be %q", r.decoded.Name)
+			}
+
+			return nil
+		},
+	}
+}
+
+type testGeneratedSchemaModel struct {
+	Name        string `tfschema:"name"`
+	Description string `tfschema:"description" optional:"true" validation:"string_is_not_empty"`
+	Output      string `tfschema:"output" computed:"true"`
+}
+
+// testResourceWithGeneratedSchema defines `name` by hand and generates the remaining fields
+type testResourceWithGeneratedSchema struct {
+	testResource
+	model interface{}
+}
+
+func (r testResourceWithGeneratedSchema) ModelObject() interface{} {
+	return r.model
+}
+
+func (testResourceWithGeneratedSchema) GeneratesSchemaFromModelObject() {}
+
+type testResourceWithStateMigration struct {
+	testResource
+	upgrades StateUpgradeData
+}
+
+func (r testResourceWithStateMigration) StateUpgraders() StateUpgradeData {
+	return r.upgrades
+}
+
+func TestResourceWrapper_CustomizeDiff(t *testing.T) {
+	testData := []struct {
+		name     string
+		resource Resource
+		expected bool
+	}{
+		{
+			name:     "without CustomizeDiff",
+			resource: testResource{},
+			expected: false,
+		},
+		{
+			name:     "with CustomizeDiff",
+			resource: testResourceWithCustomizeDiff{},
+			expected: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		wrapper := NewResourceWrapper(v.resource)
+		resource, err := wrapper.Resource()
+		if err != nil {
+			t.Fatalf("building Resource: %+v", err)
+		}
+
+		actual := resource.CustomizeDiff != nil
+		if actual != v.expected {
+			t.Fatalf("expected CustomizeDiff to be defined to be %t but got %t", v.expected, actual)
+		}
+	}
+}
+
+func TestResourceWrapper_CustomizeDiffDecodesThePlan(t *testing.T) {
+	testData := []struct {
+		name        string
+		expectError bool
+	}{
+		{
+			name:        "example",
+			expectError: false,
+		},
+		{
+			name:        "invalid",
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		var decoded testResourceModel
+		wrapper := NewResourceWrapper(testResourceWithDecodedDiff{
+			decoded: &decoded,
+		})
+		resource, err := wrapper.Resource()
+		if err != nil {
+			t.Fatalf("building Resource: %+v", err)
+		}
+
+		config := terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name": v.name,
+		})
+		_, err = resource.Diff(nil, config, &clients.Client{})
+		if v.expectError {
+			if err == nil {
+				t.Fatalf("expected an error but didn't get one")
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("running CustomizeDiff: %+v", err)
+		}
+
+		if decoded.Name != v.name {
+			t.Fatalf("expected the decoded `name` to be %q but got %q", v.name, decoded.Name)
+		}
+	}
+}
+
+func TestResourceWrapper_GeneratedSchema(t *testing.T) {
+	wrapper := NewResourceWrapper(testResourceWithGeneratedSchema{
+		model: testGeneratedSchemaModel{},
+	})
+	resource, err := wrapper.Resource()
+	if err != nil {
+		t.Fatalf("building Resource: %+v", err)
+	}
+
+	if len(resource.Schema) != 3 {
+		t.Fatalf("expected 3 fields in the Schema but got %d", len(resource.Schema))
+	}
+	if name := resource.Schema["name"]; !name.Required {
+		t.Fatalf("expected `name` to be the Required field defined in the Arguments but got %+v", name)
+	}
+	if description := resource.Schema["description"]; !description.Optional || description.ValidateFunc == nil {
+		t.Fatalf("expected `description` to be generated as Optional with a ValidateFunc but got %+v", description)
+	}
+	if output := resource.Schema["output"]; !output.Computed || output.Optional {
+		t.Fatalf("expected `output` to be generated as Computed-only but got %+v", output)
+	}
+}
+
+func TestResourceWrapper_GeneratedSchemaInvalid(t *testing.T) {
+	type missingBehaviour struct {
+		Name  string `tfschema:"name"`
+		Other string `tfschema:"other"`
+	}
+
+	wrapper := NewResourceWrapper(testResourceWithGeneratedSchema{
+		model: missingBehaviour{},
+	})
+	if _, err := wrapper.Resource(); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestResourceMetaData_DecodeDiffWithoutResourceDiff(t *testing.T) {
+	metadata := ResourceMetaData{
+		serializationDebugLogger: NullLogger{},
+	}
+	var model testResourceModel
+	if err := metadata.DecodeDiff(&model); err == nil {
+		t.Fatalf("expected an error when the ResourceDiff is nil but didn't get one")
+	}
+}
+
+func TestResourceWrapper_StateMigration(t *testing.T) {
+	upgrade := ResourceIDStateUpgrade{
+		SchemaAtVersion: testResource{}.Arguments(),
+		ParseFunc:       testProfileID,
+	}
+	testData := []struct {
+		name          string
+		upgrades      StateUpgradeData
+		expectedCount int
+		expectError   bool
+	}{
+		{
+			name:          "no upgrades",
+			upgrades:      StateUpgradeData{},
+			expectedCount: 0,
+		},
+		{
+			name: "single upgrade",
+			upgrades: StateUpgradeData{
+				SchemaVersion: 1,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: upgrade,
+				},
+			},
+			expectedCount: 1,
+		},
+		{
+			name: "multiple upgrades",
+			upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: upgrade,
+					1: upgrade,
+				},
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "missing upgrade",
+			upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: upgrade,
+				},
+			},
+			expectError: true,
+		},
+		{
+			name: "non-sequential upgrades",
+			upgrades: StateUpgradeData{
+				SchemaVersion: 2,
+				Upgraders: map[int]pluginsdk.StateUpgrade{
+					0: upgrade,
+					2: upgrade,
+				},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		wrapper := NewResourceWrapper(testResourceWithStateMigration{
+			upgrades: v.upgrades,
+		})
+		resource, err := wrapper.Resource()
+		if err != nil {
+			if v.expectError {
+				continue
+			}
+
+			t.Fatalf("building Resource: %+v", err)
+		}
+		if v.expectError {
+			t.Fatalf("expected an error but didn't get one")
+		}
+
+		if resource.SchemaVersion != v.upgrades.SchemaVersion {
+			t.Fatalf("expected SchemaVersion to be %d but got %d", v.upgrades.SchemaVersion, resource.SchemaVersion)
+		}
+		if len(resource.StateUpgraders) != v.expectedCount {
+			t.Fatalf("expected %d StateUpgraders but got %d", v.expectedCount, len(resource.StateUpgraders))
+		}
+	}
+}
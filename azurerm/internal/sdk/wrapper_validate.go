@@ -3,7 +3,6 @@ package sdk
 import (
 	"fmt"
 	"reflect"
-	"strings"
 )
 
 // ValidateModelObject validates that the object contains the specified `tfschema` tags
@@ -15,41 +14,35 @@ func ValidateModelObject(input interface{}) error {
 
 	// TODO: could we also validate that each `tfschema` tag exists in the schema?
 
-	objType := reflect.TypeOf(input).Elem()
+	// the Model Object is commonly passed in as a pointer to an interface containing it
 	objVal := reflect.ValueOf(input).Elem()
-	return validateModelObjectRecursively("", objType, objVal)
+	if objVal.Kind() == reflect.Interface {
+		objVal = objVal.Elem()
+	}
+	if !objVal.IsValid() {
+		return fmt.Errorf("need a struct")
+	}
+
+	objType := objVal.Type()
+	if objType.Kind() == reflect.Ptr {
+		objType = objType.Elem()
+	}
+	return validateModelObjectRecursively("", objType)
 }
 
-func validateModelObjectRecursively(prefix string, objType reflect.Type, objVal reflect.Value) (errOut error) {
-	defer func() {
-		if r := recover(); r != nil {
-			out, ok := r.(error)
-			if !ok {
-				return
-			}
+func validateModelObjectRecursively(prefix string, objType reflect.Type) error {
+	fields, err := modelFields(prefix, objType)
+	if err != nil {
+		return err
+	}
 
-			errOut = out
-		}
-	}()
-
-	for i := 0; i < objType.NumField(); i++ {
-		field := objType.Field(i)
-		fieldVal := objVal.Field(i)
-
-		if field.Type.Kind() == reflect.Slice {
-			sv := fieldVal.Slice(0, fieldVal.Len())
-			innerType := sv.Type().Elem()
-			innerVal := reflect.Indirect(reflect.New(innerType))
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
-			if err := validateModelObjectRecursively(fieldName, innerType, innerVal); err != nil {
+	for _, field := range fields {
+		// nested objects can be either an object, a pointer to an object or a slice of either
+		if nestedType, ok := field.nestedObjectType(); ok {
+			if err := validateModelObjectRecursively(field.path, nestedType); err != nil {
 				return err
 			}
 		}
-
-		if _, exists := field.Tag.Lookup("tfschema"); !exists {
-			fieldName := strings.TrimPrefix(fmt.Sprintf("%s.%s", prefix, field.Name), ".")
-			return fmt.Errorf("field %q is missing an `tfschema` label", fieldName)
-		}
 	}
 
 	return nil
@@ -56,3 +56,50 @@ func TestValidateNestedObjectInvalid(t *testing.T) {
 		t.Fatalf("expected an error but didn't get one")
 	}
 }
+
+func TestValidateNestedObjectPointersValid(t *testing.T) {
+	type Address struct {
+		Street string `tfschema:"street"`
+	}
+	type Pet struct {
+		Name string `tfschema:"name"`
+	}
+	type Person struct {
+		Name      string   `tfschema:"name"`
+		Nickname  *string  `tfschema:"nickname"`
+		Address   Address  `tfschema:"address"`
+		Secondary *Address `tfschema:"secondary_address"`
+		Pets      []*Pet   `tfschema:"pets"`
+	}
+	if err := ValidateModelObject(&Person{}); err != nil {
+		t.Fatalf("error: %+v", err)
+	}
+}
+
+func TestValidateNestedObjectStructInvalid(t *testing.T) {
+	type Address struct {
+		Street string `tfschema:"street"`
+		Number int
+	}
+	type Person struct {
+		Name    string  `tfschema:"name"`
+		Address Address `tfschema:"address"`
+	}
+	if err := ValidateModelObject(&Person{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
+
+func TestValidateNestedObjectPointerInvalid(t *testing.T) {
+	type Address struct {
+		Street string `tfschema:"street"`
+		Number int
+	}
+	type Person struct {
+		Name    string   `tfschema:"name"`
+		Address *Address `tfschema:"address"`
+	}
+	if err := ValidateModelObject(&Person{}); err == nil {
+		t.Fatalf("expected an error but didn't get one")
+	}
+}
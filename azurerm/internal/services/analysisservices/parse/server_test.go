@@ -81,7 +81,11 @@ func TestServerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.ANALYSISSERVICES/SERVERS/SERVER1",
-			Error: true,
+			Expected: &parse.ServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "SERVER1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ApiId) ID() string {
 
 // ApiID parses a Api ID into an ApiId struct
 func ApiID(input string) (*ApiId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ApiID(input string) (*ApiId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("apis"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id ApiDiagnosticId) ID() string {
 
 // ApiDiagnosticID parses a ApiDiagnostic ID into an ApiDiagnosticId struct
 func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ApiDiagnosticID(input string) (*ApiDiagnosticId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
-	if resourceId.DiagnosticName, err = id.PopSegment("diagnostics"); err != nil {
+	if resourceId.DiagnosticName, err = id.PopSegmentInsensitively("diagnostics"); err != nil {
 		return nil, err
 	}
 
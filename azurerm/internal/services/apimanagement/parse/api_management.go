@@ -39,7 +39,7 @@ func (id ApiManagementId) ID() string {
 
 // ApiManagementID parses a ApiManagement ID into an ApiManagementId struct
 func ApiManagementID(input string) (*ApiManagementId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApiManagementID(input string) (*ApiManagementId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
 
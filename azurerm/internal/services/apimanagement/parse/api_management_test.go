@@ -80,7 +80,11 @@ func TestApiManagementID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1",
-			Error: true,
+			Expected: &ApiManagementId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id ApiOperationId) ID() string {
 
 // ApiOperationID parses a ApiOperation ID into an ApiOperationId struct
 func ApiOperationID(input string) (*ApiOperationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ApiOperationID(input string) (*ApiOperationId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
+	if resourceId.OperationName, err = id.PopSegmentInsensitively("operations"); err != nil {
 		return nil, err
 	}
 
@@ -48,7 +48,7 @@ func (id ApiOperationPolicyId) ID() string {
 
 // ApiOperationPolicyID parses a ApiOperationPolicy ID into an ApiOperationPolicyId struct
 func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +66,16 @@ func ApiOperationPolicyID(input string) (*ApiOperationPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
-	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
+	if resourceId.OperationName, err = id.PopSegmentInsensitively("operations"); err != nil {
 		return nil, err
 	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
+	if resourceId.PolicyName, err = id.PopSegmentInsensitively("policies"); err != nil {
 		return nil, err
 	}
 
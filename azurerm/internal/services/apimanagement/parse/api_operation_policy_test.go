@@ -119,7 +119,14 @@ func TestApiOperationPolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/APIS/API1/OPERATIONS/OPERATION1/POLICIES/POLICY1",
-			Error: true,
+			Expected: &ApiOperationPolicyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				ApiName:        "API1",
+				OperationName:  "OPERATION1",
+				PolicyName:     "POLICY1",
+			},
 		},
 	}
 
@@ -106,7 +106,13 @@ func TestApiOperationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/APIS/API1/OPERATIONS/OPERATION1",
-			Error: true,
+			Expected: &ApiOperationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				ApiName:        "API1",
+				OperationName:  "OPERATION1",
+			},
 		},
 	}
 
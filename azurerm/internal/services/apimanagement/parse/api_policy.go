@@ -45,7 +45,7 @@ func (id ApiPolicyId) ID() string {
 
 // ApiPolicyID parses a ApiPolicy ID into an ApiPolicyId struct
 func ApiPolicyID(input string) (*ApiPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ApiPolicyID(input string) (*ApiPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
+	if resourceId.PolicyName, err = id.PopSegmentInsensitively("policies"); err != nil {
 		return nil, err
 	}
 
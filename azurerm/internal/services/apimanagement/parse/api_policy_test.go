@@ -106,7 +106,13 @@ func TestApiPolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/APIS/API1/POLICIES/POLICY1",
-			Error: true,
+			Expected: &ApiPolicyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				ApiName:        "API1",
+				PolicyName:     "POLICY1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id ApiSchemaId) ID() string {
 
 // ApiSchemaID parses a ApiSchema ID into an ApiSchemaId struct
 func ApiSchemaID(input string) (*ApiSchemaId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ApiSchemaID(input string) (*ApiSchemaId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
-	if resourceId.SchemaName, err = id.PopSegment("schemas"); err != nil {
+	if resourceId.SchemaName, err = id.PopSegmentInsensitively("schemas"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id ApiVersionSetId) ID() string {
 
 // ApiVersionSetID parses a ApiVersionSet ID into an ApiVersionSetId struct
 func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ApiVersionSetID(input string) (*ApiVersionSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("apiVersionSets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("apiVersionSets"); err != nil {
 		return nil, err
 	}
 
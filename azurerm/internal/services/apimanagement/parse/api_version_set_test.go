@@ -93,7 +93,12 @@ func TestApiVersionSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/APIVERSIONSETS/APIVERSIONSET1",
-			Error: true,
+			Expected: &ApiVersionSetId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				Name:           "APIVERSIONSET1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id AuthorizationServerId) ID() string {
 
 // AuthorizationServerID parses a AuthorizationServer ID into an AuthorizationServerId struct
 func AuthorizationServerID(input string) (*AuthorizationServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AuthorizationServerID(input string) (*AuthorizationServerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("authorizationServers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("authorizationServers"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id BackendId) ID() string {
 
 // BackendID parses a Backend ID into an BackendId struct
 func BackendID(input string) (*BackendId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BackendID(input string) (*BackendId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("backends"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("backends"); err != nil {
 		return nil, err
 	}
 
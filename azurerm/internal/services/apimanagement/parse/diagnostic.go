@@ -42,7 +42,7 @@ func (id DiagnosticId) ID() string {
 
 // DiagnosticID parses a Diagnostic ID into an DiagnosticId struct
 func DiagnosticID(input string) (*DiagnosticId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DiagnosticID(input string) (*DiagnosticId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("diagnostics"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("diagnostics"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id EmailTemplateId) ID() string {
 
 // EmailTemplateID parses a EmailTemplate ID into an EmailTemplateId struct
 func EmailTemplateID(input string) (*EmailTemplateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EmailTemplateID(input string) (*EmailTemplateId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.TemplateName, err = id.PopSegment("templates"); err != nil {
+	if resourceId.TemplateName, err = id.PopSegmentInsensitively("templates"); err != nil {
 		return nil, err
 	}
 
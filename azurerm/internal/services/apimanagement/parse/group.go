@@ -42,7 +42,7 @@ func (id GroupId) ID() string {
 
 // GroupID parses a Group ID into an GroupId struct
 func GroupID(input string) (*GroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func GroupID(input string) (*GroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("groups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("groups"); err != nil {
 		return nil, err
 	}
 
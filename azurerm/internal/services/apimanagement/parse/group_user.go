@@ -45,7 +45,7 @@ func (id GroupUserId) ID() string {
 
 // GroupUserID parses a GroupUser ID into an GroupUserId struct
 func GroupUserID(input string) (*GroupUserId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func GroupUserID(input string) (*GroupUserId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
+	if resourceId.GroupName, err = id.PopSegmentInsensitively("groups"); err != nil {
 		return nil, err
 	}
-	if resourceId.UserName, err = id.PopSegment("users"); err != nil {
+	if resourceId.UserName, err = id.PopSegmentInsensitively("users"); err != nil {
 		return nil, err
 	}
 
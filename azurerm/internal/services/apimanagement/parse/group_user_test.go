@@ -106,7 +106,13 @@ func TestGroupUserID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/GROUPS/GROUP1/USERS/USER1",
-			Error: true,
+			Expected: &GroupUserId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				GroupName:      "GROUP1",
+				UserName:       "USER1",
+			},
 		},
 	}
 
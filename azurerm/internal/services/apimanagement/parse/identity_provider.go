@@ -42,7 +42,7 @@ func (id IdentityProviderId) ID() string {
 
 // IdentityProviderID parses a IdentityProvider ID into an IdentityProviderId struct
 func IdentityProviderID(input string) (*IdentityProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func IdentityProviderID(input string) (*IdentityProviderId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("identityProviders"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("identityProviders"); err != nil {
 		return nil, err
 	}
 
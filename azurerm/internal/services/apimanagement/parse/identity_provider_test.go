@@ -93,7 +93,12 @@ func TestIdentityProviderID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/IDENTITYPROVIDERS/IDENTITYPROVIDER1",
-			Error: true,
+			Expected: &IdentityProviderId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				Name:           "IDENTITYPROVIDER1",
+			},
 		},
 	}
 
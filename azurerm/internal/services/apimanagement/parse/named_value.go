@@ -42,7 +42,7 @@ func (id NamedValueId) ID() string {
 
 // NamedValueID parses a NamedValue ID into an NamedValueId struct
 func NamedValueID(input string) (*NamedValueId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NamedValueID(input string) (*NamedValueId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("namedValues"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("namedValues"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id OpenIDConnectProviderId) ID() string {
 
 // OpenIDConnectProviderID parses a OpenIDConnectProvider ID into an OpenIDConnectProviderId struct
 func OpenIDConnectProviderID(input string) (*OpenIDConnectProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func OpenIDConnectProviderID(input string) (*OpenIDConnectProviderId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("openidConnectProviders"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("openidConnectProviders"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestOpenIDConnectProviderID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/OPENIDCONNECTPROVIDERS/OPID1",
-			Error: true,
+			Expected: &OpenIDConnectProviderId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				Name:           "OPID1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ProductId) ID() string {
 
 // ProductID parses a Product ID into an ProductId struct
 func ProductID(input string) (*ProductId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ProductID(input string) (*ProductId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("products"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("products"); err != nil {
 		return nil, err
 	}
 
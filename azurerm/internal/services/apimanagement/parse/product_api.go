@@ -45,7 +45,7 @@ func (id ProductApiId) ID() string {
 
 // ProductApiID parses a ProductApi ID into an ProductApiId struct
 func ProductApiID(input string) (*ProductApiId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ProductApiID(input string) (*ProductApiId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+	if resourceId.ProductName, err = id.PopSegmentInsensitively("products"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+	if resourceId.ApiName, err = id.PopSegmentInsensitively("apis"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id ProductGroupId) ID() string {
 
 // ProductGroupID parses a ProductGroup ID into an ProductGroupId struct
 func ProductGroupID(input string) (*ProductGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ProductGroupID(input string) (*ProductGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+	if resourceId.ProductName, err = id.PopSegmentInsensitively("products"); err != nil {
 		return nil, err
 	}
-	if resourceId.GroupName, err = id.PopSegment("groups"); err != nil {
+	if resourceId.GroupName, err = id.PopSegmentInsensitively("groups"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id ProductPolicyId) ID() string {
 
 // ProductPolicyID parses a ProductPolicy ID into an ProductPolicyId struct
 func ProductPolicyID(input string) (*ProductPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ProductPolicyID(input string) (*ProductPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.ProductName, err = id.PopSegment("products"); err != nil {
+	if resourceId.ProductName, err = id.PopSegmentInsensitively("products"); err != nil {
 		return nil, err
 	}
-	if resourceId.PolicyName, err = id.PopSegment("policies"); err != nil {
+	if resourceId.PolicyName, err = id.PopSegmentInsensitively("policies"); err != nil {
 		return nil, err
 	}
 
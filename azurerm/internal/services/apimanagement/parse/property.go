@@ -42,7 +42,7 @@ func (id PropertyId) ID() string {
 
 // PropertyID parses a Property ID into an PropertyId struct
 func PropertyID(input string) (*PropertyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func PropertyID(input string) (*PropertyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.NamedValueName, err = id.PopSegment("namedValues"); err != nil {
+	if resourceId.NamedValueName, err = id.PopSegmentInsensitively("namedValues"); err != nil {
 		return nil, err
 	}
 
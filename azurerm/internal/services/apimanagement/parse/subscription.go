@@ -42,7 +42,7 @@ func (id SubscriptionId) ID() string {
 
 // SubscriptionID parses a Subscription ID into an SubscriptionId struct
 func SubscriptionID(input string) (*SubscriptionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SubscriptionID(input string) (*SubscriptionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("subscriptions"); err != nil {
 		return nil, err
 	}
 
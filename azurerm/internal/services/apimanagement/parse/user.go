@@ -42,7 +42,7 @@ func (id UserId) ID() string {
 
 // UserID parses a User ID into an UserId struct
 func UserID(input string) (*UserId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func UserID(input string) (*UserId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("service"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("users"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("users"); err != nil {
 		return nil, err
 	}
 
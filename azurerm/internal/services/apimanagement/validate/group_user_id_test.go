@@ -85,7 +85,7 @@ func TestGroupUserID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APIMANAGEMENT/SERVICE/SERVICE1/GROUPS/GROUP1/USERS/USER1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
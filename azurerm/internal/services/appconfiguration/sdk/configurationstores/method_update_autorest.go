@@ -7,7 +7,7 @@ import (
 
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
-	"github.com/hashicorp/go-azure-helpers/polling"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/polling"
 )
 
 type UpdateResponse struct {
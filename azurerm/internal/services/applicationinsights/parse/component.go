@@ -39,7 +39,7 @@ func (id ComponentId) ID() string {
 
 // ComponentID parses a Component ID into an ComponentId struct
 func ComponentID(input string) (*ComponentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ComponentID(input string) (*ComponentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("components"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("components"); err != nil {
 		return nil, err
 	}
 
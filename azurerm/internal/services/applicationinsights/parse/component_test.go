@@ -80,7 +80,11 @@ func TestComponentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/COMPONENT1",
-			Error: true,
+			Expected: &ComponentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "COMPONENT1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id SmartDetectionRuleId) ID() string {
 
 // SmartDetectionRuleID parses a SmartDetectionRule ID into an SmartDetectionRuleId struct
 func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SmartDetectionRuleID(input string) (*SmartDetectionRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ComponentName, err = id.PopSegment("components"); err != nil {
+	if resourceId.ComponentName, err = id.PopSegmentInsensitively("components"); err != nil {
 		return nil, err
 	}
-	if resourceId.SmartDetectionRuleName, err = id.PopSegment("SmartDetectionRule"); err != nil {
+	if resourceId.SmartDetectionRuleName, err = id.PopSegmentInsensitively("SmartDetectionRule"); err != nil {
 		return nil, err
 	}
 
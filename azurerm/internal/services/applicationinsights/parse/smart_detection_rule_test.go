@@ -93,7 +93,12 @@ func TestSmartDetectionRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/COMPONENT1/SMARTDETECTIONRULE/RULE1",
-			Error: true,
+			Expected: &SmartDetectionRuleId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "GROUP1",
+				ComponentName:          "COMPONENT1",
+				SmartDetectionRuleName: "RULE1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id WebTestId) ID() string {
 
 // WebTestID parses a WebTest ID into an WebTestId struct
 func WebTestID(input string) (*WebTestId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func WebTestID(input string) (*WebTestId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("webtests"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("webtests"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestWebTestID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.INSIGHTS/WEBTESTS/TEST1",
-			Error: true,
+			Expected: &WebTestId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "TEST1",
+			},
 		},
 	}
 
@@ -61,7 +61,7 @@ func TestComponentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/COMPONENT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -73,7 +73,7 @@ func TestSmartDetectionRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.INSIGHTS/COMPONENTS/COMPONENT1/SMARTDETECTIONRULE/RULE1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -39,7 +39,7 @@ func (id ProviderId) ID() string {
 
 // ProviderID parses a Provider ID into an ProviderId struct
 func ProviderID(input string) (*ProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ProviderID(input string) (*ProviderId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AttestationProviderName, err = id.PopSegment("attestationProviders"); err != nil {
+	if resourceId.AttestationProviderName, err = id.PopSegmentInsensitively("attestationProviders"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestProviderID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.ATTESTATION/ATTESTATIONPROVIDERS/PROVIDER1",
-			Error: true,
+			Expected: &ProviderId{
+				SubscriptionId:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "GROUP1",
+				AttestationProviderName: "PROVIDER1",
+			},
 		},
 	}
 
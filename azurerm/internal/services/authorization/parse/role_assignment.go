@@ -2,9 +2,8 @@ package parse
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type RoleAssignmentId struct {
@@ -59,32 +58,23 @@ func RoleAssignmentID(input string) (*RoleAssignmentId, error) {
 		return nil, fmt.Errorf("Role Assignment ID is empty string")
 	}
 
-	roleAssignmentId := RoleAssignmentId{}
+	id, err := resourceid.ParseScopedID(input, "Microsoft.Authorization", "roleAssignments")
+	if err != nil {
+		return nil, fmt.Errorf("could not parse Role Assignment ID %q: %+v", input, err)
+	}
 
-	switch {
-	case strings.HasPrefix(input, "/subscriptions/"):
-		id, err := azure.ParseAzureResourceID(input)
-		if err != nil {
-			return nil, fmt.Errorf("could not parse %q as Azure resource ID", input)
-		}
-		roleAssignmentId.SubscriptionID = id.SubscriptionID
-		roleAssignmentId.ResourceGroup = id.ResourceGroup
-		if roleAssignmentId.Name, err = id.PopSegment("roleAssignments"); err != nil {
-			return nil, err
-		}
-	case strings.HasPrefix(input, "/providers/Microsoft.Management/"):
-		idParts := strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-		if len(idParts) != 2 {
-			return nil, fmt.Errorf("could not parse Role Assignment ID %q for Management Group", input)
-		}
-		if idParts[1] == "" {
-			return nil, fmt.Errorf("ID was missing a value for the roleAssignments element")
-		}
-		roleAssignmentId.Name = idParts[1]
-		roleAssignmentId.ManagementGroup = strings.TrimPrefix(idParts[0], "/providers/Microsoft.Management/managementGroups/")
-	default:
-		return nil, fmt.Errorf("could not parse Role Assignment ID %q", input)
+	scope, err := id.ParsedScope()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse the Scope for Role Assignment ID %q: %+v", input, err)
+	}
+	if scope.SubscriptionId == "" && scope.ManagementGroupName == "" {
+		return nil, fmt.Errorf("could not parse Role Assignment ID %q: expected the Scope to be within a Subscription or a Management Group", input)
 	}
 
-	return &roleAssignmentId, nil
+	return &RoleAssignmentId{
+		SubscriptionID:  scope.SubscriptionId,
+		ResourceGroup:   scope.ResourceGroup,
+		ManagementGroup: scope.ManagementGroupName,
+		Name:            id.Name,
+	}, nil
 }
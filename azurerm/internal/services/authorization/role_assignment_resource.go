@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 	billingValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/billing/validate"
 	managementGroupValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/validate"
 	resourceValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
@@ -262,12 +263,13 @@ func resourceArmRoleAssignmentDelete(d *pluginsdk.ResourceData, meta interface{}
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := parseRoleAssignmentId(d.Id())
+	// Role Assignments can exist within any Scope (including those outside of a Subscription, such as an Enrollment Account)
+	id, err := resourceid.ParseScopedID(d.Id(), "Microsoft.Authorization", "roleAssignments")
 	if err != nil {
 		return err
 	}
 
-	resp, err := client.Delete(ctx, id.scope, id.name, "")
+	resp, err := client.Delete(ctx, strings.TrimPrefix(id.Scope, "/"), id.Name, "")
 	if err != nil {
 		if !utils.ResponseWasNotFound(resp.Response) {
 			return err
@@ -320,25 +322,6 @@ func retryRoleAssignmentsClient(d *pluginsdk.ResourceData, scope string, name st
 	}
 }
 
-type roleAssignmentId struct {
-	scope string
-	name  string
-}
-
-func parseRoleAssignmentId(input string) (*roleAssignmentId, error) {
-	segments := strings.Split(input, "/providers/Microsoft.Authorization/roleAssignments/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected Role Assignment ID to be in the format `{scope}/providers/Microsoft.Authorization/roleAssignments/{name}` but got %q", input)
-	}
-
-	// /{scope}/providers/Microsoft.Authorization/roleAssignments/{roleAssignmentName}
-	id := roleAssignmentId{
-		scope: strings.TrimPrefix(segments[0], "/"),
-		name:  segments[1],
-	}
-	return &id, nil
-}
-
 func roleAssignmentCreateStateRefreshFunc(ctx context.Context, client *authorization.RoleAssignmentsClient, roleID string) pluginsdk.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		resp, err := client.GetByID(ctx, roleID, "")
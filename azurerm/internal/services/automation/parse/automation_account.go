@@ -39,7 +39,7 @@ func (id AutomationAccountId) ID() string {
 
 // AutomationAccountID parses a AutomationAccount ID into an AutomationAccountId struct
 func AutomationAccountID(input string) (*AutomationAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AutomationAccountID(input string) (*AutomationAccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("automationAccounts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("automationAccounts"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestAutomationAccountID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/ACCOUNT1",
-			Error: true,
+			Expected: &AutomationAccountId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "ACCOUNT1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ConnectionId) ID() string {
 
 // ConnectionID parses a Connection ID into an ConnectionId struct
 func ConnectionID(input string) (*ConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ConnectionID(input string) (*ConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AutomationAccountName, err = id.PopSegment("automationAccounts"); err != nil {
+	if resourceId.AutomationAccountName, err = id.PopSegmentInsensitively("automationAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("connections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("connections"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/ACCOUNT1/CONNECTIONS/CONNECTION1",
-			Error: true,
+			Expected: &ConnectionId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "GROUP1",
+				AutomationAccountName: "ACCOUNT1",
+				Name:                  "CONNECTION1",
+			},
 		},
 	}
 
@@ -73,7 +73,7 @@ func TestConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.AUTOMATION/AUTOMATIONACCOUNTS/ACCOUNT1/CONNECTIONS/CONNECTION1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -39,7 +39,7 @@ func (id AccountId) ID() string {
 
 // AccountID parses a Account ID into an AccountId struct
 func AccountID(input string) (*AccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AccountID(input string) (*AccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+	if resourceId.BatchAccountName, err = id.PopSegmentInsensitively("batchAccounts"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id ApplicationId) ID() string {
 
 // ApplicationID parses a Application ID into an ApplicationId struct
 func ApplicationID(input string) (*ApplicationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ApplicationID(input string) (*ApplicationId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+	if resourceId.BatchAccountName, err = id.PopSegmentInsensitively("batchAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("applications"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("applications"); err != nil {
 		return nil, err
 	}
 
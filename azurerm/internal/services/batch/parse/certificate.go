@@ -42,7 +42,7 @@ func (id CertificateId) ID() string {
 
 // CertificateID parses a Certificate ID into an CertificateId struct
 func CertificateID(input string) (*CertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CertificateID(input string) (*CertificateId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+	if resourceId.BatchAccountName, err = id.PopSegmentInsensitively("batchAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("certificates"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("certificates"); err != nil {
 		return nil, err
 	}
 
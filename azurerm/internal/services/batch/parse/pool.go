@@ -42,7 +42,7 @@ func (id PoolId) ID() string {
 
 // PoolID parses a Pool ID into an PoolId struct
 func PoolID(input string) (*PoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func PoolID(input string) (*PoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BatchAccountName, err = id.PopSegment("batchAccounts"); err != nil {
+	if resourceId.BatchAccountName, err = id.PopSegmentInsensitively("batchAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("pools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("pools"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestPoolID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.BATCH/BATCHACCOUNTS/ACCOUNT1/POOLS/POOL1",
-			Error: true,
+			Expected: &PoolId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				BatchAccountName: "ACCOUNT1",
+				Name:             "POOL1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id BotChannelId) ID() string {
 
 // BotChannelID parses a BotChannel ID into an BotChannelId struct
 func BotChannelID(input string) (*BotChannelId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BotChannelID(input string) (*BotChannelId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
+	if resourceId.BotServiceName, err = id.PopSegmentInsensitively("botServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.ChannelName, err = id.PopSegment("channels"); err != nil {
+	if resourceId.ChannelName, err = id.PopSegmentInsensitively("channels"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestBotChannelID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.BOTSERVICE/BOTSERVICES/BOTSERVICE1/CHANNELS/DISCOVERY1",
-			Error: true,
+			Expected: &BotChannelId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				BotServiceName: "BOTSERVICE1",
+				ChannelName:    "DISCOVERY1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id BotConnectionId) ID() string {
 
 // BotConnectionID parses a BotConnection ID into an BotConnectionId struct
 func BotConnectionID(input string) (*BotConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BotConnectionID(input string) (*BotConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BotServiceName, err = id.PopSegment("botServices"); err != nil {
+	if resourceId.BotServiceName, err = id.PopSegmentInsensitively("botServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.ConnectionName, err = id.PopSegment("connections"); err != nil {
+	if resourceId.ConnectionName, err = id.PopSegmentInsensitively("connections"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestBotConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.BOTSERVICE/BOTSERVICES/BOTSERVICE1/CONNECTIONS/CONNECTION1",
-			Error: true,
+			Expected: &BotConnectionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				BotServiceName: "BOTSERVICE1",
+				ConnectionName: "CONNECTION1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id BotHealthbotId) ID() string {
 
 // BotHealthbotID parses a BotHealthbot ID into an BotHealthbotId struct
 func BotHealthbotID(input string) (*BotHealthbotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func BotHealthbotID(input string) (*BotHealthbotId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.HealthBotName, err = id.PopSegment("healthBots"); err != nil {
+	if resourceId.HealthBotName, err = id.PopSegmentInsensitively("healthBots"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestBotHealthbotID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.HEALTHBOT/HEALTHBOTS/BOT1",
-			Error: true,
+			Expected: &BotHealthbotId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESOURCEGROUP1",
+				HealthBotName:  "BOT1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id BotServiceId) ID() string {
 
 // BotServiceID parses a BotService ID into an BotServiceId struct
 func BotServiceID(input string) (*BotServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func BotServiceID(input string) (*BotServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("botServices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("botServices"); err != nil {
 		return nil, err
 	}
 
@@ -61,7 +61,7 @@ func TestBotHealthbotID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.HEALTHBOT/HEALTHBOTS/BOT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -42,7 +42,7 @@ func (id EndpointId) ID() string {
 
 // EndpointID parses a Endpoint ID into an EndpointId struct
 func EndpointID(input string) (*EndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EndpointID(input string) (*EndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ProfileName, err = id.PopSegment("profiles"); err != nil {
+	if resourceId.ProfileName, err = id.PopSegmentInsensitively("profiles"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("endpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("endpoints"); err != nil {
 		return nil, err
 	}
 
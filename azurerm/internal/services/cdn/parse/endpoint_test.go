@@ -93,7 +93,12 @@ func TestEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CDN/PROFILES/PROFILE1/ENDPOINTS/ENDPOINT1",
-			Error: true,
+			Expected: &EndpointId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ProfileName:    "PROFILE1",
+				Name:           "ENDPOINT1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ProfileId) ID() string {
 
 // ProfileID parses a Profile ID into an ProfileId struct
 func ProfileID(input string) (*ProfileId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ProfileID(input string) (*ProfileId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("profiles"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("profiles"); err != nil {
 		return nil, err
 	}
 
@@ -216,8 +216,11 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	props := cognitiveservices.Account{
 		Kind:     utils.String(kind),
@@ -294,8 +297,11 @@ func resourceCognitiveAccountUpdate(d *pluginsdk.ResourceData, meta interface{})
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	props := cognitiveservices.Account{
 		Sku: sku,
@@ -39,7 +39,7 @@ func (id CommunicationServiceId) ID() string {
 
 // CommunicationServiceID parses a CommunicationService ID into an CommunicationServiceId struct
 func CommunicationServiceID(input string) (*CommunicationServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func CommunicationServiceID(input string) (*CommunicationServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("CommunicationServices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("CommunicationServices"); err != nil {
 		return nil, err
 	}
 
@@ -12,6 +12,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/compute/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/skus"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/base64"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
@@ -33,6 +34,14 @@ func resourceLinuxVirtualMachineScaleSet() *pluginsdk.Resource {
 			return err
 		}, importVirtualMachineScaleSet(compute.Linux, "azurerm_linux_virtual_machine_scale_set")),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(skus.ValidateAvailability(skus.AvailabilityFields{
+			ResourceType:    "Microsoft.Compute/virtualMachineScaleSets",
+			SkuResourceType: "virtualMachines",
+			LocationField:   "location",
+			SkuField:        "sku",
+			ZonesField:      "zones",
+		})),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(time.Minute * 30),
 			Update: pluginsdk.DefaultTimeout(time.Minute * 60),
@@ -39,7 +39,7 @@ func (id AvailabilitySetId) ID() string {
 
 // AvailabilitySetID parses a AvailabilitySet ID into an AvailabilitySetId struct
 func AvailabilitySetID(input string) (*AvailabilitySetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AvailabilitySetID(input string) (*AvailabilitySetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("availabilitySets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("availabilitySets"); err != nil {
 		return nil, err
 	}
 
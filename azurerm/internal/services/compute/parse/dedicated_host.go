@@ -42,7 +42,7 @@ func (id DedicatedHostId) ID() string {
 
 // DedicatedHostID parses a DedicatedHost ID into an DedicatedHostId struct
 func DedicatedHostID(input string) (*DedicatedHostId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DedicatedHostID(input string) (*DedicatedHostId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
+	if resourceId.HostGroupName, err = id.PopSegmentInsensitively("hostGroups"); err != nil {
 		return nil, err
 	}
-	if resourceId.HostName, err = id.PopSegment("hosts"); err != nil {
+	if resourceId.HostName, err = id.PopSegmentInsensitively("hosts"); err != nil {
 		return nil, err
 	}
 
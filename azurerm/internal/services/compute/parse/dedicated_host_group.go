@@ -39,7 +39,7 @@ func (id DedicatedHostGroupId) ID() string {
 
 // DedicatedHostGroupID parses a DedicatedHostGroup ID into an DedicatedHostGroupId struct
 func DedicatedHostGroupID(input string) (*DedicatedHostGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DedicatedHostGroupID(input string) (*DedicatedHostGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.HostGroupName, err = id.PopSegment("hostGroups"); err != nil {
+	if resourceId.HostGroupName, err = id.PopSegmentInsensitively("hostGroups"); err != nil {
 		return nil, err
 	}
 
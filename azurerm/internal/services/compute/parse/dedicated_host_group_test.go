@@ -80,7 +80,11 @@ func TestDedicatedHostGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTGROUP1",
-			Error: true,
+			Expected: &DedicatedHostGroupId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				HostGroupName:  "HOSTGROUP1",
+			},
 		},
 	}
 
@@ -93,7 +93,12 @@ func TestDedicatedHostID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/HOSTGROUPS/HOSTGROUP1/HOSTS/HOST1",
-			Error: true,
+			Expected: &DedicatedHostId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				HostGroupName:  "HOSTGROUP1",
+				HostName:       "HOST1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id DiskAccessId) ID() string {
 
 // DiskAccessID parses a DiskAccess ID into an DiskAccessId struct
 func DiskAccessID(input string) (*DiskAccessId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DiskAccessID(input string) (*DiskAccessId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("diskAccesses"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("diskAccesses"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestDiskAccessID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/DISKACCESSES/DISKACCESS1",
-			Error: true,
+			Expected: &DiskAccessId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "DISKACCESS1",
+			},
 		},
 	}
 
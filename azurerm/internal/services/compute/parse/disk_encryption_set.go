@@ -39,7 +39,7 @@ func (id DiskEncryptionSetId) ID() string {
 
 // DiskEncryptionSetID parses a DiskEncryptionSet ID into an DiskEncryptionSetId struct
 func DiskEncryptionSetID(input string) (*DiskEncryptionSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DiskEncryptionSetID(input string) (*DiskEncryptionSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("diskEncryptionSets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("diskEncryptionSets"); err != nil {
 		return nil, err
 	}
 
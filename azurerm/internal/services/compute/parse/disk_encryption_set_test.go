@@ -80,7 +80,11 @@ func TestDiskEncryptionSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/DISKENCRYPTIONSETS/SET1",
-			Error: true,
+			Expected: &DiskEncryptionSetId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "SET1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id HybridMachineId) ID() string {
 
 // HybridMachineID parses a HybridMachine ID into an HybridMachineId struct
 func HybridMachineID(input string) (*HybridMachineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func HybridMachineID(input string) (*HybridMachineId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
+	if resourceId.MachineName, err = id.PopSegmentInsensitively("machines"); err != nil {
 		return nil, err
 	}
 
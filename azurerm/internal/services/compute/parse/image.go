@@ -39,7 +39,7 @@ func (id ImageId) ID() string {
 
 // ImageID parses a Image ID into an ImageId struct
 func ImageID(input string) (*ImageId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ImageID(input string) (*ImageId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("images"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("images"); err != nil {
 		return nil, err
 	}
 
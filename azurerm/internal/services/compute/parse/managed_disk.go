@@ -39,7 +39,7 @@ func (id ManagedDiskId) ID() string {
 
 // ManagedDiskID parses a ManagedDisk ID into an ManagedDiskId struct
 func ManagedDiskID(input string) (*ManagedDiskId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ManagedDiskID(input string) (*ManagedDiskId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DiskName, err = id.PopSegment("disks"); err != nil {
+	if resourceId.DiskName, err = id.PopSegmentInsensitively("disks"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestManagedDiskID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/DISKS/DISK1",
-			Error: true,
+			Expected: &ManagedDiskId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				DiskName:       "DISK1",
+			},
 		},
 	}
 
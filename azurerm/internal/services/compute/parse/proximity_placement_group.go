@@ -39,7 +39,7 @@ func (id ProximityPlacementGroupId) ID() string {
 
 // ProximityPlacementGroupID parses a ProximityPlacementGroup ID into an ProximityPlacementGroupId struct
 func ProximityPlacementGroupID(input string) (*ProximityPlacementGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ProximityPlacementGroupID(input string) (*ProximityPlacementGroupId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("proximityPlacementGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("proximityPlacementGroups"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id SharedImageId) ID() string {
 
 // SharedImageID parses a SharedImage ID into an SharedImageId struct
 func SharedImageID(input string) (*SharedImageId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SharedImageID(input string) (*SharedImageId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
 		return nil, err
 	}
-	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+	if resourceId.ImageName, err = id.PopSegmentInsensitively("images"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id SharedImageGalleryId) ID() string {
 
 // SharedImageGalleryID parses a SharedImageGallery ID into an SharedImageGalleryId struct
 func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SharedImageGalleryID(input string) (*SharedImageGalleryId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestSharedImageGalleryID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/GALLERIES/GALLERY1",
-			Error: true,
+			Expected: &SharedImageGalleryId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				GalleryName:    "GALLERY1",
+			},
 		},
 	}
 
@@ -93,7 +93,12 @@ func TestSharedImageID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/GALLERIES/GALLERY1/IMAGES/IMAGE1",
-			Error: true,
+			Expected: &SharedImageId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				GalleryName:    "GALLERY1",
+				ImageName:      "IMAGE1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id SharedImageVersionId) ID() string {
 
 // SharedImageVersionID parses a SharedImageVersion ID into an SharedImageVersionId struct
 func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SharedImageVersionID(input string) (*SharedImageVersionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.GalleryName, err = id.PopSegment("galleries"); err != nil {
+	if resourceId.GalleryName, err = id.PopSegmentInsensitively("galleries"); err != nil {
 		return nil, err
 	}
-	if resourceId.ImageName, err = id.PopSegment("images"); err != nil {
+	if resourceId.ImageName, err = id.PopSegmentInsensitively("images"); err != nil {
 		return nil, err
 	}
-	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
+	if resourceId.VersionName, err = id.PopSegmentInsensitively("versions"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id SSHPublicKeyId) ID() string {
 
 // SSHPublicKeyID parses a SSHPublicKey ID into an SSHPublicKeyId struct
 func SSHPublicKeyID(input string) (*SSHPublicKeyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SSHPublicKeyID(input string) (*SSHPublicKeyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("sshPublicKeys"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("sshPublicKeys"); err != nil {
 		return nil, err
 	}
 
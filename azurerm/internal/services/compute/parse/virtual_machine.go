@@ -39,7 +39,7 @@ func (id VirtualMachineId) ID() string {
 
 // VirtualMachineID parses a VirtualMachine ID into an VirtualMachineId struct
 func VirtualMachineID(input string) (*VirtualMachineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualMachineID(input string) (*VirtualMachineId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualMachines"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualMachines"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id VirtualMachineExtensionId) ID() string {
 
 // VirtualMachineExtensionID parses a VirtualMachineExtension ID into an VirtualMachineExtensionId struct
 func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualMachineExtensionID(input string) (*VirtualMachineExtensionId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+	if resourceId.VirtualMachineName, err = id.PopSegmentInsensitively("virtualMachines"); err != nil {
 		return nil, err
 	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
+	if resourceId.ExtensionName, err = id.PopSegmentInsensitively("extensions"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestVirtualMachineExtensionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/VIRTUALMACHINES/MACHINE1/EXTENSIONS/EXTENSION1",
-			Error: true,
+			Expected: &VirtualMachineExtensionId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				VirtualMachineName: "MACHINE1",
+				ExtensionName:      "EXTENSION1",
+			},
 		},
 	}
 
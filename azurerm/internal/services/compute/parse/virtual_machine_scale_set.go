@@ -39,7 +39,7 @@ func (id VirtualMachineScaleSetId) ID() string {
 
 // VirtualMachineScaleSetID parses a VirtualMachineScaleSet ID into an VirtualMachineScaleSetId struct
 func VirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualMachineScaleSetID(input string) (*VirtualMachineScaleSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualMachineScaleSets"); err != nil {
 		return nil, err
 	}
 
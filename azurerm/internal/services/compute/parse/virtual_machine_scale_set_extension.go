@@ -42,7 +42,7 @@ func (id VirtualMachineScaleSetExtensionId) ID() string {
 
 // VirtualMachineScaleSetExtensionID parses a VirtualMachineScaleSetExtension ID into an VirtualMachineScaleSetExtensionId struct
 func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExtensionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualMachineScaleSetExtensionID(input string) (*VirtualMachineScaleSetExt
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualMachineScaleSetName, err = id.PopSegment("virtualMachineScaleSets"); err != nil {
+	if resourceId.VirtualMachineScaleSetName, err = id.PopSegmentInsensitively("virtualMachineScaleSets"); err != nil {
 		return nil, err
 	}
-	if resourceId.ExtensionName, err = id.PopSegment("extensions"); err != nil {
+	if resourceId.ExtensionName, err = id.PopSegmentInsensitively("extensions"); err != nil {
 		return nil, err
 	}
 
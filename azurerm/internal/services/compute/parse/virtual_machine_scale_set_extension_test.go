@@ -93,7 +93,12 @@ func TestVirtualMachineScaleSetExtensionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/VIRTUALMACHINESCALESETS/SCALESET1/EXTENSIONS/EXTENSION1",
-			Error: true,
+			Expected: &VirtualMachineScaleSetExtensionId{
+				SubscriptionId:             "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:              "RESGROUP1",
+				VirtualMachineScaleSetName: "SCALESET1",
+				ExtensionName:              "EXTENSION1",
+			},
 		},
 	}
 
@@ -19,6 +19,7 @@ import (
 	msiparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	intStor "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/client"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/skus"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -59,6 +60,14 @@ func resourceVirtualMachine() *pluginsdk.Resource {
 		// TODO: replace this with an importer which validates the ID during import
 		Importer: pluginsdk.DefaultImporter(),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(skus.ValidateAvailability(skus.AvailabilityFields{
+			ResourceType:    "Microsoft.Compute/virtualMachines",
+			SkuResourceType: "virtualMachines",
+			LocationField:   "location",
+			SkuField:        "vm_size",
+			ZonesField:      "zones",
+		})),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
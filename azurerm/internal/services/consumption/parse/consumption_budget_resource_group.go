@@ -39,7 +39,7 @@ func (id ConsumptionBudgetResourceGroupId) ID() string {
 
 // ConsumptionBudgetResourceGroupID parses a ConsumptionBudgetResourceGroup ID into an ConsumptionBudgetResourceGroupId struct
 func ConsumptionBudgetResourceGroupID(input string) (*ConsumptionBudgetResourceGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ConsumptionBudgetResourceGroupID(input string) (*ConsumptionBudgetResourceG
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.BudgetName, err = id.PopSegment("budgets"); err != nil {
+	if resourceId.BudgetName, err = id.PopSegmentInsensitively("budgets"); err != nil {
 		return nil, err
 	}
 
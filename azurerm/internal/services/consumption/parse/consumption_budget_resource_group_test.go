@@ -80,7 +80,11 @@ func TestConsumptionBudgetResourceGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONSUMPTION/BUDGETS/BUDGET1",
-			Error: true,
+			Expected: &ConsumptionBudgetResourceGroupId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				BudgetName:     "BUDGET1",
+			},
 		},
 	}
 
@@ -36,7 +36,7 @@ func (id ConsumptionBudgetSubscriptionId) ID() string {
 
 // ConsumptionBudgetSubscriptionID parses a ConsumptionBudgetSubscription ID into an ConsumptionBudgetSubscriptionId struct
 func ConsumptionBudgetSubscriptionID(input string) (*ConsumptionBudgetSubscriptionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func ConsumptionBudgetSubscriptionID(input string) (*ConsumptionBudgetSubscripti
 		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
 	}
 
-	if resourceId.BudgetName, err = id.PopSegment("budgets"); err != nil {
+	if resourceId.BudgetName, err = id.PopSegmentInsensitively("budgets"); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +67,10 @@ func TestConsumptionBudgetSubscriptionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.CONSUMPTION/BUDGETS/BUDGET1",
-			Error: true,
+			Expected: &ConsumptionBudgetSubscriptionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				BudgetName:     "BUDGET1",
+			},
 		},
 	}
 
@@ -14,8 +14,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/migration"
-	validate2 "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/containers/validate"
 	keyVaultValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
 	identityParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	identityValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
@@ -55,7 +55,7 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate2.ContainerRegistryName,
+				ValidateFunc: validation.ResourceName("Microsoft.ContainerRegistry/registries"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -317,37 +317,40 @@ func resourceContainerRegistry() *pluginsdk.Resource {
 			"tags": tags.Schema(),
 		},
 
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
-			sku := d.Get("sku").(string)
-			geoReplicationLocations := d.Get("georeplication_locations").(*pluginsdk.Set)
-			geoReplications := d.Get("georeplications").(*pluginsdk.Set)
-			hasGeoReplicationsApplied := geoReplicationLocations.Len() > 0 || geoReplications.Len() > 0
-			// if locations have been specified for geo-replication then, the SKU has to be Premium
-			if hasGeoReplicationsApplied && !strings.EqualFold(sku, string(containerregistry.Premium)) {
-				return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
-			}
-
-			quarantinePolicyEnabled := d.Get("quarantine_policy_enabled").(bool)
-			if quarantinePolicyEnabled && !strings.EqualFold(sku, string(containerregistry.Premium)) {
-				return fmt.Errorf("ACR quarantine policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set quarantine_policy {}")
-			}
-
-			retentionPolicyEnabled, ok := d.GetOk("retention_policy.0.enabled")
-			if ok && retentionPolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
-				return fmt.Errorf("ACR retention policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set retention_policy {}")
-			}
-
-			trustPolicyEnabled, ok := d.GetOk("trust_policy.0.enabled")
-			if ok && trustPolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
-				return fmt.Errorf("ACR trust policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set trust_policy {}")
-			}
-
-			encryptionEnabled, ok := d.GetOk("encryption.0.enabled")
-			if ok && encryptionEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
-				return fmt.Errorf("ACR encryption can only be applied when using the Premium Sku.")
-			}
-			return nil
-		}),
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				sku := d.Get("sku").(string)
+				geoReplicationLocations := d.Get("georeplication_locations").(*pluginsdk.Set)
+				geoReplications := d.Get("georeplications").(*pluginsdk.Set)
+				hasGeoReplicationsApplied := geoReplicationLocations.Len() > 0 || geoReplications.Len() > 0
+				// if locations have been specified for geo-replication then, the SKU has to be Premium
+				if hasGeoReplicationsApplied && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("ACR geo-replication can only be applied when using the Premium Sku.")
+				}
+
+				quarantinePolicyEnabled := d.Get("quarantine_policy_enabled").(bool)
+				if quarantinePolicyEnabled && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("ACR quarantine policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set quarantine_policy {}")
+				}
+
+				retentionPolicyEnabled, ok := d.GetOk("retention_policy.0.enabled")
+				if ok && retentionPolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("ACR retention policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set retention_policy {}")
+				}
+
+				trustPolicyEnabled, ok := d.GetOk("trust_policy.0.enabled")
+				if ok && trustPolicyEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("ACR trust policy can only be applied when using the Premium Sku. If you are downgrading from a Premium SKU please set trust_policy {}")
+				}
+
+				encryptionEnabled, ok := d.GetOk("encryption.0.enabled")
+				if ok && encryptionEnabled.(bool) && !strings.EqualFold(sku, string(containerregistry.Premium)) {
+					return fmt.Errorf("ACR encryption can only be applied when using the Premium Sku.")
+				}
+				return nil
+			},
+			nameavailability.CustomizeDiff("Container Registry", containerRegistryNameAvailability),
+		),
 	}
 }
 
@@ -1072,3 +1075,22 @@ func flattenTrustPolicy(p *containerregistry.Policies) []interface{} {
 	trustPolicy["enabled"] = utils.Bool(enabled)
 	return []interface{}{trustPolicy}
 }
+
+func containerRegistryNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	resp, err := client.Containers.RegistriesClient.CheckNameAvailability(ctx, containerregistry.RegistryNameCheckRequest{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.ContainerRegistry/registries"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.NameAvailable == nil {
+		return nil, fmt.Errorf("`nameAvailable` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.NameAvailable,
+		Reason:    utils.NormalizeNilableString(resp.Reason),
+		Message:   utils.NormalizeNilableString(resp.Message),
+	}, nil
+}
@@ -53,7 +53,7 @@ func resourceContainerRegistryScopeMap() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.ContainerRegistryName,
+				ValidateFunc: validation.ResourceName("Microsoft.ContainerRegistry/registries"),
 			},
 
 			"actions": {
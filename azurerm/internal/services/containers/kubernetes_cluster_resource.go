@@ -20,6 +20,7 @@ import (
 	msiparse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/parse"
 	msivalidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/msi/validate"
 	privateDnsValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/privatedns/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/skus"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -45,6 +46,13 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("sku_tier", func(ctx context.Context, old, new, meta interface{}) bool {
 				return new == "Free"
 			}),
+			skus.ValidateAvailability(skus.AvailabilityFields{
+				ResourceType:    "Microsoft.ContainerService/managedClusters",
+				SkuResourceType: "virtualMachines",
+				LocationField:   "location",
+				SkuField:        "default_node_pool.0.vm_size",
+				ZonesField:      "default_node_pool.0.availability_zones",
+			}),
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -59,7 +67,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validation.ResourceName("Microsoft.ContainerService/managedClusters"),
 			},
 
 			"location": azure.SchemaLocation(),
@@ -80,7 +80,11 @@ func TestClusterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/CLUSTER1",
-			Error: true,
+			Expected: &ClusterId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				ManagedClusterName: "CLUSTER1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ContainerGroupId) ID() string {
 
 // ContainerGroupID parses a ContainerGroup ID into an ContainerGroupId struct
 func ContainerGroupID(input string) (*ContainerGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ContainerGroupID(input string) (*ContainerGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("containerGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("containerGroups"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id ContainerRegistryScopeMapId) ID() string {
 
 // ContainerRegistryScopeMapID parses a ContainerRegistryScopeMap ID into an ContainerRegistryScopeMapId struct
 func ContainerRegistryScopeMapID(input string) (*ContainerRegistryScopeMapId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ContainerRegistryScopeMapID(input string) (*ContainerRegistryScopeMapId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+	if resourceId.RegistryName, err = id.PopSegmentInsensitively("registries"); err != nil {
 		return nil, err
 	}
-	if resourceId.ScopeMapName, err = id.PopSegment("scopeMaps"); err != nil {
+	if resourceId.ScopeMapName, err = id.PopSegmentInsensitively("scopeMaps"); err != nil {
 		return nil, err
 	}
 
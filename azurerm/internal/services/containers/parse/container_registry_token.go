@@ -42,7 +42,7 @@ func (id ContainerRegistryTokenId) ID() string {
 
 // ContainerRegistryTokenID parses a ContainerRegistryToken ID into an ContainerRegistryTokenId struct
 func ContainerRegistryTokenID(input string) (*ContainerRegistryTokenId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ContainerRegistryTokenID(input string) (*ContainerRegistryTokenId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RegistryName, err = id.PopSegment("registries"); err != nil {
+	if resourceId.RegistryName, err = id.PopSegmentInsensitively("registries"); err != nil {
 		return nil, err
 	}
-	if resourceId.TokenName, err = id.PopSegment("tokens"); err != nil {
+	if resourceId.TokenName, err = id.PopSegmentInsensitively("tokens"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestContainerRegistryTokenID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONTAINERREGISTRY/REGISTRIES/REGISTRY1/TOKENS/TOKEN1",
-			Error: true,
+			Expected: &ContainerRegistryTokenId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				RegistryName:   "REGISTRY1",
+				TokenName:      "TOKEN1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id NodePoolId) ID() string {
 
 // NodePoolID parses a NodePool ID into an NodePoolId struct
 func NodePoolID(input string) (*NodePoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NodePoolID(input string) (*NodePoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+	if resourceId.ManagedClusterName, err = id.PopSegmentInsensitively("managedClusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.AgentPoolName, err = id.PopSegment("agentPools"); err != nil {
+	if resourceId.AgentPoolName, err = id.PopSegmentInsensitively("agentPools"); err != nil {
 		return nil, err
 	}
 
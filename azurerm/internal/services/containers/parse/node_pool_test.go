@@ -93,7 +93,12 @@ func TestNodePoolID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/CLUSTER1/AGENTPOOLS/POOL1",
-			Error: true,
+			Expected: &NodePoolId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				ManagedClusterName: "CLUSTER1",
+				AgentPoolName:      "POOL1",
+			},
 		},
 	}
 
@@ -15,6 +15,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/common"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
 	keyVaultParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
@@ -59,13 +60,10 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
-				ValidateFunc: validation.StringMatch(
-					regexp.MustCompile("^[-a-z0-9]{3,50}$"),
-					"Cosmos DB Account name must be 3 - 50 characters long, contain only lowercase letters, numbers and hyphens.",
-				),
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceName("Microsoft.DocumentDB/databaseAccounts"),
 			},
 
 			"location": azure.SchemaLocation(),
@@ -458,6 +456,8 @@ func resourceCosmosDbAccount() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(nameavailability.CustomizeDiff("CosmosDB Account", cosmosDbAccountNameAvailability)),
 	}
 }
 
@@ -1357,3 +1357,22 @@ func flattenAzureRmdocumentdbMachineIdentity(identity *documentdb.ManagedService
 	},
 	}
 }
+
+func cosmosDbAccountNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	resp, err := client.Cosmos.DatabaseClient.CheckNameExists(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if utils.ResponseWasNotFound(resp) {
+		return &nameavailability.Result{
+			Available: true,
+		}, nil
+	}
+
+	return &nameavailability.Result{
+		Available: false,
+		Reason:    "AlreadyExists",
+		Message:   fmt.Sprintf("a CosmosDB Account named %q already exists", name),
+	}, nil
+}
@@ -58,7 +58,7 @@ func resourceCosmosDbGremlinGraph() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.CosmosAccountName,
+				ValidateFunc: validation.ResourceName("Microsoft.DocumentDB/databaseAccounts"),
 			},
 
 			"database_name": {
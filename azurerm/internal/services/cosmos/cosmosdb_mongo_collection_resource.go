@@ -56,7 +56,7 @@ func resourceCosmosDbMongoCollection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.CosmosAccountName,
+				ValidateFunc: validation.ResourceName("Microsoft.DocumentDB/databaseAccounts"),
 			},
 
 			"database_name": {
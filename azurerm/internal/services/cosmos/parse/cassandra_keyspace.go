@@ -42,7 +42,7 @@ func (id CassandraKeyspaceId) ID() string {
 
 // CassandraKeyspaceID parses a CassandraKeyspace ID into an CassandraKeyspaceId struct
 func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CassandraKeyspaceID(input string) (*CassandraKeyspaceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("cassandraKeyspaces"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("cassandraKeyspaces"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id CassandraTableId) ID() string {
 
 // CassandraTableID parses a CassandraTable ID into an CassandraTableId struct
 func CassandraTableID(input string) (*CassandraTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func CassandraTableID(input string) (*CassandraTableId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.CassandraKeyspaceName, err = id.PopSegment("cassandraKeyspaces"); err != nil {
+	if resourceId.CassandraKeyspaceName, err = id.PopSegmentInsensitively("cassandraKeyspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.TableName, err = id.PopSegment("tables"); err != nil {
+	if resourceId.TableName, err = id.PopSegmentInsensitively("tables"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestCassandraTableID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/CASSANDRAKEYSPACES/KEYSPACE1/TABLES/TABLE1",
-			Error: true,
+			Expected: &CassandraTableId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "RESGROUP1",
+				DatabaseAccountName:   "ACC1",
+				CassandraKeyspaceName: "KEYSPACE1",
+				TableName:             "TABLE1",
+			},
 		},
 	}
 
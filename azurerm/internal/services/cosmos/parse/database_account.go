@@ -39,7 +39,7 @@ func (id DatabaseAccountId) ID() string {
 
 // DatabaseAccountID parses a DatabaseAccount ID into an DatabaseAccountId struct
 func DatabaseAccountID(input string) (*DatabaseAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DatabaseAccountID(input string) (*DatabaseAccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
 
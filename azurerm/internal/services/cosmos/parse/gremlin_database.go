@@ -42,7 +42,7 @@ func (id GremlinDatabaseId) ID() string {
 
 // GremlinDatabaseID parses a GremlinDatabase ID into an GremlinDatabaseId struct
 func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func GremlinDatabaseID(input string) (*GremlinDatabaseId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("gremlinDatabases"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("gremlinDatabases"); err != nil {
 		return nil, err
 	}
 
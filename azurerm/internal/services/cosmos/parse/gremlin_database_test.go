@@ -93,7 +93,12 @@ func TestGremlinDatabaseID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/GREMLINDATABASES/DATABASE1",
-			Error: true,
+			Expected: &GremlinDatabaseId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				DatabaseAccountName: "ACC1",
+				Name:                "DATABASE1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id GremlinGraphId) ID() string {
 
 // GremlinGraphID parses a GremlinGraph ID into an GremlinGraphId struct
 func GremlinGraphID(input string) (*GremlinGraphId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func GremlinGraphID(input string) (*GremlinGraphId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.GremlinDatabaseName, err = id.PopSegment("gremlinDatabases"); err != nil {
+	if resourceId.GremlinDatabaseName, err = id.PopSegmentInsensitively("gremlinDatabases"); err != nil {
 		return nil, err
 	}
-	if resourceId.GraphName, err = id.PopSegment("graphs"); err != nil {
+	if resourceId.GraphName, err = id.PopSegmentInsensitively("graphs"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestGremlinGraphID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/GREMLINDATABASES/DATABASE1/GRAPHS/GRAPH1",
-			Error: true,
+			Expected: &GremlinGraphId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				DatabaseAccountName: "ACC1",
+				GremlinDatabaseName: "DATABASE1",
+				GraphName:           "GRAPH1",
+			},
 		},
 	}
 
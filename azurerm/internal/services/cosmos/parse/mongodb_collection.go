@@ -45,7 +45,7 @@ func (id MongodbCollectionId) ID() string {
 
 // MongodbCollectionID parses a MongodbCollection ID into an MongodbCollectionId struct
 func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func MongodbCollectionID(input string) (*MongodbCollectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.MongodbDatabaseName, err = id.PopSegment("mongodbDatabases"); err != nil {
+	if resourceId.MongodbDatabaseName, err = id.PopSegmentInsensitively("mongodbDatabases"); err != nil {
 		return nil, err
 	}
-	if resourceId.CollectionName, err = id.PopSegment("collections"); err != nil {
+	if resourceId.CollectionName, err = id.PopSegmentInsensitively("collections"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestMongodbCollectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/MONGODBDATABASES/DB1/COLLECTIONS/COLL1",
-			Error: true,
+			Expected: &MongodbCollectionId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				DatabaseAccountName: "ACC1",
+				MongodbDatabaseName: "DB1",
+				CollectionName:      "COLL1",
+			},
 		},
 	}
 
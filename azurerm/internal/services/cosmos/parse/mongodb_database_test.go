@@ -93,7 +93,12 @@ func TestMongodbDatabaseID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/MONGODBDATABASES/DB1",
-			Error: true,
+			Expected: &MongodbDatabaseId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				DatabaseAccountName: "ACC1",
+				Name:                "DB1",
+			},
 		},
 	}
 
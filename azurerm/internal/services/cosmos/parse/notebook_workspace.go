@@ -42,7 +42,7 @@ func (id NotebookWorkspaceId) ID() string {
 
 // NotebookWorkspaceID parses a NotebookWorkspace ID into an NotebookWorkspaceId struct
 func NotebookWorkspaceID(input string) (*NotebookWorkspaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NotebookWorkspaceID(input string) (*NotebookWorkspaceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("notebookWorkspaces"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("notebookWorkspaces"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestNotebookWorkspaceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACCOUNT1/NOTEBOOKWORKSPACES/NOTEBOOKWORKSPACE1",
-			Error: true,
+			Expected: &NotebookWorkspaceId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESOURCEGROUP1",
+				DatabaseAccountName: "ACCOUNT1",
+				Name:                "NOTEBOOKWORKSPACE1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id SqlContainerId) ID() string {
 
 // SqlContainerID parses a SqlContainer ID into an SqlContainerId struct
 func SqlContainerID(input string) (*SqlContainerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SqlContainerID(input string) (*SqlContainerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
+	if resourceId.SqlDatabaseName, err = id.PopSegmentInsensitively("sqlDatabases"); err != nil {
 		return nil, err
 	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
 		return nil, err
 	}
 
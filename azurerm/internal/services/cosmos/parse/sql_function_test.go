@@ -119,7 +119,14 @@ func TestSqlFunctionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACCOUNT1/SQLDATABASES/DATABASE1/CONTAINERS/CONTAINER1/USERDEFINEDFUNCTIONS/USERDEFINEDFUNCTION1",
-			Error: true,
+			Expected: &SqlFunctionId{
+				SubscriptionId:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "RESOURCEGROUP1",
+				DatabaseAccountName:     "ACCOUNT1",
+				SqlDatabaseName:         "DATABASE1",
+				ContainerName:           "CONTAINER1",
+				UserDefinedFunctionName: "USERDEFINEDFUNCTION1",
+			},
 		},
 	}
 
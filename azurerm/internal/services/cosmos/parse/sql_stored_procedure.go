@@ -48,7 +48,7 @@ func (id SqlStoredProcedureId) ID() string {
 
 // SqlStoredProcedureID parses a SqlStoredProcedure ID into an SqlStoredProcedureId struct
 func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +66,16 @@ func SqlStoredProcedureID(input string) (*SqlStoredProcedureId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.SqlDatabaseName, err = id.PopSegment("sqlDatabases"); err != nil {
+	if resourceId.SqlDatabaseName, err = id.PopSegmentInsensitively("sqlDatabases"); err != nil {
 		return nil, err
 	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
 		return nil, err
 	}
-	if resourceId.StoredProcedureName, err = id.PopSegment("storedProcedures"); err != nil {
+	if resourceId.StoredProcedureName, err = id.PopSegmentInsensitively("storedProcedures"); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +119,14 @@ func TestSqlStoredProcedureID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/SQLDATABASES/DB1/CONTAINERS/CONTAINER1/STOREDPROCEDURES/SPROC1",
-			Error: true,
+			Expected: &SqlStoredProcedureId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				DatabaseAccountName: "ACC1",
+				SqlDatabaseName:     "DB1",
+				ContainerName:       "CONTAINER1",
+				StoredProcedureName: "SPROC1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id TableId) ID() string {
 
 // TableID parses a Table ID into an TableId struct
 func TableID(input string) (*TableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func TableID(input string) (*TableId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DatabaseAccountName, err = id.PopSegment("databaseAccounts"); err != nil {
+	if resourceId.DatabaseAccountName, err = id.PopSegmentInsensitively("databaseAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("tables"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("tables"); err != nil {
 		return nil, err
 	}
 
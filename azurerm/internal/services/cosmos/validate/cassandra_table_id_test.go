@@ -85,7 +85,7 @@ func TestCassandraTableID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/CASSANDRAKEYSPACES/KEYSPACE1/TABLES/TABLE1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
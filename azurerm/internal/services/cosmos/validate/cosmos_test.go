@@ -2,45 +2,6 @@ package validate
 
 import "testing"
 
-func TestCosmosAccountName(t *testing.T) {
-	cases := []struct {
-		Value  string
-		Errors int
-	}{
-		{
-			Value:  "foo-bar",
-			Errors: 0,
-		},
-		{
-			Value:  "foo",
-			Errors: 0,
-		},
-		{
-			Value:  "fu",
-			Errors: 1,
-		},
-		{
-			Value:  "foo_bar",
-			Errors: 1,
-		},
-		{
-			Value:  "fooB@r",
-			Errors: 1,
-		},
-		{
-			Value:  "foo-bar-foo-bar-foo-bar-foo-bar-foo-bar-foo-bar-foo-bar",
-			Errors: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := CosmosAccountName(tc.Value, "throughput")
-		if len(errors) != tc.Errors {
-			t.Fatalf("Expected CosmosAccountName to trigger '%d' errors for '%s' - got '%d'", tc.Errors, tc.Value, len(errors))
-		}
-	}
-}
-
 func TestCosmosEntityName(t *testing.T) {
 	cases := []struct {
 		Value  string
@@ -85,7 +85,7 @@ func TestMongodbCollectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/MONGODBDATABASES/DB1/COLLECTIONS/COLL1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
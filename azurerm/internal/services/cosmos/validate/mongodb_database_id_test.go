@@ -73,7 +73,7 @@ func TestMongodbDatabaseID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACC1/MONGODBDATABASES/DB1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
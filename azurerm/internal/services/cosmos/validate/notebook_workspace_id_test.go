@@ -73,7 +73,7 @@ func TestNotebookWorkspaceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACCOUNT1/NOTEBOOKWORKSPACES/NOTEBOOKWORKSPACE1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
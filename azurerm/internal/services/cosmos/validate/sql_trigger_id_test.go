@@ -97,7 +97,7 @@ func TestSqlTriggerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.DOCUMENTDB/DATABASEACCOUNTS/ACCOUNT1/SQLDATABASES/DATABASE1/CONTAINERS/CONTAINER1/TRIGGERS/TRIGGER1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -39,7 +39,7 @@ func (id ResourceProviderId) ID() string {
 
 // ResourceProviderID parses a ResourceProvider ID into an ResourceProviderId struct
 func ResourceProviderID(input string) (*ResourceProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ResourceProviderID(input string) (*ResourceProviderId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("resourceproviders"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("resourceproviders"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id ProjectId) ID() string {
 
 // ProjectID parses a Project ID into an ProjectId struct
 func ProjectID(input string) (*ProjectId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ProjectID(input string) (*ProjectId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceName, err = id.PopSegment("services"); err != nil {
+	if resourceId.ServiceName, err = id.PopSegmentInsensitively("services"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("projects"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("projects"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestProjectID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATAMIGRATION/SERVICES/SERVICE1/PROJECTS/PROJECT1",
-			Error: true,
+			Expected: &ProjectId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServiceName:    "SERVICE1",
+				Name:           "PROJECT1",
+			},
 		},
 	}
 
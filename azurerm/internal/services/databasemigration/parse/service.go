@@ -39,7 +39,7 @@ func (id ServiceId) ID() string {
 
 // ServiceID parses a Service ID into an ServiceId struct
 func ServiceID(input string) (*ServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ServiceID(input string) (*ServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("services"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("services"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestWorkspaceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATABRICKS/WORKSPACES/WORKSPACE1",
-			Error: true,
+			Expected: &WorkspaceId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "WORKSPACE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id DataSetId) ID() string {
 
 // DataSetID parses a DataSet ID into an DataSetId struct
 func DataSetID(input string) (*DataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DataSetID(input string) (*DataSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+	if resourceId.FactoryName, err = id.PopSegmentInsensitively("factories"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("datasets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("datasets"); err != nil {
 		return nil, err
 	}
 
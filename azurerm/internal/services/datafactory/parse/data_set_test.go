@@ -93,7 +93,12 @@ func TestDataSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATAFACTORY/FACTORIES/FACNAME1/DATASETS/DATASET1",
-			Error: true,
+			Expected: &DataSetId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				FactoryName:    "FACNAME1",
+				Name:           "DATASET1",
+			},
 		},
 	}
 
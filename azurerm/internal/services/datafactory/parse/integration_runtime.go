@@ -42,7 +42,7 @@ func (id IntegrationRuntimeId) ID() string {
 
 // IntegrationRuntimeID parses a IntegrationRuntime ID into an IntegrationRuntimeId struct
 func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func IntegrationRuntimeID(input string) (*IntegrationRuntimeId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+	if resourceId.FactoryName, err = id.PopSegmentInsensitively("factories"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("integrationruntimes"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("integrationruntimes"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestIntegrationRuntimeID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATAFACTORY/FACTORIES/FACTORY1/INTEGRATIONRUNTIMES/RUNTIME1",
-			Error: true,
+			Expected: &IntegrationRuntimeId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				FactoryName:    "FACTORY1",
+				Name:           "RUNTIME1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id LinkedServiceId) ID() string {
 
 // LinkedServiceID parses a LinkedService ID into an LinkedServiceId struct
 func LinkedServiceID(input string) (*LinkedServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LinkedServiceID(input string) (*LinkedServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FactoryName, err = id.PopSegment("factories"); err != nil {
+	if resourceId.FactoryName, err = id.PopSegmentInsensitively("factories"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("linkedservices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("linkedservices"); err != nil {
 		return nil, err
 	}
 
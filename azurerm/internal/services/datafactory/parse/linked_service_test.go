@@ -93,7 +93,12 @@ func TestLinkedServiceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATAFACTORY/FACTORIES/FACTORY1/LINKEDSERVICES/LINKEDSERVICE1",
-			Error: true,
+			Expected: &LinkedServiceId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				FactoryName:    "FACTORY1",
+				Name:           "LINKEDSERVICE1",
+			},
 		},
 	}
 
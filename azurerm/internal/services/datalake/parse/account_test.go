@@ -80,7 +80,11 @@ func TestAccountID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATALAKESTORE/ACCOUNTS/ACCOUNT1",
-			Error: true,
+			Expected: &AccountId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "ACCOUNT1",
+			},
 		},
 	}
 
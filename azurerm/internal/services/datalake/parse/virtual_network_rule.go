@@ -42,7 +42,7 @@ func (id VirtualNetworkRuleId) ID() string {
 
 // VirtualNetworkRuleID parses a VirtualNetworkRule ID into an VirtualNetworkRuleId struct
 func VirtualNetworkRuleID(input string) (*VirtualNetworkRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualNetworkRuleID(input string) (*VirtualNetworkRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+	if resourceId.AccountName, err = id.PopSegmentInsensitively("accounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("virtualNetworkRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualNetworkRules"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestVirtualNetworkRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATALAKESTORE/ACCOUNTS/ACCOUNT1/VIRTUALNETWORKRULES/VIRTUALNETWORKRULE1",
-			Error: true,
+			Expected: &VirtualNetworkRuleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				AccountName:    "ACCOUNT1",
+				Name:           "VIRTUALNETWORKRULE1",
+			},
 		},
 	}
 
@@ -12,7 +12,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/helper"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/datashare/validate"
-	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -55,7 +54,7 @@ func resourceDataShareDataSetBlobStorage() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: storageValidate.StorageContainerName,
+				ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts/blobServices/containers"),
 			},
 
 			"storage_account": {
@@ -70,7 +69,7 @@ func resourceDataShareDataSetBlobStorage() *pluginsdk.Resource {
 							Type:         pluginsdk.TypeString,
 							Required:     true,
 							ForceNew:     true,
-							ValidateFunc: storageValidate.StorageAccountName,
+							ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts"),
 						},
 
 						"resource_group_name": azure.SchemaResourceGroupName(),
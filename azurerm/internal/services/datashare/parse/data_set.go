@@ -45,7 +45,7 @@ func (id DataSetId) ID() string {
 
 // DataSetID parses a DataSet ID into an DataSetId struct
 func DataSetID(input string) (*DataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func DataSetID(input string) (*DataSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+	if resourceId.AccountName, err = id.PopSegmentInsensitively("accounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
+	if resourceId.ShareName, err = id.PopSegmentInsensitively("shares"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("dataSets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("dataSets"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestDataSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATASHARE/ACCOUNTS/ACCOUNT1/SHARES/SHARE1/DATASETS/DATASET1",
-			Error: true,
+			Expected: &DataSetId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				AccountName:    "ACCOUNT1",
+				ShareName:      "SHARE1",
+				Name:           "DATASET1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ShareId) ID() string {
 
 // ShareID parses a Share ID into an ShareId struct
 func ShareID(input string) (*ShareId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ShareID(input string) (*ShareId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AccountName, err = id.PopSegment("accounts"); err != nil {
+	if resourceId.AccountName, err = id.PopSegmentInsensitively("accounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("shares"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("shares"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestShareID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATASHARE/ACCOUNTS/ACCOUNT1/SHARES/SHARE1",
-			Error: true,
+			Expected: &ShareId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				AccountName:    "ACCOUNT1",
+				Name:           "SHARE1",
+			},
 		},
 	}
 
@@ -85,7 +85,7 @@ func TestDataSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DATASHARE/ACCOUNTS/ACCOUNT1/SHARES/SHARE1/DATASETS/DATASET1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
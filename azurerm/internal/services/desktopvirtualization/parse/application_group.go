@@ -39,7 +39,7 @@ func (id ApplicationGroupId) ID() string {
 
 // ApplicationGroupID parses a ApplicationGroup ID into an ApplicationGroupId struct
 func ApplicationGroupID(input string) (*ApplicationGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApplicationGroupID(input string) (*ApplicationGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("applicationGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("applicationGroups"); err != nil {
 		return nil, err
 	}
 
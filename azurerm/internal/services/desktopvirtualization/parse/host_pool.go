@@ -39,7 +39,7 @@ func (id HostPoolId) ID() string {
 
 // HostPoolID parses a HostPool ID into an HostPoolId struct
 func HostPoolID(input string) (*HostPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func HostPoolID(input string) (*HostPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("hostPools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hostPools"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id WorkspaceId) ID() string {
 
 // WorkspaceID parses a Workspace ID into an WorkspaceId struct
 func WorkspaceID(input string) (*WorkspaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func WorkspaceID(input string) (*WorkspaceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id ControllerId) ID() string {
 
 // ControllerID parses a Controller ID into an ControllerId struct
 func ControllerID(input string) (*ControllerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ControllerID(input string) (*ControllerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("controllers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("controllers"); err != nil {
 		return nil, err
 	}
 
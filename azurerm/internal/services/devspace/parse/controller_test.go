@@ -80,7 +80,11 @@ func TestControllerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.DEVSPACES/CONTROLLERS/CONTROLLER1",
-			Error: true,
+			Expected: &ControllerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "CONTROLLER1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ScheduleId) ID() string {
 
 // ScheduleID parses a Schedule ID into an ScheduleId struct
 func ScheduleID(input string) (*ScheduleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ScheduleID(input string) (*ScheduleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("schedules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("schedules"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id DigitalTwinsEndpointId) ID() string {
 
 // DigitalTwinsEndpointID parses a DigitalTwinsEndpoint ID into an DigitalTwinsEndpointId struct
 func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DigitalTwinsEndpointID(input string) (*DigitalTwinsEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DigitalTwinsInstanceName, err = id.PopSegment("digitalTwinsInstances"); err != nil {
+	if resourceId.DigitalTwinsInstanceName, err = id.PopSegmentInsensitively("digitalTwinsInstances"); err != nil {
 		return nil, err
 	}
-	if resourceId.EndpointName, err = id.PopSegment("endpoints"); err != nil {
+	if resourceId.EndpointName, err = id.PopSegmentInsensitively("endpoints"); err != nil {
 		return nil, err
 	}
 
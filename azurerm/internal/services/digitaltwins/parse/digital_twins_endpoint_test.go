@@ -93,7 +93,12 @@ func TestDigitalTwinsEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/INSTANCE1/ENDPOINTS/ENDPOINT1",
-			Error: true,
+			Expected: &DigitalTwinsEndpointId{
+				SubscriptionId:           "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:            "GROUP1",
+				DigitalTwinsInstanceName: "INSTANCE1",
+				EndpointName:             "ENDPOINT1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id DigitalTwinsInstanceId) ID() string {
 
 // DigitalTwinsInstanceID parses a DigitalTwinsInstance ID into an DigitalTwinsInstanceId struct
 func DigitalTwinsInstanceID(input string) (*DigitalTwinsInstanceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DigitalTwinsInstanceID(input string) (*DigitalTwinsInstanceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("digitalTwinsInstances"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("digitalTwinsInstances"); err != nil {
 		return nil, err
 	}
 
@@ -73,7 +73,7 @@ func TestDigitalTwinsEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.DIGITALTWINS/DIGITALTWINSINSTANCES/INSTANCE1/ENDPOINTS/ENDPOINT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
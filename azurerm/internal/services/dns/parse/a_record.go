@@ -42,7 +42,7 @@ func (id ARecordId) ID() string {
 
 // ARecordID parses a ARecord ID into an ARecordId struct
 func ARecordID(input string) (*ARecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ARecordID(input string) (*ARecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.AName, err = id.PopSegment("A"); err != nil {
+	if resourceId.AName, err = id.PopSegmentInsensitively("A"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestARecordID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/ZONE1/A/EH1",
-			Error: true,
+			Expected: &ARecordId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				DnszoneName:    "ZONE1",
+				AName:          "EH1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id AaaaRecordId) ID() string {
 
 // AaaaRecordID parses a AaaaRecord ID into an AaaaRecordId struct
 func AaaaRecordID(input string) (*AaaaRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AaaaRecordID(input string) (*AaaaRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.AAAAName, err = id.PopSegment("AAAA"); err != nil {
+	if resourceId.AAAAName, err = id.PopSegmentInsensitively("AAAA"); err != nil {
 		return nil, err
 	}
 
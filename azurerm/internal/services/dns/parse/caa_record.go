@@ -42,7 +42,7 @@ func (id CaaRecordId) ID() string {
 
 // CaaRecordID parses a CaaRecord ID into an CaaRecordId struct
 func CaaRecordID(input string) (*CaaRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CaaRecordID(input string) (*CaaRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.CAAName, err = id.PopSegment("CAA"); err != nil {
+	if resourceId.CAAName, err = id.PopSegmentInsensitively("CAA"); err != nil {
 		return nil, err
 	}
 
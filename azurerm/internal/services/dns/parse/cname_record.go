@@ -42,7 +42,7 @@ func (id CnameRecordId) ID() string {
 
 // CnameRecordID parses a CnameRecord ID into an CnameRecordId struct
 func CnameRecordID(input string) (*CnameRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CnameRecordID(input string) (*CnameRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.CNAMEName, err = id.PopSegment("CNAME"); err != nil {
+	if resourceId.CNAMEName, err = id.PopSegmentInsensitively("CNAME"); err != nil {
 		return nil, err
 	}
 
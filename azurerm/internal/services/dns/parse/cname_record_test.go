@@ -93,7 +93,12 @@ func TestCnameRecordID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/ZONE1/CNAME/NAME1",
-			Error: true,
+			Expected: &CnameRecordId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				DnszoneName:    "ZONE1",
+				CNAMEName:      "NAME1",
+			},
 		},
 	}
 
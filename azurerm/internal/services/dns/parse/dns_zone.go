@@ -39,7 +39,7 @@ func (id DnsZoneId) ID() string {
 
 // DnsZoneID parses a DnsZone ID into an DnsZoneId struct
 func DnsZoneID(input string) (*DnsZoneId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DnsZoneID(input string) (*DnsZoneId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id MxRecordId) ID() string {
 
 // MxRecordID parses a MxRecord ID into an MxRecordId struct
 func MxRecordID(input string) (*MxRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func MxRecordID(input string) (*MxRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.MXName, err = id.PopSegment("MX"); err != nil {
+	if resourceId.MXName, err = id.PopSegmentInsensitively("MX"); err != nil {
 		return nil, err
 	}
 
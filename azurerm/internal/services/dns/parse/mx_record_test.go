@@ -93,7 +93,12 @@ func TestMxRecordID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/DNSZONES/ZONE1/MX/MX1",
-			Error: true,
+			Expected: &MxRecordId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				DnszoneName:    "ZONE1",
+				MXName:         "MX1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id NsRecordId) ID() string {
 
 // NsRecordID parses a NsRecord ID into an NsRecordId struct
 func NsRecordID(input string) (*NsRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NsRecordID(input string) (*NsRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.NSName, err = id.PopSegment("NS"); err != nil {
+	if resourceId.NSName, err = id.PopSegmentInsensitively("NS"); err != nil {
 		return nil, err
 	}
 
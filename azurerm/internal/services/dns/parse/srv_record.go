@@ -42,7 +42,7 @@ func (id SrvRecordId) ID() string {
 
 // SrvRecordID parses a SrvRecord ID into an SrvRecordId struct
 func SrvRecordID(input string) (*SrvRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SrvRecordID(input string) (*SrvRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.SRVName, err = id.PopSegment("SRV"); err != nil {
+	if resourceId.SRVName, err = id.PopSegmentInsensitively("SRV"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id TxtRecordId) ID() string {
 
 // TxtRecordID parses a TxtRecord ID into an TxtRecordId struct
 func TxtRecordID(input string) (*TxtRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func TxtRecordID(input string) (*TxtRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DnszoneName, err = id.PopSegment("dnszones"); err != nil {
+	if resourceId.DnszoneName, err = id.PopSegmentInsensitively("dnszones"); err != nil {
 		return nil, err
 	}
-	if resourceId.TXTName, err = id.PopSegment("TXT"); err != nil {
+	if resourceId.TXTName, err = id.PopSegmentInsensitively("TXT"); err != nil {
 		return nil, err
 	}
 
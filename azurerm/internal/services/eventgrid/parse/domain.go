@@ -39,7 +39,7 @@ func (id DomainId) ID() string {
 
 // DomainID parses a Domain ID into an DomainId struct
 func DomainID(input string) (*DomainId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DomainID(input string) (*DomainId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("domains"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("domains"); err != nil {
 		return nil, err
 	}
 
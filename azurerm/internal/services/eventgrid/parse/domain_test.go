@@ -80,7 +80,11 @@ func TestDomainID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.EVENTGRID/DOMAINS/DOMAIN1",
-			Error: true,
+			Expected: &DomainId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "DOMAIN1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id DomainTopicId) ID() string {
 
 // DomainTopicID parses a DomainTopic ID into an DomainTopicId struct
 func DomainTopicID(input string) (*DomainTopicId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DomainTopicID(input string) (*DomainTopicId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DomainName, err = id.PopSegment("domains"); err != nil {
+	if resourceId.DomainName, err = id.PopSegmentInsensitively("domains"); err != nil {
 		return nil, err
 	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
 		return nil, err
 	}
 
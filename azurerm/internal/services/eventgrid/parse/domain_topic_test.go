@@ -93,7 +93,12 @@ func TestDomainTopicID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.EVENTGRID/DOMAINS/DOMAIN1/TOPICS/TOPIC1",
-			Error: true,
+			Expected: &DomainTopicId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				DomainName:     "DOMAIN1",
+				TopicName:      "TOPIC1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id SystemTopicId) ID() string {
 
 // SystemTopicID parses a SystemTopic ID into an SystemTopicId struct
 func SystemTopicID(input string) (*SystemTopicId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SystemTopicID(input string) (*SystemTopicId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("systemTopics"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("systemTopics"); err != nil {
 		return nil, err
 	}
 
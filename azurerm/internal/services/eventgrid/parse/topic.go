@@ -39,7 +39,7 @@ func (id TopicId) ID() string {
 
 // TopicID parses a Topic ID into an TopicId struct
 func TopicID(input string) (*TopicId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func TopicID(input string) (*TopicId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("topics"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("topics"); err != nil {
 		return nil, err
 	}
 
@@ -27,7 +27,7 @@ func EventHubConsumerGroupDataSource() *pluginsdk.Resource {
 				Type:     pluginsdk.TypeString,
 				Required: true,
 				ValidateFunc: validation.Any(
-					validate.ValidateEventHubConsumerName(),
+					validation.ResourceName("Microsoft.EventHub/namespaces/eventhubs/consumerGroups"),
 					validation.StringInSlice([]string{"$Default"}, false),
 				),
 			},
@@ -35,7 +35,7 @@ func EventHubConsumerGroupDataSource() *pluginsdk.Resource {
 			"namespace_name": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ValidateFunc: validate.ValidateEventHubNamespaceName(),
+				ValidateFunc: validation.ResourceName("Microsoft.EventHub/namespaces"),
 			},
 
 			"eventhub_name": {
@@ -20,11 +20,12 @@ type ConsumerGroupObject struct {
 	NamespaceName     string `tfschema:"namespace_name"`
 	EventHubName      string `tfschema:"eventhub_name"`
 	ResourceGroupName string `tfschema:"resource_group_name"`
-	UserMetadata      string `tfschema:"user_metadata"`
+	UserMetadata      string `tfschema:"user_metadata" optional:"true" validation:"string_len_between(1,1024)"`
 }
 
 var _ sdk.Resource = ConsumerGroupResource{}
 var _ sdk.ResourceWithUpdate = ConsumerGroupResource{}
+var _ sdk.ResourceWithGeneratedSchema = ConsumerGroupResource{}
 
 type ConsumerGroupResource struct {
 }
@@ -39,14 +40,14 @@ func (r ConsumerGroupResource) Arguments() map[string]*pluginsdk.Schema {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.ValidateEventHubConsumerName(),
+			ValidateFunc: validation.ResourceName("Microsoft.EventHub/namespaces/eventhubs/consumerGroups"),
 		},
 
 		"namespace_name": {
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.ValidateEventHubNamespaceName(),
+			ValidateFunc: validation.ResourceName("Microsoft.EventHub/namespaces"),
 		},
 
 		"eventhub_name": {
@@ -58,11 +59,7 @@ func (r ConsumerGroupResource) Arguments() map[string]*pluginsdk.Schema {
 
 		"resource_group_name": azure.SchemaResourceGroupName(),
 
-		"user_metadata": {
-			Type:         pluginsdk.TypeString,
-			Optional:     true,
-			ValidateFunc: validation.StringLenBetween(1, 1024),
-		},
+		// NOTE: the Schema for `user_metadata` is generated from the ConsumerGroupObject
 	}
 }
 
@@ -70,6 +67,8 @@ func (r ConsumerGroupResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
 
+func (r ConsumerGroupResource) GeneratesSchemaFromModelObject() {}
+
 func (r ConsumerGroupResource) Create() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
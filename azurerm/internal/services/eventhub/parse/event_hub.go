@@ -42,7 +42,7 @@ func (id EventHubId) ID() string {
 
 // EventHubID parses a EventHub ID into an EventHubId struct
 func EventHubID(input string) (*EventHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EventHubID(input string) (*EventHubId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("eventhubs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("eventhubs"); err != nil {
 		return nil, err
 	}
 
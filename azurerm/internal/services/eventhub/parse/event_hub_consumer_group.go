@@ -45,7 +45,7 @@ func (id EventHubConsumerGroupId) ID() string {
 
 // EventHubConsumerGroupID parses a EventHubConsumerGroup ID into an EventHubConsumerGroupId struct
 func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func EventHubConsumerGroupID(input string) (*EventHubConsumerGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.EventhubName, err = id.PopSegment("eventhubs"); err != nil {
+	if resourceId.EventhubName, err = id.PopSegmentInsensitively("eventhubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.ConsumergroupName, err = id.PopSegment("consumergroups"); err != nil {
+	if resourceId.ConsumergroupName, err = id.PopSegmentInsensitively("consumergroups"); err != nil {
 		return nil, err
 	}
 
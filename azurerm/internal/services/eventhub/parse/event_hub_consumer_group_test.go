@@ -106,7 +106,13 @@ func TestEventHubConsumerGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.EVENTHUB/NAMESPACES/NAMESPACE1/EVENTHUBS/EVENTHUB1/CONSUMERGROUPS/CONSUMERGROUP1",
-			Error: true,
+			Expected: &EventHubConsumerGroupId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "GROUP1",
+				NamespaceName:     "NAMESPACE1",
+				EventhubName:      "EVENTHUB1",
+				ConsumergroupName: "CONSUMERGROUP1",
+			},
 		},
 	}
 
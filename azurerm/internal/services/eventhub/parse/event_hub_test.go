@@ -93,7 +93,12 @@ func TestEventHubID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.EVENTHUB/NAMESPACES/NAMESPACE1/EVENTHUBS/EVENTHUB1",
-			Error: true,
+			Expected: &EventHubId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				NamespaceName:  "NAMESPACE1",
+				Name:           "EVENTHUB1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id NamespaceId) ID() string {
 
 // NamespaceID parses a Namespace ID into an NamespaceId struct
 func NamespaceID(input string) (*NamespaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NamespaceID(input string) (*NamespaceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id NamespaceAuthorizationRuleId) ID() string {
 
 // NamespaceAuthorizationRuleID parses a NamespaceAuthorizationRule ID into an NamespaceAuthorizationRuleId struct
 func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NamespaceAuthorizationRuleID(input string) (*NamespaceAuthorizationRuleId,
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("authorizationRules"); err != nil {
 		return nil, err
 	}
 
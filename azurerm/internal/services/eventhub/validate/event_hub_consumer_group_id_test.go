@@ -85,7 +85,7 @@ func TestEventHubConsumerGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.EVENTHUB/NAMESPACES/NAMESPACE1/EVENTHUBS/EVENTHUB1/CONSUMERGROUPS/CONSUMERGROUP1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
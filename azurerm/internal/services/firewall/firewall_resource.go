@@ -323,14 +323,14 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
-	defer locks.UnlockByName(name, azureFirewallResourceName)
-
-	locks.MultipleByName(vnetToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetToLock, SubnetResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureFirewallResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetToLock, SubnetResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if !d.IsNewResource() {
 		exists, err2 := client.Get(ctx, resourceGroup, name)
@@ -515,14 +515,14 @@ func resourceFirewallDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.ByName(name, azureFirewallResourceName)
-	defer locks.UnlockByName(name, azureFirewallResourceName)
-
-	locks.MultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNamesToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(&subnetNamesToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(&subnetNamesToLock, SubnetResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureFirewallResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(virtualNetworkNamesToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(subnetNamesToLock, SubnetResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -39,7 +39,7 @@ func (id FirewallId) ID() string {
 
 // FirewallID parses a Firewall ID into an FirewallId struct
 func FirewallID(input string) (*FirewallId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func FirewallID(input string) (*FirewallId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+	if resourceId.AzureFirewallName, err = id.PopSegmentInsensitively("azureFirewalls"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id FirewallApplicationRuleCollectionId) ID() string {
 
 // FirewallApplicationRuleCollectionID parses a FirewallApplicationRuleCollection ID into an FirewallApplicationRuleCollectionId struct
 func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FirewallApplicationRuleCollectionID(input string) (*FirewallApplicationRule
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+	if resourceId.AzureFirewallName, err = id.PopSegmentInsensitively("azureFirewalls"); err != nil {
 		return nil, err
 	}
-	if resourceId.ApplicationRuleCollectionName, err = id.PopSegment("applicationRuleCollections"); err != nil {
+	if resourceId.ApplicationRuleCollectionName, err = id.PopSegmentInsensitively("applicationRuleCollections"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/MYGROUP1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/MYFIREWALL/APPLICATIONRULECOLLECTIONS/APPLICATIONRULECOLLECTION1",
-			Error: true,
+			Expected: &FirewallApplicationRuleCollectionId{
+				SubscriptionId:                "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:                 "MYGROUP1",
+				AzureFirewallName:             "MYFIREWALL",
+				ApplicationRuleCollectionName: "APPLICATIONRULECOLLECTION1",
+			},
 		},
 	}
 
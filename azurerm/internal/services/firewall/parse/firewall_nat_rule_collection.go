@@ -42,7 +42,7 @@ func (id FirewallNatRuleCollectionId) ID() string {
 
 // FirewallNatRuleCollectionID parses a FirewallNatRuleCollection ID into an FirewallNatRuleCollectionId struct
 func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FirewallNatRuleCollectionID(input string) (*FirewallNatRuleCollectionId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+	if resourceId.AzureFirewallName, err = id.PopSegmentInsensitively("azureFirewalls"); err != nil {
 		return nil, err
 	}
-	if resourceId.NatRuleCollectionName, err = id.PopSegment("natRuleCollections"); err != nil {
+	if resourceId.NatRuleCollectionName, err = id.PopSegmentInsensitively("natRuleCollections"); err != nil {
 		return nil, err
 	}
 
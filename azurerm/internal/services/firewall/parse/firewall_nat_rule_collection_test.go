@@ -93,7 +93,12 @@ func TestFirewallNatRuleCollectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/MYGROUP1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/MYFIREWALL/NATRULECOLLECTIONS/NATRULECOLLECTION1",
-			Error: true,
+			Expected: &FirewallNatRuleCollectionId{
+				SubscriptionId:        "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:         "MYGROUP1",
+				AzureFirewallName:     "MYFIREWALL",
+				NatRuleCollectionName: "NATRULECOLLECTION1",
+			},
 		},
 	}
 
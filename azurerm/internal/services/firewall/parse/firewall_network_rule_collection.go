@@ -42,7 +42,7 @@ func (id FirewallNetworkRuleCollectionId) ID() string {
 
 // FirewallNetworkRuleCollectionID parses a FirewallNetworkRuleCollection ID into an FirewallNetworkRuleCollectionId struct
 func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FirewallNetworkRuleCollectionID(input string) (*FirewallNetworkRuleCollecti
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+	if resourceId.AzureFirewallName, err = id.PopSegmentInsensitively("azureFirewalls"); err != nil {
 		return nil, err
 	}
-	if resourceId.NetworkRuleCollectionName, err = id.PopSegment("networkRuleCollections"); err != nil {
+	if resourceId.NetworkRuleCollectionName, err = id.PopSegmentInsensitively("networkRuleCollections"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id FirewallPolicyId) ID() string {
 
 // FirewallPolicyID parses a FirewallPolicy ID into an FirewallPolicyId struct
 func FirewallPolicyID(input string) (*FirewallPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func FirewallPolicyID(input string) (*FirewallPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("firewallPolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("firewallPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id FirewallPolicyRuleCollectionGroupId) ID() string {
 
 // FirewallPolicyRuleCollectionGroupID parses a FirewallPolicyRuleCollectionGroup ID into an FirewallPolicyRuleCollectionGroupId struct
 func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleCollectionGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FirewallPolicyRuleCollectionGroupID(input string) (*FirewallPolicyRuleColle
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FirewallPolicyName, err = id.PopSegment("firewallPolicies"); err != nil {
+	if resourceId.FirewallPolicyName, err = id.PopSegmentInsensitively("firewallPolicies"); err != nil {
 		return nil, err
 	}
-	if resourceId.RuleCollectionGroupName, err = id.PopSegment("ruleCollectionGroups"); err != nil {
+	if resourceId.RuleCollectionGroupName, err = id.PopSegmentInsensitively("ruleCollectionGroups"); err != nil {
 		return nil, err
 	}
 
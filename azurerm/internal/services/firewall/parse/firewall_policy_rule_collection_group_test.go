@@ -93,7 +93,12 @@ func TestFirewallPolicyRuleCollectionGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/FIREWALLPOLICIES/POLICY1/RULECOLLECTIONGROUPS/RULECOLLECTIONGROUP1",
-			Error: true,
+			Expected: &FirewallPolicyRuleCollectionGroupId{
+				SubscriptionId:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "RESGROUP1",
+				FirewallPolicyName:      "POLICY1",
+				RuleCollectionGroupName: "RULECOLLECTIONGROUP1",
+			},
 		},
 	}
 
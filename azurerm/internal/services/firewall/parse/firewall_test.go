@@ -80,7 +80,11 @@ func TestFirewallID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/FIREWALL1",
-			Error: true,
+			Expected: &FirewallId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "GROUP1",
+				AzureFirewallName: "FIREWALL1",
+			},
 		},
 	}
 
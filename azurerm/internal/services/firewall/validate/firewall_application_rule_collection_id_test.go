@@ -73,7 +73,7 @@ func TestFirewallApplicationRuleCollectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/MYGROUP1/PROVIDERS/MICROSOFT.NETWORK/AZUREFIREWALLS/MYFIREWALL/APPLICATIONRULECOLLECTIONS/APPLICATIONRULECOLLECTION1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
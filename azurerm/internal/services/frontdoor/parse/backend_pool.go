@@ -42,7 +42,7 @@ func (id BackendPoolId) ID() string {
 
 // BackendPoolID parses a BackendPool ID into an BackendPoolId struct
 func BackendPoolID(input string) (*BackendPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BackendPoolID(input string) (*BackendPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("backendPools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("backendPools"); err != nil {
 		return nil, err
 	}
 
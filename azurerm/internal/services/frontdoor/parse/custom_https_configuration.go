@@ -42,7 +42,7 @@ func (id CustomHttpsConfigurationId) ID() string {
 
 // CustomHttpsConfigurationID parses a CustomHttpsConfiguration ID into an CustomHttpsConfigurationId struct
 func CustomHttpsConfigurationID(input string) (*CustomHttpsConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CustomHttpsConfigurationID(input string) (*CustomHttpsConfigurationId, erro
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.CustomHttpsConfigurationName, err = id.PopSegment("customHttpsConfiguration"); err != nil {
+	if resourceId.CustomHttpsConfigurationName, err = id.PopSegmentInsensitively("customHttpsConfiguration"); err != nil {
 		return nil, err
 	}
 
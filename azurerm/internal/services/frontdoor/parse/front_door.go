@@ -39,7 +39,7 @@ func (id FrontDoorId) ID() string {
 
 // FrontDoorID parses a FrontDoor ID into an FrontDoorId struct
 func FrontDoorID(input string) (*FrontDoorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func FrontDoorID(input string) (*FrontDoorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
 
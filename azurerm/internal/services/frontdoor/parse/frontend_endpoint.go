@@ -42,7 +42,7 @@ func (id FrontendEndpointId) ID() string {
 
 // FrontendEndpointID parses a FrontendEndpoint ID into an FrontendEndpointId struct
 func FrontendEndpointID(input string) (*FrontendEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FrontendEndpointID(input string) (*FrontendEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("frontendEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("frontendEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id HealthProbeId) ID() string {
 
 // HealthProbeID parses a HealthProbe ID into an HealthProbeId struct
 func HealthProbeID(input string) (*HealthProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func HealthProbeID(input string) (*HealthProbeId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.HealthProbeSettingName, err = id.PopSegment("healthProbeSettings"); err != nil {
+	if resourceId.HealthProbeSettingName, err = id.PopSegmentInsensitively("healthProbeSettings"); err != nil {
 		return nil, err
 	}
 
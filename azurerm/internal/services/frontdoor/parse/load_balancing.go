@@ -42,7 +42,7 @@ func (id LoadBalancingId) ID() string {
 
 // LoadBalancingID parses a LoadBalancing ID into an LoadBalancingId struct
 func LoadBalancingID(input string) (*LoadBalancingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancingID(input string) (*LoadBalancingId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.LoadBalancingSettingName, err = id.PopSegment("loadBalancingSettings"); err != nil {
+	if resourceId.LoadBalancingSettingName, err = id.PopSegmentInsensitively("loadBalancingSettings"); err != nil {
 		return nil, err
 	}
 
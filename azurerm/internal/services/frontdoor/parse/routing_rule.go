@@ -42,7 +42,7 @@ func (id RoutingRuleId) ID() string {
 
 // RoutingRuleID parses a RoutingRule ID into an RoutingRuleId struct
 func RoutingRuleID(input string) (*RoutingRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func RoutingRuleID(input string) (*RoutingRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorName, err = id.PopSegment("frontDoors"); err != nil {
+	if resourceId.FrontDoorName, err = id.PopSegmentInsensitively("frontDoors"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("routingRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("routingRules"); err != nil {
 		return nil, err
 	}
 
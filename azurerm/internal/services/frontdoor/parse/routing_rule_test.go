@@ -93,7 +93,12 @@ func TestRoutingRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/FRONTDOORS/FRONTDOOR1/ROUTINGRULES/RULE1",
-			Error: true,
+			Expected: &RoutingRuleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				FrontDoorName:  "FRONTDOOR1",
+				Name:           "RULE1",
+			},
 		},
 	}
 
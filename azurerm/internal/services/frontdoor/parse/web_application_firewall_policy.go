@@ -39,7 +39,7 @@ func (id WebApplicationFirewallPolicyId) ID() string {
 
 // WebApplicationFirewallPolicyID parses a WebApplicationFirewallPolicy ID into an WebApplicationFirewallPolicyId struct
 func WebApplicationFirewallPolicyID(input string) (*WebApplicationFirewallPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func WebApplicationFirewallPolicyID(input string) (*WebApplicationFirewallPolicy
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FrontDoorWebApplicationFirewallPolicyName, err = id.PopSegment("frontDoorWebApplicationFirewallPolicies"); err != nil {
+	if resourceId.FrontDoorWebApplicationFirewallPolicyName, err = id.PopSegmentInsensitively("frontDoorWebApplicationFirewallPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id CacheAccessPolicyId) ID() string {
 
 // CacheAccessPolicyID parses a CacheAccessPolicy ID into an CacheAccessPolicyId struct
 func CacheAccessPolicyID(input string) (*CacheAccessPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CacheAccessPolicyID(input string) (*CacheAccessPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.CacheName, err = id.PopSegment("caches"); err != nil {
+	if resourceId.CacheName, err = id.PopSegmentInsensitively("caches"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("cacheAccessPolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("cacheAccessPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id StorageTargetId) ID() string {
 
 // StorageTargetID parses a StorageTarget ID into an StorageTargetId struct
 func StorageTargetID(input string) (*StorageTargetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StorageTargetID(input string) (*StorageTargetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.CacheName, err = id.PopSegment("caches"); err != nil {
+	if resourceId.CacheName, err = id.PopSegmentInsensitively("caches"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("storageTargets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("storageTargets"); err != nil {
 		return nil, err
 	}
 
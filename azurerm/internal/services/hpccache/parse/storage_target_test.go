@@ -93,7 +93,12 @@ func TestStorageTargetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.STORAGECACHE/CACHES/CACHE1/STORAGETARGETS/TARGET1",
-			Error: true,
+			Expected: &StorageTargetId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				CacheName:      "CACHE1",
+				Name:           "TARGET1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id DedicatedHardwareSecurityModuleId) ID() string {
 
 // DedicatedHardwareSecurityModuleID parses a DedicatedHardwareSecurityModule ID into an DedicatedHardwareSecurityModuleId struct
 func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurityModuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DedicatedHardwareSecurityModuleID(input string) (*DedicatedHardwareSecurity
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DedicatedHSMName, err = id.PopSegment("dedicatedHSMs"); err != nil {
+	if resourceId.DedicatedHSMName, err = id.PopSegmentInsensitively("dedicatedHSMs"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestDedicatedHardwareSecurityModuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.HARDWARESECURITYMODULES/DEDICATEDHSMS/HSM1",
-			Error: true,
+			Expected: &DedicatedHardwareSecurityModuleId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "GROUP1",
+				DedicatedHSMName: "HSM1",
+			},
 		},
 	}
 
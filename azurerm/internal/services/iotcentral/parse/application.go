@@ -39,7 +39,7 @@ func (id ApplicationId) ID() string {
 
 // ApplicationID parses a Application ID into an ApplicationId struct
 func ApplicationID(input string) (*ApplicationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApplicationID(input string) (*ApplicationId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.IoTAppName, err = id.PopSegment("ioTApps"); err != nil {
+	if resourceId.IoTAppName, err = id.PopSegmentInsensitively("ioTApps"); err != nil {
 		return nil, err
 	}
 
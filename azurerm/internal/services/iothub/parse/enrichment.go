@@ -42,7 +42,7 @@ func (id EnrichmentId) ID() string {
 
 // EnrichmentID parses a Enrichment ID into an EnrichmentId struct
 func EnrichmentID(input string) (*EnrichmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EnrichmentID(input string) (*EnrichmentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.IotHubName, err = id.PopSegment("IotHubs"); err != nil {
+	if resourceId.IotHubName, err = id.PopSegmentInsensitively("IotHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("Enrichments"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("Enrichments"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestEnrichmentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DEVICES/IOTHUBS/HUB1/ENRICHMENTS/ENRICHMENT1",
-			Error: true,
+			Expected: &EnrichmentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				IotHubName:     "HUB1",
+				Name:           "ENRICHMENT1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id IotHubId) ID() string {
 
 // IotHubID parses a IotHub ID into an IotHubId struct
 func IotHubID(input string) (*IotHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func IotHubID(input string) (*IotHubId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("IotHubs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("IotHubs"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id AccessPolicyId) ID() string {
 
 // AccessPolicyID parses a AccessPolicy ID into an AccessPolicyId struct
 func AccessPolicyID(input string) (*AccessPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AccessPolicyID(input string) (*AccessPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+	if resourceId.EnvironmentName, err = id.PopSegmentInsensitively("environments"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("accessPolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("accessPolicies"); err != nil {
 		return nil, err
 	}
 
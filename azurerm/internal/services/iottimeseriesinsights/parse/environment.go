@@ -39,7 +39,7 @@ func (id EnvironmentId) ID() string {
 
 // EnvironmentID parses a Environment ID into an EnvironmentId struct
 func EnvironmentID(input string) (*EnvironmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func EnvironmentID(input string) (*EnvironmentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("environments"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("environments"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id EventSourceId) ID() string {
 
 // EventSourceID parses a EventSource ID into an EventSourceId struct
 func EventSourceID(input string) (*EventSourceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EventSourceID(input string) (*EventSourceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+	if resourceId.EnvironmentName, err = id.PopSegmentInsensitively("environments"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("eventSources"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("eventSources"); err != nil {
 		return nil, err
 	}
 
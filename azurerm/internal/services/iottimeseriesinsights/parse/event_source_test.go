@@ -93,7 +93,12 @@ func TestEventSourceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.TIMESERIESINSIGHTS/ENVIRONMENTS/ENVIRONMENT1/EVENTSOURCES/EVENTSOURCE1",
-			Error: true,
+			Expected: &EventSourceId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:   "RESGROUP1",
+				EnvironmentName: "ENVIRONMENT1",
+				Name:            "EVENTSOURCE1",
+			},
 		},
 	}
 
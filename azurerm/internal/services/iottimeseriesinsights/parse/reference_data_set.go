@@ -42,7 +42,7 @@ func (id ReferenceDataSetId) ID() string {
 
 // ReferenceDataSetID parses a ReferenceDataSet ID into an ReferenceDataSetId struct
 func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ReferenceDataSetID(input string) (*ReferenceDataSetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.EnvironmentName, err = id.PopSegment("environments"); err != nil {
+	if resourceId.EnvironmentName, err = id.PopSegmentInsensitively("environments"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("referenceDataSets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("referenceDataSets"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestReferenceDataSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.TIMESERIESINSIGHTS/ENVIRONMENTS/ENVIRONMENT1/REFERENCEDATASETS/DATASET1",
-			Error: true,
+			Expected: &ReferenceDataSetId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:   "RESGROUP1",
+				EnvironmentName: "ENVIRONMENT1",
+				Name:            "DATASET1",
+			},
 		},
 	}
 
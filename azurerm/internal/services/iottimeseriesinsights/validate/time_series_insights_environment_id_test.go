@@ -21,7 +21,7 @@ func TestTimeSeriesInsightsEnvironmentID(t *testing.T) {
 		},
 		{
 			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myGroup1/providers/Microsoft.TimeSeriesInsights/Environments/Environment1",
-			Valid: false,
+			Valid: true,
 		},
 		{
 			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myGroup1/providers/Microsoft.TimeSeriesInsights/environments/Environment1",
@@ -19,6 +19,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/keyvault/validate"
@@ -68,7 +69,7 @@ func resourceKeyVault() *pluginsdk.Resource {
 					Type:         pluginsdk.TypeString,
 					Required:     true,
 					ForceNew:     true,
-					ValidateFunc: validate.VaultName,
+					ValidateFunc: validation.ResourceName("Microsoft.KeyVault/vaults"),
 				},
 
 				"location": azure.SchemaLocation(),
@@ -239,6 +240,8 @@ func resourceKeyVault() *pluginsdk.Resource {
 
 			return rSchema
 		}(),
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(nameavailability.CustomizeDiff("Key Vault", keyVaultNameAvailability)),
 	}
 }
 
@@ -352,8 +355,11 @@ func resourceKeyVaultCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if _, err := client.CreateOrUpdate(ctx, id.ResourceGroup, id.Name, parameters); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
@@ -495,8 +501,11 @@ func resourceKeyVaultUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 			}
 		}
 
-		locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-		defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+		unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		update.Properties.NetworkAcls = networkAcls
 	}
@@ -750,8 +759,11 @@ func resourceKeyVaultDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	resp, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -1011,3 +1023,42 @@ func getSoftDeletedStateForKeyVault(ctx context.Context, client *keyvault.Vaults
 
 	return &result, nil
 }
+
+func keyVaultNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	vaultsClient := client.KeyVault.VaultsClient
+	resp, err := vaultsClient.CheckNameAvailability(ctx, keyvault.VaultCheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.KeyVault/vaults"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.NameAvailable == nil {
+		return nil, fmt.Errorf("`nameAvailable` was nil")
+	}
+
+	result := nameavailability.Result{
+		Available: *resp.NameAvailable,
+		Reason:    string(resp.Reason),
+		Message:   utils.NormalizeNilableString(resp.Message),
+	}
+
+	// a Soft-Deleted Key Vault within this Subscription is recovered during creation when this is enabled
+	if !result.Available && client.Features.KeyVault.RecoverSoftDeletedKeyVaults {
+		deleted, err := vaultsClient.ListDeletedComplete(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("listing Soft-Deleted Key Vaults: %+v", err)
+		}
+		for deleted.NotDone() {
+			if v := deleted.Value(); v.Name != nil && strings.EqualFold(*v.Name, name) {
+				result.Available = true
+				break
+			}
+			if err := deleted.NextWithContext(ctx); err != nil {
+				return nil, fmt.Errorf("listing Soft-Deleted Key Vaults: %+v", err)
+			}
+		}
+	}
+
+	return &result, nil
+}
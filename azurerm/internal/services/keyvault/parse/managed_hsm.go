@@ -39,7 +39,7 @@ func (id ManagedHSMId) ID() string {
 
 // ManagedHSMID parses a ManagedHSM ID into an ManagedHSMId struct
 func ManagedHSMID(input string) (*ManagedHSMId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ManagedHSMID(input string) (*ManagedHSMId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("managedHSMs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("managedHSMs"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id VaultId) ID() string {
 
 // VaultID parses a Vault ID into an VaultId struct
 func VaultID(input string) (*VaultId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VaultID(input string) (*VaultId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("vaults"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vaults"); err != nil {
 		return nil, err
 	}
 
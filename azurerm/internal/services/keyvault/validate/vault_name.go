@@ -1,15 +0,0 @@
-package validate
-
-import (
-	"fmt"
-	"regexp"
-)
-
-func VaultName(v interface{}, k string) (warnings []string, errors []error) {
-	value := v.(string)
-	if matched := regexp.MustCompile(`^[a-zA-Z0-9-]{3,24}$`).Match([]byte(value)); !matched {
-		errors = append(errors, fmt.Errorf("%q may only contain alphanumeric characters and dashes and must be between 3-24 chars", k))
-	}
-
-	return warnings, errors
-}
@@ -83,7 +83,7 @@ func resourceKustoEventGridDataConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: eventhubValidate.ValidateEventHubConsumerName(),
+				ValidateFunc: validation.ResourceName("Microsoft.EventHub/namespaces/eventhubs/consumerGroups"),
 			},
 
 			"blob_storage_event_type": {
@@ -96,7 +96,7 @@ func resourceKustoEventHubDataConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: eventhubValidate.ValidateEventHubConsumerName(),
+				ValidateFunc: validation.ResourceName("Microsoft.EventHub/namespaces/eventhubs/consumerGroups"),
 			},
 
 			"table_name": {
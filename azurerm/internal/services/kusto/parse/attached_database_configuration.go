@@ -42,7 +42,7 @@ func (id AttachedDatabaseConfigurationId) ID() string {
 
 // AttachedDatabaseConfigurationID parses a AttachedDatabaseConfiguration ID into an AttachedDatabaseConfigurationId struct
 func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AttachedDatabaseConfigurationID(input string) (*AttachedDatabaseConfigurati
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("AttachedDatabaseConfigurations"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("AttachedDatabaseConfigurations"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestAttachedDatabaseConfigurationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/CLUSTER1/ATTACHEDDATABASECONFIGURATIONS/CONFIG1",
-			Error: true,
+			Expected: &AttachedDatabaseConfigurationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ClusterName:    "CLUSTER1",
+				Name:           "CONFIG1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ClusterId) ID() string {
 
 // ClusterID parses a Cluster ID into an ClusterId struct
 func ClusterID(input string) (*ClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ClusterID(input string) (*ClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id ClusterPrincipalAssignmentId) ID() string {
 
 // ClusterPrincipalAssignmentID parses a ClusterPrincipalAssignment ID into an ClusterPrincipalAssignmentId struct
 func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ClusterPrincipalAssignmentID(input string) (*ClusterPrincipalAssignmentId,
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
+	if resourceId.PrincipalAssignmentName, err = id.PopSegmentInsensitively("PrincipalAssignments"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id DataConnectionId) ID() string {
 
 // DataConnectionID parses a DataConnection ID into an DataConnectionId struct
 func DataConnectionID(input string) (*DataConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func DataConnectionID(input string) (*DataConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("Databases"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("DataConnections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("DataConnections"); err != nil {
 		return nil, err
 	}
 
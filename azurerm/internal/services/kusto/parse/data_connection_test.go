@@ -106,7 +106,13 @@ func TestDataConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/CLUSTER1/DATABASES/DATABASE1/DATACONNECTIONS/CONNECTION1",
-			Error: true,
+			Expected: &DataConnectionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ClusterName:    "CLUSTER1",
+				DatabaseName:   "DATABASE1",
+				Name:           "CONNECTION1",
+			},
 		},
 	}
 
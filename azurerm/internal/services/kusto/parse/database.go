@@ -42,7 +42,7 @@ func (id DatabaseId) ID() string {
 
 // DatabaseID parses a Database ID into an DatabaseId struct
 func DatabaseID(input string) (*DatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DatabaseID(input string) (*DatabaseId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("Databases"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("Databases"); err != nil {
 		return nil, err
 	}
 
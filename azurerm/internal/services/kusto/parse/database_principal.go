@@ -48,7 +48,7 @@ func (id DatabasePrincipalId) ID() string {
 
 // DatabasePrincipalID parses a DatabasePrincipal ID into an DatabasePrincipalId struct
 func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +66,16 @@ func DatabasePrincipalID(input string) (*DatabasePrincipalId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("Databases"); err != nil {
 		return nil, err
 	}
-	if resourceId.RoleName, err = id.PopSegment("Role"); err != nil {
+	if resourceId.RoleName, err = id.PopSegmentInsensitively("Role"); err != nil {
 		return nil, err
 	}
-	if resourceId.FQNName, err = id.PopSegment("FQN"); err != nil {
+	if resourceId.FQNName, err = id.PopSegmentInsensitively("FQN"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id DatabasePrincipalAssignmentId) ID() string {
 
 // DatabasePrincipalAssignmentID parses a DatabasePrincipalAssignment ID into an DatabasePrincipalAssignmentId struct
 func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func DatabasePrincipalAssignmentID(input string) (*DatabasePrincipalAssignmentId
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("Clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("Clusters"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("Databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("Databases"); err != nil {
 		return nil, err
 	}
-	if resourceId.PrincipalAssignmentName, err = id.PopSegment("PrincipalAssignments"); err != nil {
+	if resourceId.PrincipalAssignmentName, err = id.PopSegmentInsensitively("PrincipalAssignments"); err != nil {
 		return nil, err
 	}
 
@@ -119,7 +119,14 @@ func TestDatabasePrincipalID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/CLUSTER1/DATABASES/DATABASE1/ROLE/VIEWER/FQN/AADUSER=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
-			Error: true,
+			Expected: &DatabasePrincipalId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ClusterName:    "CLUSTER1",
+				DatabaseName:   "DATABASE1",
+				RoleName:       "VIEWER",
+				FQNName:        "AADUSER=11111111-1111-1111-1111-111111111111;22222222-2222-2222-2222-222222222222",
+			},
 		},
 	}
 
@@ -85,7 +85,7 @@ func TestDatabasePrincipalAssignmentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.KUSTO/CLUSTERS/CLUSTER1/DATABASES/DATABASE1/PRINCIPALASSIGNMENTS/ASSIGNMENT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
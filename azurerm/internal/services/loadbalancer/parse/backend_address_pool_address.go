@@ -45,7 +45,7 @@ func (id BackendAddressPoolAddressId) ID() string {
 
 // BackendAddressPoolAddressID parses a BackendAddressPoolAddress ID into an BackendAddressPoolAddressId struct
 func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func BackendAddressPoolAddressID(input string) (*BackendAddressPoolAddressId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
+	if resourceId.BackendAddressPoolName, err = id.PopSegmentInsensitively("backendAddressPools"); err != nil {
 		return nil, err
 	}
-	if resourceId.AddressName, err = id.PopSegment("addresses"); err != nil {
+	if resourceId.AddressName, err = id.PopSegmentInsensitively("addresses"); err != nil {
 		return nil, err
 	}
 
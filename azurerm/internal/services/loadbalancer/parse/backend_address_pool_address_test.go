@@ -106,7 +106,13 @@ func TestBackendAddressPoolAddressID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCER1/BACKENDADDRESSPOOLS/BACKENDADDRESSPOOL1/ADDRESSES/ADDRESS1",
-			Error: true,
+			Expected: &BackendAddressPoolAddressId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "RESGROUP1",
+				LoadBalancerName:       "LOADBALANCER1",
+				BackendAddressPoolName: "BACKENDADDRESSPOOL1",
+				AddressName:            "ADDRESS1",
+			},
 		},
 	}
 
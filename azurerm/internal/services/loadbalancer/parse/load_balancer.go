@@ -39,7 +39,7 @@ func (id LoadBalancerId) ID() string {
 
 // LoadBalancerID parses a LoadBalancer ID into an LoadBalancerId struct
 func LoadBalancerID(input string) (*LoadBalancerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func LoadBalancerID(input string) (*LoadBalancerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
 
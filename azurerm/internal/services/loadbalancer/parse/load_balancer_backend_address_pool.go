@@ -42,7 +42,7 @@ func (id LoadBalancerBackendAddressPoolId) ID() string {
 
 // LoadBalancerBackendAddressPoolID parses a LoadBalancerBackendAddressPool ID into an LoadBalancerBackendAddressPoolId struct
 func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddressPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerBackendAddressPoolID(input string) (*LoadBalancerBackendAddress
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.BackendAddressPoolName, err = id.PopSegment("backendAddressPools"); err != nil {
+	if resourceId.BackendAddressPoolName, err = id.PopSegmentInsensitively("backendAddressPools"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id LoadBalancerFrontendIpConfigurationId) ID() string {
 
 // LoadBalancerFrontendIpConfigurationID parses a LoadBalancerFrontendIpConfiguration ID into an LoadBalancerFrontendIpConfigurationId struct
 func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerFrontendIpConfigurationID(input string) (*LoadBalancerFrontendI
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.FrontendIPConfigurationName, err = id.PopSegment("frontendIPConfigurations"); err != nil {
+	if resourceId.FrontendIPConfigurationName, err = id.PopSegmentInsensitively("frontendIPConfigurations"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id LoadBalancerInboundNatPoolId) ID() string {
 
 // LoadBalancerInboundNatPoolID parses a LoadBalancerInboundNatPool ID into an LoadBalancerInboundNatPoolId struct
 func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerInboundNatPoolID(input string) (*LoadBalancerInboundNatPoolId,
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.InboundNatPoolName, err = id.PopSegment("inboundNatPools"); err != nil {
+	if resourceId.InboundNatPoolName, err = id.PopSegmentInsensitively("inboundNatPools"); err != nil {
 		return nil, err
 	}
 
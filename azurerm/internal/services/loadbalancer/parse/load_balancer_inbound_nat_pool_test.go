@@ -93,7 +93,12 @@ func TestLoadBalancerInboundNatPoolID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCER1/INBOUNDNATPOOLS/POOL1",
-			Error: true,
+			Expected: &LoadBalancerInboundNatPoolId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				LoadBalancerName:   "LOADBALANCER1",
+				InboundNatPoolName: "POOL1",
+			},
 		},
 	}
 
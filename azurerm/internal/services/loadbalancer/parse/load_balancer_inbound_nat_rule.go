@@ -42,7 +42,7 @@ func (id LoadBalancerInboundNatRuleId) ID() string {
 
 // LoadBalancerInboundNatRuleID parses a LoadBalancerInboundNatRule ID into an LoadBalancerInboundNatRuleId struct
 func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerInboundNatRuleID(input string) (*LoadBalancerInboundNatRuleId,
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.InboundNatRuleName, err = id.PopSegment("inboundNatRules"); err != nil {
+	if resourceId.InboundNatRuleName, err = id.PopSegmentInsensitively("inboundNatRules"); err != nil {
 		return nil, err
 	}
 
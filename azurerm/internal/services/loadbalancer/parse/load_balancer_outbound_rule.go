@@ -42,7 +42,7 @@ func (id LoadBalancerOutboundRuleId) ID() string {
 
 // LoadBalancerOutboundRuleID parses a LoadBalancerOutboundRule ID into an LoadBalancerOutboundRuleId struct
 func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerOutboundRuleID(input string) (*LoadBalancerOutboundRuleId, erro
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.OutboundRuleName, err = id.PopSegment("outboundRules"); err != nil {
+	if resourceId.OutboundRuleName, err = id.PopSegmentInsensitively("outboundRules"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestLoadBalancerOutboundRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCER1/OUTBOUNDRULES/RULE1",
-			Error: true,
+			Expected: &LoadBalancerOutboundRuleId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				LoadBalancerName: "LOADBALANCER1",
+				OutboundRuleName: "RULE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id LoadBalancerProbeId) ID() string {
 
 // LoadBalancerProbeID parses a LoadBalancerProbe ID into an LoadBalancerProbeId struct
 func LoadBalancerProbeID(input string) (*LoadBalancerProbeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancerProbeID(input string) (*LoadBalancerProbeId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.ProbeName, err = id.PopSegment("probes"); err != nil {
+	if resourceId.ProbeName, err = id.PopSegmentInsensitively("probes"); err != nil {
 		return nil, err
 	}
 
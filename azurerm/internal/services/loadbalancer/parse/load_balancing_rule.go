@@ -42,7 +42,7 @@ func (id LoadBalancingRuleId) ID() string {
 
 // LoadBalancingRuleID parses a LoadBalancingRule ID into an LoadBalancingRuleId struct
 func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LoadBalancingRuleID(input string) (*LoadBalancingRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.LoadBalancerName, err = id.PopSegment("loadBalancers"); err != nil {
+	if resourceId.LoadBalancerName, err = id.PopSegmentInsensitively("loadBalancers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("loadBalancingRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("loadBalancingRules"); err != nil {
 		return nil, err
 	}
 
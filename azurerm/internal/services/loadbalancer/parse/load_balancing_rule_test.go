@@ -93,7 +93,12 @@ func TestLoadBalancingRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCER1/LOADBALANCINGRULES/RULE1",
-			Error: true,
+			Expected: &LoadBalancingRuleId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				LoadBalancerName: "LOADBALANCER1",
+				Name:             "RULE1",
+			},
 		},
 	}
 
@@ -85,7 +85,7 @@ func TestBackendAddressPoolAddressID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/LOADBALANCERS/LOADBALANCER1/BACKENDADDRESSPOOLS/BACKENDADDRESSPOOL1/ADDRESSES/ADDRESS1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -49,7 +49,7 @@ func resourceLogAnalyticsLinkedService() *pluginsdk.Resource {
 				Computed:         true,
 				Optional:         true,
 				DiffSuppressFunc: suppress.CaseDifference,
-				ValidateFunc:     validate.LogAnalyticsWorkspaceName,
+				ValidateFunc:     validation.ResourceName("Microsoft.OperationalInsights/workspaces"),
 				ExactlyOneOf:     []string{"workspace_name", "workspace_id"},
 				Deprecated:       "This field has been deprecated in favour of `workspace_id` and will be removed in a future version of the provider",
 			},
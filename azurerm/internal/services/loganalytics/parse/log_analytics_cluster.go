@@ -39,7 +39,7 @@ func (id LogAnalyticsClusterId) ID() string {
 
 // LogAnalyticsClusterID parses a LogAnalyticsCluster ID into an LogAnalyticsClusterId struct
 func LogAnalyticsClusterID(input string) (*LogAnalyticsClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func LogAnalyticsClusterID(input string) (*LogAnalyticsClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ClusterName, err = id.PopSegment("clusters"); err != nil {
+	if resourceId.ClusterName, err = id.PopSegmentInsensitively("clusters"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestLogAnalyticsClusterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/CLUSTERS/CLUSTER1",
-			Error: true,
+			Expected: &LogAnalyticsClusterId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ClusterName:    "CLUSTER1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id LogAnalyticsDataExportId) ID() string {
 
 // LogAnalyticsDataExportID parses a LogAnalyticsDataExport ID into an LogAnalyticsDataExportId struct
 func LogAnalyticsDataExportID(input string) (*LogAnalyticsDataExportId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LogAnalyticsDataExportID(input string) (*LogAnalyticsDataExportId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.DataexportName, err = id.PopSegment("dataexports"); err != nil {
+	if resourceId.DataexportName, err = id.PopSegmentInsensitively("dataexports"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id LogAnalyticsDataSourceWindowsEventId) ID() string {
 
 // LogAnalyticsDataSourceWindowsEventID parses a LogAnalyticsDataSourceWindowsEvent ID into an LogAnalyticsDataSourceWindowsEventId struct
 func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSourceWindowsEventId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LogAnalyticsDataSourceWindowsEventID(input string) (*LogAnalyticsDataSource
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.DataSourceName, err = id.PopSegment("dataSources"); err != nil {
+	if resourceId.DataSourceName, err = id.PopSegmentInsensitively("dataSources"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestLogAnalyticsDataSourceWindowsEventID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/WORKSPACE1/DATASOURCES/DATASOURCE1",
-			Error: true,
+			Expected: &LogAnalyticsDataSourceWindowsEventId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				WorkspaceName:  "WORKSPACE1",
+				DataSourceName: "DATASOURCE1",
+			},
 		},
 	}
 
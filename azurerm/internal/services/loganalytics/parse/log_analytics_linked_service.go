@@ -42,7 +42,7 @@ func (id LogAnalyticsLinkedServiceId) ID() string {
 
 // LogAnalyticsLinkedServiceID parses a LogAnalyticsLinkedService ID into an LogAnalyticsLinkedServiceId struct
 func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LogAnalyticsLinkedServiceID(input string) (*LogAnalyticsLinkedServiceId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.LinkedServiceName, err = id.PopSegment("linkedServices"); err != nil {
+	if resourceId.LinkedServiceName, err = id.PopSegmentInsensitively("linkedServices"); err != nil {
 		return nil, err
 	}
 
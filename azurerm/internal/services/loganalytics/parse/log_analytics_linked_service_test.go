@@ -93,7 +93,12 @@ func TestLogAnalyticsLinkedServiceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/WORKSPACE1/LINKEDSERVICES/LINKEDSERVICE1",
-			Error: true,
+			Expected: &LogAnalyticsLinkedServiceId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				WorkspaceName:     "WORKSPACE1",
+				LinkedServiceName: "LINKEDSERVICE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id LogAnalyticsLinkedStorageAccountId) ID() string {
 
 // LogAnalyticsLinkedStorageAccountID parses a LogAnalyticsLinkedStorageAccount ID into an LogAnalyticsLinkedStorageAccountId struct
 func LogAnalyticsLinkedStorageAccountID(input string) (*LogAnalyticsLinkedStorageAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LogAnalyticsLinkedStorageAccountID(input string) (*LogAnalyticsLinkedStorag
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.LinkedStorageAccountName, err = id.PopSegment("linkedStorageAccounts"); err != nil {
+	if resourceId.LinkedStorageAccountName, err = id.PopSegmentInsensitively("linkedStorageAccounts"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id LogAnalyticsSavedSearchId) ID() string {
 
 // LogAnalyticsSavedSearchID parses a LogAnalyticsSavedSearch ID into an LogAnalyticsSavedSearchId struct
 func LogAnalyticsSavedSearchID(input string) (*LogAnalyticsSavedSearchId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LogAnalyticsSavedSearchID(input string) (*LogAnalyticsSavedSearchId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.SavedSearcheName, err = id.PopSegment("savedSearches"); err != nil {
+	if resourceId.SavedSearcheName, err = id.PopSegmentInsensitively("savedSearches"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id LogAnalyticsSolutionId) ID() string {
 
 // LogAnalyticsSolutionID parses a LogAnalyticsSolution ID into an LogAnalyticsSolutionId struct
 func LogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func LogAnalyticsSolutionID(input string) (*LogAnalyticsSolutionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SolutionName, err = id.PopSegment("solutions"); err != nil {
+	if resourceId.SolutionName, err = id.PopSegmentInsensitively("solutions"); err != nil {
 		return nil, err
 	}
 
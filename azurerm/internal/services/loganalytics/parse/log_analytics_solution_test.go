@@ -80,7 +80,11 @@ func TestLogAnalyticsSolutionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONSMANAGEMENT/SOLUTIONS/SOLUTION1",
-			Error: true,
+			Expected: &LogAnalyticsSolutionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SolutionName:   "SOLUTION1",
+			},
 		},
 	}
 
@@ -80,7 +80,11 @@ func TestLogAnalyticsWorkspaceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/WORKSPACE1",
-			Error: true,
+			Expected: &LogAnalyticsWorkspaceId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				WorkspaceName:  "WORKSPACE1",
+			},
 		},
 	}
 
@@ -73,7 +73,7 @@ func TestLogAnalyticsLinkedStorageAccountID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.OPERATIONALINSIGHTS/WORKSPACES/WORKSPACE1/LINKEDSTORAGEACCOUNTS/QUERY",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
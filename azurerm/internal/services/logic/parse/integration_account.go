@@ -39,7 +39,7 @@ func (id IntegrationAccountId) ID() string {
 
 // IntegrationAccountID parses a IntegrationAccount ID into an IntegrationAccountId struct
 func IntegrationAccountID(input string) (*IntegrationAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func IntegrationAccountID(input string) (*IntegrationAccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("integrationAccounts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("integrationAccounts"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id IntegrationServiceEnvironmentId) ID() string {
 
 // IntegrationServiceEnvironmentID parses a IntegrationServiceEnvironment ID into an IntegrationServiceEnvironmentId struct
 func IntegrationServiceEnvironmentID(input string) (*IntegrationServiceEnvironmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func IntegrationServiceEnvironmentID(input string) (*IntegrationServiceEnvironme
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("integrationServiceEnvironments"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("integrationServiceEnvironments"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id InferenceClusterId) ID() string {
 
 // InferenceClusterID parses a InferenceCluster ID into an InferenceClusterId struct
 func InferenceClusterID(input string) (*InferenceClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func InferenceClusterID(input string) (*InferenceClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.ComputeName, err = id.PopSegment("computes"); err != nil {
+	if resourceId.ComputeName, err = id.PopSegmentInsensitively("computes"); err != nil {
 		return nil, err
 	}
 
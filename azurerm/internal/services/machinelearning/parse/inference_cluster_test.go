@@ -93,7 +93,12 @@ func TestInferenceClusterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/WORKSPACE1/COMPUTES/CLUSTER1",
-			Error: true,
+			Expected: &InferenceClusterId{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "RESGROUP1",
+				WorkspaceName:  "WORKSPACE1",
+				ComputeName:    "CLUSTER1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id KubernetesClusterId) ID() string {
 
 // KubernetesClusterID parses a KubernetesCluster ID into an KubernetesClusterId struct
 func KubernetesClusterID(input string) (*KubernetesClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func KubernetesClusterID(input string) (*KubernetesClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ManagedClusterName, err = id.PopSegment("managedClusters"); err != nil {
+	if resourceId.ManagedClusterName, err = id.PopSegmentInsensitively("managedClusters"); err != nil {
 		return nil, err
 	}
 
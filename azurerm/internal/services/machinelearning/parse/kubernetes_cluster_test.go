@@ -80,7 +80,11 @@ func TestKubernetesClusterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CONTAINERSERVICE/MANAGEDCLUSTERS/CLUSTER1",
-			Error: true,
+			Expected: &KubernetesClusterId{
+				SubscriptionId:     "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:      "RESGROUP1",
+				ManagedClusterName: "CLUSTER1",
+			},
 		},
 	}
 
@@ -80,7 +80,11 @@ func TestWorkspaceID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/00000000-0000-0000-0000-000000000000/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MACHINELEARNINGSERVICES/WORKSPACES/WORKSPACE1",
-			Error: true,
+			Expected: &WorkspaceId{
+				SubscriptionId: "00000000-0000-0000-0000-000000000000",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "WORKSPACE1",
+			},
 		},
 	}
 
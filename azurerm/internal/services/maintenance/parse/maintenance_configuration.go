@@ -39,7 +39,7 @@ func (id MaintenanceConfigurationId) ID() string {
 
 // MaintenanceConfigurationID parses a MaintenanceConfiguration ID into an MaintenanceConfigurationId struct
 func MaintenanceConfigurationID(input string) (*MaintenanceConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func MaintenanceConfigurationID(input string) (*MaintenanceConfigurationId, erro
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("maintenanceConfigurations"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("maintenanceConfigurations"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestMaintenanceConfigurationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MAINTENANCE/MAINTENANCECONFIGURATIONS/MAINTENANCECONFIGURATION1",
-			Error: true,
+			Expected: &MaintenanceConfigurationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "MAINTENANCECONFIGURATION1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ApplicationDefinitionId) ID() string {
 
 // ApplicationDefinitionID parses a ApplicationDefinition ID into an ApplicationDefinitionId struct
 func ApplicationDefinitionID(input string) (*ApplicationDefinitionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApplicationDefinitionID(input string) (*ApplicationDefinitionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("applicationDefinitions"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("applicationDefinitions"); err != nil {
 		return nil, err
 	}
 
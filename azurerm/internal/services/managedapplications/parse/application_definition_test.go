@@ -80,7 +80,11 @@ func TestApplicationDefinitionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SOLUTIONS/APPLICATIONDEFINITIONS/DEFINITION1",
-			Error: true,
+			Expected: &ApplicationDefinitionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "DEFINITION1",
+			},
 		},
 	}
 
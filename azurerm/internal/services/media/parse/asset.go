@@ -42,7 +42,7 @@ func (id AssetId) ID() string {
 
 // AssetID parses a Asset ID into an AssetId struct
 func AssetID(input string) (*AssetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AssetID(input string) (*AssetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("assets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("assets"); err != nil {
 		return nil, err
 	}
 
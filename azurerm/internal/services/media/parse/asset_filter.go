@@ -45,7 +45,7 @@ func (id AssetFilterId) ID() string {
 
 // AssetFilterID parses a AssetFilter ID into an AssetFilterId struct
 func AssetFilterID(input string) (*AssetFilterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func AssetFilterID(input string) (*AssetFilterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.AssetName, err = id.PopSegment("assets"); err != nil {
+	if resourceId.AssetName, err = id.PopSegmentInsensitively("assets"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("assetFilters"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("assetFilters"); err != nil {
 		return nil, err
 	}
 
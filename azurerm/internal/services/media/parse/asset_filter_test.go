@@ -106,7 +106,13 @@ func TestAssetFilterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/ASSETS/ASSET1/ASSETFILTERS/FILTER1",
-			Error: true,
+			Expected: &AssetFilterId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				MediaserviceName: "ACCOUNT1",
+				AssetName:        "ASSET1",
+				Name:             "FILTER1",
+			},
 		},
 	}
 
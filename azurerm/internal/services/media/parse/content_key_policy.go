@@ -42,7 +42,7 @@ func (id ContentKeyPolicyId) ID() string {
 
 // ContentKeyPolicyID parses a ContentKeyPolicy ID into an ContentKeyPolicyId struct
 func ContentKeyPolicyID(input string) (*ContentKeyPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ContentKeyPolicyID(input string) (*ContentKeyPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("contentkeypolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("contentkeypolicies"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id JobId) ID() string {
 
 // JobID parses a Job ID into an JobId struct
 func JobID(input string) (*JobId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func JobID(input string) (*JobId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.TransformName, err = id.PopSegment("transforms"); err != nil {
+	if resourceId.TransformName, err = id.PopSegmentInsensitively("transforms"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("jobs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("jobs"); err != nil {
 		return nil, err
 	}
 
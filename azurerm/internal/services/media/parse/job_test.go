@@ -106,7 +106,13 @@ func TestJobID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/TRANSFORMS/TRANSFORM1/JOBS/JOB1",
-			Error: true,
+			Expected: &JobId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				MediaserviceName: "ACCOUNT1",
+				TransformName:    "TRANSFORM1",
+				Name:             "JOB1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id LiveEventId) ID() string {
 
 // LiveEventID parses a LiveEvent ID into an LiveEventId struct
 func LiveEventID(input string) (*LiveEventId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LiveEventID(input string) (*LiveEventId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("liveevents"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("liveevents"); err != nil {
 		return nil, err
 	}
 
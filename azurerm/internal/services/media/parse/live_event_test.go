@@ -93,7 +93,12 @@ func TestLiveEventID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/LIVEEVENTS/EVENT1",
-			Error: true,
+			Expected: &LiveEventId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				MediaserviceName: "ACCOUNT1",
+				Name:             "EVENT1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id LiveOutputId) ID() string {
 
 // LiveOutputID parses a LiveOutput ID into an LiveOutputId struct
 func LiveOutputID(input string) (*LiveOutputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func LiveOutputID(input string) (*LiveOutputId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.LiveeventName, err = id.PopSegment("liveevents"); err != nil {
+	if resourceId.LiveeventName, err = id.PopSegmentInsensitively("liveevents"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("liveoutputs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("liveoutputs"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestLiveOutputID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/LIVEEVENTS/EVENT1/LIVEOUTPUTS/OUTPUT1",
-			Error: true,
+			Expected: &LiveOutputId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				MediaserviceName: "ACCOUNT1",
+				LiveeventName:    "EVENT1",
+				Name:             "OUTPUT1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id MediaServiceId) ID() string {
 
 // MediaServiceID parses a MediaService ID into an MediaServiceId struct
 func MediaServiceID(input string) (*MediaServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func MediaServiceID(input string) (*MediaServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id StreamingEndpointId) ID() string {
 
 // StreamingEndpointID parses a StreamingEndpoint ID into an StreamingEndpointId struct
 func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StreamingEndpointID(input string) (*StreamingEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("streamingendpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("streamingendpoints"); err != nil {
 		return nil, err
 	}
 
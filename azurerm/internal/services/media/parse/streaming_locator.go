@@ -42,7 +42,7 @@ func (id StreamingLocatorId) ID() string {
 
 // StreamingLocatorID parses a StreamingLocator ID into an StreamingLocatorId struct
 func StreamingLocatorID(input string) (*StreamingLocatorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StreamingLocatorID(input string) (*StreamingLocatorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("streaminglocators"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("streaminglocators"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestStreamingLocatorID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/STREAMINGLOCATORS/LOCATOR1",
-			Error: true,
+			Expected: &StreamingLocatorId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				MediaserviceName: "ACCOUNT1",
+				Name:             "LOCATOR1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id StreamingPolicyId) ID() string {
 
 // StreamingPolicyID parses a StreamingPolicy ID into an StreamingPolicyId struct
 func StreamingPolicyID(input string) (*StreamingPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StreamingPolicyID(input string) (*StreamingPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("streamingpolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("streamingpolicies"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id TransformId) ID() string {
 
 // TransformID parses a Transform ID into an TransformId struct
 func TransformID(input string) (*TransformId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func TransformID(input string) (*TransformId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.MediaserviceName, err = id.PopSegment("mediaservices"); err != nil {
+	if resourceId.MediaserviceName, err = id.PopSegmentInsensitively("mediaservices"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("transforms"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("transforms"); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +85,7 @@ func TestLiveOutputID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MEDIA/MEDIASERVICES/ACCOUNT1/LIVEEVENTS/EVENT1/LIVEOUTPUTS/OUTPUT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
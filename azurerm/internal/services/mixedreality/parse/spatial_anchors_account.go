@@ -39,7 +39,7 @@ func (id SpatialAnchorsAccountId) ID() string {
 
 // SpatialAnchorsAccountID parses a SpatialAnchorsAccount ID into an SpatialAnchorsAccountId struct
 func SpatialAnchorsAccountID(input string) (*SpatialAnchorsAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SpatialAnchorsAccountID(input string) (*SpatialAnchorsAccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("spatialAnchorsAccounts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("spatialAnchorsAccounts"); err != nil {
 		return nil, err
 	}
 
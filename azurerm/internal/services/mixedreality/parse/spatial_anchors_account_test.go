@@ -80,7 +80,11 @@ func TestSpatialAnchorsAccountID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.MIXEDREALITY/SPATIALANCHORSACCOUNTS/ACCOUNT1",
-			Error: true,
+			Expected: &SpatialAnchorsAccountId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "ACCOUNT1",
+			},
 		},
 	}
 
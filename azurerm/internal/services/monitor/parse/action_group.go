@@ -39,7 +39,7 @@ func (id ActionGroupId) ID() string {
 
 // ActionGroupID parses a ActionGroup ID into an ActionGroupId struct
 func ActionGroupID(input string) (*ActionGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ActionGroupID(input string) (*ActionGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("actionGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("actionGroups"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id ActionRuleId) ID() string {
 
 // ActionRuleID parses a ActionRule ID into an ActionRuleId struct
 func ActionRuleID(input string) (*ActionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ActionRuleID(input string) (*ActionRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("actionRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("actionRules"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestActionRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.ALERTSMANAGEMENT/ACTIONRULES/ACTIONRULE1",
-			Error: true,
+			Expected: &ActionRuleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "ACTIONRULE1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id SmartDetectorAlertRuleId) ID() string {
 
 // SmartDetectorAlertRuleID parses a SmartDetectorAlertRule ID into an SmartDetectorAlertRuleId struct
 func SmartDetectorAlertRuleID(input string) (*SmartDetectorAlertRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SmartDetectorAlertRuleID(input string) (*SmartDetectorAlertRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("smartdetectoralertrules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("smartdetectoralertrules"); err != nil {
 		return nil, err
 	}
 
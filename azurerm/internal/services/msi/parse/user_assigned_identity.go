@@ -39,7 +39,7 @@ func (id UserAssignedIdentityId) ID() string {
 
 // UserAssignedIdentityID parses a UserAssignedIdentity ID into an UserAssignedIdentityId struct
 func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func UserAssignedIdentityID(input string) (*UserAssignedIdentityId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("userAssignedIdentities"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("userAssignedIdentities"); err != nil {
 		return nil, err
 	}
 
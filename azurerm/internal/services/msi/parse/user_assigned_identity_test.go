@@ -80,7 +80,11 @@ func TestUserAssignedIdentityID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.MANAGEDIDENTITY/USERASSIGNEDIDENTITIES/IDENTITY1",
-			Error: true,
+			Expected: &UserAssignedIdentityId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "IDENTITY1",
+			},
 		},
 	}
 
@@ -47,7 +47,7 @@ func resourceArmUserAssignedIdentity() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringLenBetween(3, 128),
+				ValidateFunc: validation.ResourceName("Microsoft.ManagedIdentity/userAssignedIdentities"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
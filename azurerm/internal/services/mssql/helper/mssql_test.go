@@ -6,67 +6,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
 )
 
-// Your server name can contain only lowercase letters, numbers, and '-', but can't start or end with '-' or have more than 63 characters.
-func TestValidateMsSqlServerName(t *testing.T) {
-	cases := []struct {
-		Value  string
-		Errors bool
-	}{
-		{
-			Value:  "",
-			Errors: true,
-		},
-		{
-			Value:  "k",
-			Errors: false,
-		},
-		{
-			Value:  "K",
-			Errors: true,
-		},
-		{
-			Value:  "k-",
-			Errors: true,
-		},
-		{
-			Value:  "k-t",
-			Errors: false,
-		},
-		{
-			Value:  "K-T",
-			Errors: true,
-		},
-		{
-			Value:  "validname",
-			Errors: false,
-		},
-		{
-			Value:  "invalid_name",
-			Errors: true,
-		},
-		{
-			Value:  "123456789112345678921234567893123456789412345678951234567896123",
-			Errors: false,
-		},
-		{
-			Value:  "01234567891123456789212345678931234567894123456789512345678961234",
-			Errors: true,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := validate.ValidateMsSqlServerName(tc.Value, "name")
-
-		if len(errors) > 0 != tc.Errors {
-			if tc.Errors {
-				t.Fatalf("Expected ValidateMsSqlServerName to have errors for '%s', got %d ", tc.Value, len(errors))
-			} else {
-				t.Fatalf("Expected ValidateMsSqlServerName to not have errors for '%s', got %d ", tc.Value, len(errors))
-			}
-		}
-	}
-}
-
 // Your database name can't end with '.' or ' ', can't contain '<,>,*,%,&,:,\,/,?' or control characters, and can't have more than 128 characters.
 func TestValidateMsSqlDatabaseName(t *testing.T) {
 	cases := []struct {
@@ -57,7 +57,7 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.ValidateMsSqlServerName,
+				ValidateFunc: validation.ResourceName("Microsoft.Sql/servers"),
 			},
 
 			"sku": {
@@ -42,7 +42,7 @@ func (id DatabaseId) ID() string {
 
 // DatabaseID parses a Database ID into an DatabaseId struct
 func DatabaseID(input string) (*DatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DatabaseID(input string) (*DatabaseId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("databases"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("databases"); err != nil {
 		return nil, err
 	}
 
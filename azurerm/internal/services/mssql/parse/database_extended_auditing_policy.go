@@ -45,7 +45,7 @@ func (id DatabaseExtendedAuditingPolicyId) ID() string {
 
 // DatabaseExtendedAuditingPolicyID parses a DatabaseExtendedAuditingPolicy ID into an DatabaseExtendedAuditingPolicyId struct
 func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func DatabaseExtendedAuditingPolicyID(input string) (*DatabaseExtendedAuditingPo
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
 		return nil, err
 	}
-	if resourceId.ExtendedAuditingSettingName, err = id.PopSegment("extendedAuditingSettings"); err != nil {
+	if resourceId.ExtendedAuditingSettingName, err = id.PopSegmentInsensitively("extendedAuditingSettings"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestDatabaseExtendedAuditingPolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/DATABASES/DATABASE1/EXTENDEDAUDITINGSETTINGS/DEFAULT",
-			Error: true,
+			Expected: &DatabaseExtendedAuditingPolicyId{
+				SubscriptionId:              "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:               "GROUP1",
+				ServerName:                  "SERVER1",
+				DatabaseName:                "DATABASE1",
+				ExtendedAuditingSettingName: "DEFAULT",
+			},
 		},
 	}
 
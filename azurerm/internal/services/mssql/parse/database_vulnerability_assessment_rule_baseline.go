@@ -51,7 +51,7 @@ func (id DatabaseVulnerabilityAssessmentRuleBaselineId) ID() string {
 
 // DatabaseVulnerabilityAssessmentRuleBaselineID parses a DatabaseVulnerabilityAssessmentRuleBaseline ID into an DatabaseVulnerabilityAssessmentRuleBaselineId struct
 func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulnerabilityAssessmentRuleBaselineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -69,19 +69,19 @@ func DatabaseVulnerabilityAssessmentRuleBaselineID(input string) (*DatabaseVulne
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
 		return nil, err
 	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
+	if resourceId.VulnerabilityAssessmentName, err = id.PopSegmentInsensitively("vulnerabilityAssessments"); err != nil {
 		return nil, err
 	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
+	if resourceId.RuleName, err = id.PopSegmentInsensitively("rules"); err != nil {
 		return nil, err
 	}
-	if resourceId.BaselineName, err = id.PopSegment("baselines"); err != nil {
+	if resourceId.BaselineName, err = id.PopSegmentInsensitively("baselines"); err != nil {
 		return nil, err
 	}
 
@@ -132,7 +132,15 @@ func TestDatabaseVulnerabilityAssessmentRuleBaselineID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/DATABASES/DATABASE1/VULNERABILITYASSESSMENTS/DEFAULT/RULES/RULE1/BASELINES/BASELINE1",
-			Error: true,
+			Expected: &DatabaseVulnerabilityAssessmentRuleBaselineId{
+				SubscriptionId:              "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:               "GROUP1",
+				ServerName:                  "SERVER1",
+				DatabaseName:                "DATABASE1",
+				VulnerabilityAssessmentName: "DEFAULT",
+				RuleName:                    "RULE1",
+				BaselineName:                "BASELINE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ElasticPoolId) ID() string {
 
 // ElasticPoolID parses a ElasticPool ID into an ElasticPoolId struct
 func ElasticPoolID(input string) (*ElasticPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ElasticPoolID(input string) (*ElasticPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("elasticPools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("elasticPools"); err != nil {
 		return nil, err
 	}
 
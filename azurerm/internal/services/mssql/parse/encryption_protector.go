@@ -42,7 +42,7 @@ func (id EncryptionProtectorId) ID() string {
 
 // EncryptionProtectorID parses a EncryptionProtector ID into an EncryptionProtectorId struct
 func EncryptionProtectorID(input string) (*EncryptionProtectorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EncryptionProtectorID(input string) (*EncryptionProtectorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.EncryptionProtectorName, err = id.PopSegment("encryptionProtector"); err != nil {
+	if resourceId.EncryptionProtectorName, err = id.PopSegmentInsensitively("encryptionProtector"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestFirewallRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/FIREWALLRULES/RULE1",
-			Error: true,
+			Expected: &FirewallRuleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				ServerName:     "SERVER1",
+				Name:           "RULE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id JobAgentId) ID() string {
 
 // JobAgentID parses a JobAgent ID into an JobAgentId struct
 func JobAgentID(input string) (*JobAgentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func JobAgentID(input string) (*JobAgentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("jobAgents"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("jobAgents"); err != nil {
 		return nil, err
 	}
 
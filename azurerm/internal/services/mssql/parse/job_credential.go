@@ -45,7 +45,7 @@ func (id JobCredentialId) ID() string {
 
 // JobCredentialID parses a JobCredential ID into an JobCredentialId struct
 func JobCredentialID(input string) (*JobCredentialId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func JobCredentialID(input string) (*JobCredentialId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.JobAgentName, err = id.PopSegment("jobAgents"); err != nil {
+	if resourceId.JobAgentName, err = id.PopSegmentInsensitively("jobAgents"); err != nil {
 		return nil, err
 	}
-	if resourceId.CredentialName, err = id.PopSegment("credentials"); err != nil {
+	if resourceId.CredentialName, err = id.PopSegmentInsensitively("credentials"); err != nil {
 		return nil, err
 	}
 
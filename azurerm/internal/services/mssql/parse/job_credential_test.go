@@ -106,7 +106,13 @@ func TestJobCredentialID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/JOBAGENTS/JOBAGENT1/CREDENTIALS/CREDENTIAL1",
-			Error: true,
+			Expected: &JobCredentialId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				ServerName:     "SERVER1",
+				JobAgentName:   "JOBAGENT1",
+				CredentialName: "CREDENTIAL1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id RecoverableDatabaseId) ID() string {
 
 // RecoverableDatabaseID parses a RecoverableDatabase ID into an RecoverableDatabaseId struct
 func RecoverableDatabaseID(input string) (*RecoverableDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func RecoverableDatabaseID(input string) (*RecoverableDatabaseId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("recoverabledatabases"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("recoverabledatabases"); err != nil {
 		return nil, err
 	}
 
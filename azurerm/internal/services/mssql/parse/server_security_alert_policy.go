@@ -42,7 +42,7 @@ func (id ServerSecurityAlertPolicyId) ID() string {
 
 // ServerSecurityAlertPolicyID parses a ServerSecurityAlertPolicy ID into an ServerSecurityAlertPolicyId struct
 func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ServerSecurityAlertPolicyID(input string) (*ServerSecurityAlertPolicyId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.SecurityAlertPolicyName, err = id.PopSegment("securityAlertPolicies"); err != nil {
+	if resourceId.SecurityAlertPolicyName, err = id.PopSegmentInsensitively("securityAlertPolicies"); err != nil {
 		return nil, err
 	}
 
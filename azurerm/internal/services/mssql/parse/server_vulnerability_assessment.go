@@ -42,7 +42,7 @@ func (id ServerVulnerabilityAssessmentId) ID() string {
 
 // ServerVulnerabilityAssessmentID parses a ServerVulnerabilityAssessment ID into an ServerVulnerabilityAssessmentId struct
 func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ServerVulnerabilityAssessmentID(input string) (*ServerVulnerabilityAssessme
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.VulnerabilityAssessmentName, err = id.PopSegment("vulnerabilityAssessments"); err != nil {
+	if resourceId.VulnerabilityAssessmentName, err = id.PopSegmentInsensitively("vulnerabilityAssessments"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestServerVulnerabilityAssessmentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/VULNERABILITYASSESSMENTS/DEFAULT",
-			Error: true,
+			Expected: &ServerVulnerabilityAssessmentId{
+				SubscriptionId:              "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:               "GROUP1",
+				ServerName:                  "SERVER1",
+				VulnerabilityAssessmentName: "DEFAULT",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id SqlVirtualMachineId) ID() string {
 
 // SqlVirtualMachineID parses a SqlVirtualMachine ID into an SqlVirtualMachineId struct
 func SqlVirtualMachineID(input string) (*SqlVirtualMachineId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SqlVirtualMachineID(input string) (*SqlVirtualMachineId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("sqlVirtualMachines"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("sqlVirtualMachines"); err != nil {
 		return nil, err
 	}
 
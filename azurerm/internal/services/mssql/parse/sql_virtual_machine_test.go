@@ -80,7 +80,11 @@ func TestSqlVirtualMachineID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQLVIRTUALMACHINE/SQLVIRTUALMACHINES/VIRTUALMACHINE1",
-			Error: true,
+			Expected: &SqlVirtualMachineId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				Name:           "VIRTUALMACHINE1",
+			},
 		},
 	}
 
@@ -85,7 +85,7 @@ func TestDatabaseExtendedAuditingPolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/DATABASES/DATABASE1/EXTENDEDAUDITINGSETTINGS/DEFAULT",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -109,7 +109,7 @@ func TestDatabaseVulnerabilityAssessmentRuleBaselineID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/DATABASES/DATABASE1/VULNERABILITYASSESSMENTS/DEFAULT/RULES/RULE1/BASELINES/BASELINE1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -85,7 +85,7 @@ func TestJobCredentialID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/JOBAGENTS/JOBAGENT1/CREDENTIALS/CREDENTIAL1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
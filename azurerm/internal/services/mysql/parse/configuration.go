@@ -42,7 +42,7 @@ func (id ConfigurationId) ID() string {
 
 // ConfigurationID parses a Configuration ID into an ConfigurationId struct
 func ConfigurationID(input string) (*ConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ConfigurationID(input string) (*ConfigurationId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("configurations"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("configurations"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestConfigurationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DBFORMYSQL/SERVERS/SERVER1/CONFIGURATIONS/CONFIG1",
-			Error: true,
+			Expected: &ConfigurationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServerName:     "SERVER1",
+				Name:           "CONFIG1",
+			},
 		},
 	}
 
@@ -93,7 +93,12 @@ func TestFirewallRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DBFORMYSQL/SERVERS/SERVER1/FIREWALLRULES/FIREWALLRULE1",
-			Error: true,
+			Expected: &FirewallRuleId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServerName:     "SERVER1",
+				Name:           "FIREWALLRULE1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id KeyId) ID() string {
 
 // KeyID parses a Key ID into an KeyId struct
 func KeyID(input string) (*KeyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func KeyID(input string) (*KeyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("keys"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("keys"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestKeyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DBFORMYSQL/SERVERS/SERVER1/KEYS/KEY1",
-			Error: true,
+			Expected: &KeyId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServerName:     "SERVER1",
+				Name:           "KEY1",
+			},
 		},
 	}
 
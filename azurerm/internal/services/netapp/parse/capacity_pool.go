@@ -42,7 +42,7 @@ func (id CapacityPoolId) ID() string {
 
 // CapacityPoolID parses a CapacityPool ID into an CapacityPoolId struct
 func CapacityPoolID(input string) (*CapacityPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func CapacityPoolID(input string) (*CapacityPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
+	if resourceId.NetAppAccountName, err = id.PopSegmentInsensitively("netAppAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("capacityPools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("capacityPools"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestCapacityPoolID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/ACCOUNT1/CAPACITYPOOLS/POOL1",
-			Error: true,
+			Expected: &CapacityPoolId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				NetAppAccountName: "ACCOUNT1",
+				Name:              "POOL1",
+			},
 		},
 	}
 
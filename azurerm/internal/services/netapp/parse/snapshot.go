@@ -48,7 +48,7 @@ func (id SnapshotId) ID() string {
 
 // SnapshotID parses a Snapshot ID into an SnapshotId struct
 func SnapshotID(input string) (*SnapshotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +66,16 @@ func SnapshotID(input string) (*SnapshotId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
+	if resourceId.NetAppAccountName, err = id.PopSegmentInsensitively("netAppAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
+	if resourceId.CapacityPoolName, err = id.PopSegmentInsensitively("capacityPools"); err != nil {
 		return nil, err
 	}
-	if resourceId.VolumeName, err = id.PopSegment("volumes"); err != nil {
+	if resourceId.VolumeName, err = id.PopSegmentInsensitively("volumes"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("snapshots"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("snapshots"); err != nil {
 		return nil, err
 	}
 
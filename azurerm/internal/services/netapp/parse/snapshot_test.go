@@ -119,7 +119,14 @@ func TestSnapshotID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/ACCOUNT1/CAPACITYPOOLS/POOL1/VOLUMES/VOLUME1/SNAPSHOTS/SNAPSHOT1",
-			Error: true,
+			Expected: &SnapshotId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				NetAppAccountName: "ACCOUNT1",
+				CapacityPoolName:  "POOL1",
+				VolumeName:        "VOLUME1",
+				Name:              "SNAPSHOT1",
+			},
 		},
 	}
 
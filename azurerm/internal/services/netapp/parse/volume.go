@@ -45,7 +45,7 @@ func (id VolumeId) ID() string {
 
 // VolumeID parses a Volume ID into an VolumeId struct
 func VolumeID(input string) (*VolumeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func VolumeID(input string) (*VolumeId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NetAppAccountName, err = id.PopSegment("netAppAccounts"); err != nil {
+	if resourceId.NetAppAccountName, err = id.PopSegmentInsensitively("netAppAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.CapacityPoolName, err = id.PopSegment("capacityPools"); err != nil {
+	if resourceId.CapacityPoolName, err = id.PopSegmentInsensitively("capacityPools"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("volumes"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("volumes"); err != nil {
 		return nil, err
 	}
 
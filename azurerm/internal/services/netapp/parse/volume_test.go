@@ -106,7 +106,13 @@ func TestVolumeID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/ACCOUNT1/CAPACITYPOOLS/POOL1/VOLUMES/VOLUME1",
-			Error: true,
+			Expected: &VolumeId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				NetAppAccountName: "ACCOUNT1",
+				CapacityPoolName:  "POOL1",
+				Name:              "VOLUME1",
+			},
 		},
 	}
 
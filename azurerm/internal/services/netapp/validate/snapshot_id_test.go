@@ -97,7 +97,7 @@ func TestSnapshotID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETAPP/NETAPPACCOUNTS/ACCOUNT1/CAPACITYPOOLS/POOL1/VOLUMES/VOLUME1/SNAPSHOTS/SNAPSHOT1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -68,8 +68,11 @@ func resourceExpressRouteCircuitAuthorizationCreate(d *pluginsdk.ResourceData, m
 	resourceGroup := d.Get("resource_group_name").(string)
 	circuitName := d.Get("express_route_circuit_name").(string)
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(circuitName, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, circuitName, name)
@@ -156,8 +159,11 @@ func resourceExpressRouteCircuitAuthorizationDelete(d *pluginsdk.ResourceData, m
 	circuitName := id.Path["expressRouteCircuits"]
 	name := id.Path["authorizations"]
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(circuitName, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, circuitName, name)
 	if err != nil {
@@ -202,8 +202,11 @@ func resourceExpressRouteCircuitPeeringCreateUpdate(d *pluginsdk.ResourceData, m
 	circuitName := d.Get("express_route_circuit_name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(circuitName, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, circuitName, peeringType)
@@ -356,8 +359,11 @@ func resourceExpressRouteCircuitPeeringDelete(d *pluginsdk.ResourceData, meta in
 	circuitName := id.Path["expressRouteCircuits"]
 	peeringType := id.Path["peerings"]
 
-	locks.ByName(circuitName, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(circuitName, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(circuitName, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, circuitName, peeringType)
 	if err != nil {
@@ -137,8 +137,11 @@ func resourceExpressRouteCircuitCreateUpdate(d *pluginsdk.ResourceData, meta int
 	name := d.Get("name").(string)
 	resGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resGroup, name)
@@ -309,8 +312,11 @@ func resourceExpressRouteCircuitDelete(d *pluginsdk.ResourceData, meta interface
 	resourceGroup := id.ResourceGroup
 	name := id.Path["expressRouteCircuits"]
 
-	locks.ByName(name, expressRouteCircuitResourceName)
-	defer locks.UnlockByName(name, expressRouteCircuitResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, expressRouteCircuitResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
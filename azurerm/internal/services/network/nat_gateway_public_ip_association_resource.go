@@ -65,8 +65,11 @@ func resourceNATGatewayPublicIpAssociationCreate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(parsedNatGatewayId.Name, natGatewayResourceName)
-	defer locks.UnlockByName(parsedNatGatewayId.Name, natGatewayResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(parsedNatGatewayId.Name, natGatewayResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	natGateway, err := client.Get(ctx, parsedNatGatewayId.ResourceGroup, parsedNatGatewayId.Name, "")
 	if err != nil {
@@ -176,8 +179,11 @@ func resourceNATGatewayPublicIpAssociationDelete(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.NatGateway.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.NatGateway.Name, natGatewayResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.NatGateway.Name, natGatewayResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	natGateway, err := client.Get(ctx, id.NatGateway.ResourceGroup, id.NatGateway.Name, "")
 	if err != nil {
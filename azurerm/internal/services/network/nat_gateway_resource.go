@@ -108,8 +108,11 @@ func resourceNatGatewayCreate(d *pluginsdk.ResourceData, meta interface{}) error
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, natGatewayResourceName)
-	defer locks.UnlockByName(name, natGatewayResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, natGatewayResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	resp, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
@@ -173,8 +176,11 @@ func resourceNatGatewayUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, natGatewayResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
@@ -301,8 +307,11 @@ func resourceNatGatewayDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, natGatewayResourceName)
-	defer locks.UnlockByName(id.Name, natGatewayResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, natGatewayResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
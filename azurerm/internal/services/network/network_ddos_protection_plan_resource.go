@@ -63,6 +63,7 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, azureNetworkDDoSProtectionPlanResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for DDoS protection plan creation")
 
@@ -90,11 +91,13 @@ func resourceNetworkDDoSProtectionPlanCreateUpdate(d *pluginsdk.ResourceData, me
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureNetworkDDoSProtectionPlanResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	parameters := network.DdosProtectionPlan{
 		Location: &location,
@@ -167,6 +170,7 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 	client := meta.(*clients.Client).Network.DDOSProtectionPlansClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, azureNetworkDDoSProtectionPlanResourceName, d.Id())
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -191,11 +195,13 @@ func resourceNetworkDDoSProtectionPlanDelete(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("Error extracting names of Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkDDoSProtectionPlanResourceName)
-	defer locks.UnlockByName(name, azureNetworkDDoSProtectionPlanResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureNetworkDDoSProtectionPlanResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
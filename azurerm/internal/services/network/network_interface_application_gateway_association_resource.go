@@ -76,8 +76,11 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationCrea
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -245,8 +248,11 @@ func resourceNetworkInterfaceApplicationGatewayBackendAddressPoolAssociationDele
 	resourceGroup := nicID.ResourceGroup
 	backendAddressPoolId := splitId[1]
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -72,8 +72,11 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationCreate(d *plugin
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -192,8 +195,11 @@ func resourceNetworkInterfaceApplicationSecurityGroupAssociationDelete(d *plugin
 	resourceGroup := nicID.ResourceGroup
 	applicationSecurityGroupId := splitId[1]
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
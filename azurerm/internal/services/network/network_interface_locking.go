@@ -1,6 +1,8 @@
 package network
 
 import (
+	"context"
+
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2020-07-01/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
@@ -12,14 +14,14 @@ type networkInterfaceIPConfigurationLockingDetails struct {
 	virtualNetworkNamesToLock []string
 }
 
-func (details networkInterfaceIPConfigurationLockingDetails) lock() {
-	locks.MultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.MultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
-}
-
-func (details networkInterfaceIPConfigurationLockingDetails) unlock() {
-	locks.UnlockMultipleByName(&details.subnetNamesToLock, SubnetResourceName)
-	locks.UnlockMultipleByName(&details.virtualNetworkNamesToLock, VirtualNetworkResourceName)
+// lock acquires the locks for the Network Interface along with each Subnet and Virtual Network, returning
+// a function to release them - these are acquired together since the Virtual Networks and Subnets are
+// locked before the Network Interface in the canonical lock order
+func (details networkInterfaceIPConfigurationLockingDetails) lock(ctx context.Context, networkInterfaceName string) (func(), error) {
+	keys := []locks.Key{locks.NameKey(networkInterfaceName, networkInterfaceResourceName)}
+	keys = append(keys, locks.NameKeys(details.subnetNamesToLock, SubnetResourceName)...)
+	keys = append(keys, locks.NameKeys(details.virtualNetworkNamesToLock, VirtualNetworkResourceName)...)
+	return locks.Acquire(ctx, keys...)
 }
 
 func determineResourcesToLockFromIPConfiguration(input *[]network.InterfaceIPConfiguration) (*networkInterfaceIPConfigurationLockingDetails, error) {
@@ -76,8 +76,11 @@ func resourceNetworkInterfaceNatRuleAssociationCreate(d *pluginsdk.ResourceData,
 	networkInterfaceName := id.Path["networkInterfaces"]
 	resourceGroup := id.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -245,8 +248,11 @@ func resourceNetworkInterfaceNatRuleAssociationDelete(d *pluginsdk.ResourceData,
 	resourceGroup := nicID.ResourceGroup
 	natRuleId := splitId[1]
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(networkInterfaceName, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -17,6 +17,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const networkInterfaceSecurityGroupAssociationResourceName = "azurerm_network_interface_network_security_group_association"
+
 func resourceNetworkInterfaceSecurityGroupAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceNetworkInterfaceSecurityGroupAssociationCreate,
@@ -54,6 +56,7 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, networkInterfaceSecurityGroupAssociationResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for Network Interface <-> Network Security Group Association creation.")
 
@@ -68,17 +71,20 @@ func resourceNetworkInterfaceSecurityGroupAssociationCreate(d *pluginsdk.Resourc
 	networkInterfaceName := nicId.Path["networkInterfaces"]
 	resourceGroup := nicId.ResourceGroup
 
-	locks.ByName(networkInterfaceName, networkInterfaceResourceName)
-	defer locks.UnlockByName(networkInterfaceName, networkInterfaceResourceName)
-
 	nsgId, err := azure.ParseAzureResourceID(networkSecurityGroupId)
 	if err != nil {
 		return err
 	}
 	nsgName := nsgId.Path["networkSecurityGroups"]
 
-	locks.ByName(nsgName, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(networkInterfaceName, networkInterfaceResourceName),
+		locks.NameKey(nsgName, networkSecurityGroupResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
 	if err != nil {
@@ -184,8 +190,11 @@ func resourceNetworkInterfaceSecurityGroupAssociationDelete(d *pluginsdk.Resourc
 	name := nicID.Path["networkInterfaces"]
 	resourceGroup := nicID.ResourceGroup
 
-	locks.ByName(name, networkInterfaceResourceName)
-	defer locks.UnlockByName(name, networkInterfaceResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, networkInterfaceResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.Get(ctx, resourceGroup, name, "")
 	if err != nil {
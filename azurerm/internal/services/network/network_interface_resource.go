@@ -43,9 +43,10 @@ func resourceNetworkInterface() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceName("Microsoft.Network/networkInterfaces"),
 			},
 
 			"location": azure.SchemaLocation(),
@@ -188,6 +189,7 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, networkInterfaceResourceName, d.Id())
 
 	id := parse.NewNetworkInterfaceID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 	if d.IsNewResource() {
@@ -213,9 +215,6 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		EnableAcceleratedNetworking: &enableAcceleratedNetworking,
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
-
 	dns, hasDns := d.GetOk("dns_servers")
 	nameLabel, hasNameLabel := d.GetOk("internal_dns_name_label")
 	if hasDns || hasNameLabel {
@@ -244,8 +243,11 @@ func resourceNetworkInterfaceCreate(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+	unlock, err := lockingDetails.lock(ctx, id.Name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if len(*ipConfigs) > 0 {
 		properties.IPConfigurations = ipConfigs
@@ -275,14 +277,33 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, networkInterfaceResourceName, d.Id())
 
 	id, err := parse.NetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
+	// the Subnets and Virtual Networks for any new IP Configurations are locked along with the Network Interface
+	var ipConfigs *[]network.InterfaceIPConfiguration
+	lockingDetails := &networkInterfaceIPConfigurationLockingDetails{}
+	if d.HasChange("ip_configuration") {
+		ipConfigsRaw := d.Get("ip_configuration").([]interface{})
+		ipConfigs, err = expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
+		if err != nil {
+			return fmt.Errorf("Error expanding `ip_configuration`: %+v", err)
+		}
+		lockingDetails, err = determineResourcesToLockFromIPConfiguration(ipConfigs)
+		if err != nil {
+			return fmt.Errorf("Error determining locking details: %+v", err)
+		}
+	}
+
+	unlock, err := lockingDetails.lock(ctx, id.Name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// first get the existing one so that we can pull things as needed
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
@@ -329,19 +350,6 @@ func resourceNetworkInterfaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	if d.HasChange("ip_configuration") {
-		ipConfigsRaw := d.Get("ip_configuration").([]interface{})
-		ipConfigs, err := expandNetworkInterfaceIPConfigurations(ipConfigsRaw)
-		if err != nil {
-			return fmt.Errorf("Error expanding `ip_configuration`: %+v", err)
-		}
-		lockingDetails, err := determineResourcesToLockFromIPConfiguration(ipConfigs)
-		if err != nil {
-			return fmt.Errorf("Error determining locking details: %+v", err)
-		}
-
-		lockingDetails.lock()
-		defer lockingDetails.unlock()
-
 		// then map the fields managed in other resources back
 		ipConfigs = mapFieldsToNetworkInterface(ipConfigs, info)
 
@@ -470,15 +478,14 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 	client := meta.(*clients.Client).Network.InterfacesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, networkInterfaceResourceName, d.Id())
 
 	id, err := parse.NetworkInterfaceID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.Name, networkInterfaceResourceName)
-	defer locks.UnlockByName(id.Name, networkInterfaceResourceName)
-
+	// the Network Interface is locked along with its Subnets and Virtual Networks, which are retrieved first
 	existing, err := client.Get(ctx, id.ResourceGroup, id.Name, "")
 	if err != nil {
 		if utils.ResponseWasNotFound(existing.Response) {
@@ -500,8 +507,11 @@ func resourceNetworkInterfaceDelete(d *pluginsdk.ResourceData, meta interface{})
 		return fmt.Errorf("determining locking details: %+v", err)
 	}
 
-	lockingDetails.lock()
-	defer lockingDetails.unlock()
+	unlock, err := lockingDetails.lock(ctx, id.Name)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -97,6 +97,7 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 	client := meta.(*clients.Client).Network.ProfileClient
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, azureNetworkProfileResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for Network Profile creation")
 
@@ -124,14 +125,14 @@ func resourceNetworkProfileCreateUpdate(d *pluginsdk.ResourceData, meta interfac
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureNetworkProfileResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetsToLock, SubnetResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	parameters := network.Profile{
 		Location: &location,
@@ -207,6 +208,7 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	client := meta.(*clients.Client).Network.ProfileClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, azureNetworkProfileResourceName, d.Id())
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -231,14 +233,14 @@ func resourceNetworkProfileDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		return fmt.Errorf("Error extracting names of Subnet and Virtual Network: %+v", err)
 	}
 
-	locks.ByName(name, azureNetworkProfileResourceName)
-	defer locks.UnlockByName(name, azureNetworkProfileResourceName)
-
-	locks.MultipleByName(vnetsToLock, VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(vnetsToLock, VirtualNetworkResourceName)
-
-	locks.MultipleByName(subnetsToLock, SubnetResourceName)
-	defer locks.UnlockMultipleByName(subnetsToLock, SubnetResourceName)
+	lockKeys := []locks.Key{locks.NameKey(name, azureNetworkProfileResourceName)}
+	lockKeys = append(lockKeys, locks.NameKeys(*vnetsToLock, VirtualNetworkResourceName)...)
+	lockKeys = append(lockKeys, locks.NameKeys(*subnetsToLock, SubnetResourceName)...)
+	unlock, err := locks.Acquire(ctx, lockKeys...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if _, err = client.Delete(ctx, resourceGroup, name); err != nil {
 		return fmt.Errorf("Error deleting Network Profile %q (Resource Group %q): %+v", name, resourceGroup, err)
@@ -43,9 +43,10 @@ func resourceNetworkSecurityGroup() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceName("Microsoft.Network/networkSecurityGroups"),
 			},
 
 			"location": azure.SchemaLocation(),
@@ -207,8 +208,11 @@ func resourceNetworkSecurityGroupCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return fmt.Errorf("Error Building list of Network Security Group Rules: %+v", sgErr)
 	}
 
-	locks.ByName(name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(name, networkSecurityGroupResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, networkSecurityGroupResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	sg := network.SecurityGroup{
 		Name:     &name,
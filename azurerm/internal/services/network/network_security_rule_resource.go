@@ -202,8 +202,11 @@ func resourceNetworkSecurityRuleCreateUpdate(d *pluginsdk.ResourceData, meta int
 	protocol := d.Get("protocol").(string)
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(nsgName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+		unlock, err := locks.Acquire(ctx, locks.NameKey(nsgName, networkSecurityGroupResourceName))
+		if err != nil {
+			return err
+		}
+		defer unlock()
 	}
 
 	rule := network.SecurityRule{
@@ -376,8 +379,11 @@ func resourceNetworkSecurityRuleDelete(d *pluginsdk.ResourceData, meta interface
 	sgRuleName := id.Path["securityRules"]
 
 	if !meta.(*clients.Client).Features.Network.RelaxedLocking {
-		locks.ByName(nsgName, networkSecurityGroupResourceName)
-		defer locks.UnlockByName(nsgName, networkSecurityGroupResourceName)
+		unlock, err := locks.Acquire(ctx, locks.NameKey(nsgName, networkSecurityGroupResourceName))
+		if err != nil {
+			return err
+		}
+		defer unlock()
 	}
 
 	future, err := client.Delete(ctx, resGroup, nsgName, sgRuleName)
@@ -39,7 +39,7 @@ func (id ApplicationGatewayId) ID() string {
 
 // ApplicationGatewayID parses a ApplicationGateway ID into an ApplicationGatewayId struct
 func ApplicationGatewayID(input string) (*ApplicationGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApplicationGatewayID(input string) (*ApplicationGatewayId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("applicationGateways"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("applicationGateways"); err != nil {
 		return nil, err
 	}
 
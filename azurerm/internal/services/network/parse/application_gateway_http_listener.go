@@ -42,7 +42,7 @@ func (id ApplicationGatewayHTTPListenerId) ID() string {
 
 // ApplicationGatewayHTTPListenerID parses a ApplicationGatewayHTTPListener ID into an ApplicationGatewayHTTPListenerId struct
 func ApplicationGatewayHTTPListenerID(input string) (*ApplicationGatewayHTTPListenerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ApplicationGatewayHTTPListenerID(input string) (*ApplicationGatewayHTTPList
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+	if resourceId.ApplicationGatewayName, err = id.PopSegmentInsensitively("applicationGateways"); err != nil {
 		return nil, err
 	}
-	if resourceId.HttpListenerName, err = id.PopSegment("httpListeners"); err != nil {
+	if resourceId.HttpListenerName, err = id.PopSegmentInsensitively("httpListeners"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestApplicationGatewayHTTPListenerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/APPLICATIONGATEWAYS/APPLICATIONGATEWAY1/HTTPLISTENERS/HTTPLISTENER1",
-			Error: true,
+			Expected: &ApplicationGatewayHTTPListenerId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "RESGROUP1",
+				ApplicationGatewayName: "APPLICATIONGATEWAY1",
+				HttpListenerName:       "HTTPLISTENER1",
+			},
 		},
 	}
 
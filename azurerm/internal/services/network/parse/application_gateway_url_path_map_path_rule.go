@@ -45,7 +45,7 @@ func (id ApplicationGatewayURLPathMapPathRuleId) ID() string {
 
 // ApplicationGatewayURLPathMapPathRuleID parses a ApplicationGatewayURLPathMapPathRule ID into an ApplicationGatewayURLPathMapPathRuleId struct
 func ApplicationGatewayURLPathMapPathRuleID(input string) (*ApplicationGatewayURLPathMapPathRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ApplicationGatewayURLPathMapPathRuleID(input string) (*ApplicationGatewayUR
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ApplicationGatewayName, err = id.PopSegment("applicationGateways"); err != nil {
+	if resourceId.ApplicationGatewayName, err = id.PopSegmentInsensitively("applicationGateways"); err != nil {
 		return nil, err
 	}
-	if resourceId.UrlPathMapName, err = id.PopSegment("urlPathMaps"); err != nil {
+	if resourceId.UrlPathMapName, err = id.PopSegmentInsensitively("urlPathMaps"); err != nil {
 		return nil, err
 	}
-	if resourceId.PathRuleName, err = id.PopSegment("pathRules"); err != nil {
+	if resourceId.PathRuleName, err = id.PopSegmentInsensitively("pathRules"); err != nil {
 		return nil, err
 	}
 
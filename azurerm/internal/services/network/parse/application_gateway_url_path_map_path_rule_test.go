@@ -106,7 +106,13 @@ func TestApplicationGatewayURLPathMapPathRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/APPLICATIONGATEWAYS/APPLICATIONGATEWAY1/URLPATHMAPS/URLPATHMAP1/PATHRULES/PATHRULE1",
-			Error: true,
+			Expected: &ApplicationGatewayURLPathMapPathRuleId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "RESGROUP1",
+				ApplicationGatewayName: "APPLICATIONGATEWAY1",
+				UrlPathMapName:         "URLPATHMAP1",
+				PathRuleName:           "PATHRULE1",
+			},
 		},
 	}
 
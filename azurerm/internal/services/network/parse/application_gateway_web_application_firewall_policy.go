@@ -39,7 +39,7 @@ func (id ApplicationGatewayWebApplicationFirewallPolicyId) ID() string {
 
 // ApplicationGatewayWebApplicationFirewallPolicyID parses a ApplicationGatewayWebApplicationFirewallPolicy ID into an ApplicationGatewayWebApplicationFirewallPolicyId struct
 func ApplicationGatewayWebApplicationFirewallPolicyID(input string) (*ApplicationGatewayWebApplicationFirewallPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ApplicationGatewayWebApplicationFirewallPolicyID(input string) (*Applicatio
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("ApplicationGatewayWebApplicationFirewallPolicies"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("ApplicationGatewayWebApplicationFirewallPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id BastionHostId) ID() string {
 
 // BastionHostID parses a BastionHost ID into an BastionHostId struct
 func BastionHostID(input string) (*BastionHostId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func BastionHostID(input string) (*BastionHostId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("bastionHosts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("bastionHosts"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id BgpConnectionId) ID() string {
 
 // BgpConnectionID parses a BgpConnection ID into an BgpConnectionId struct
 func BgpConnectionID(input string) (*BgpConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BgpConnectionID(input string) (*BgpConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("bgpConnections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("bgpConnections"); err != nil {
 		return nil, err
 	}
 
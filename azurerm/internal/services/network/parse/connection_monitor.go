@@ -42,7 +42,7 @@ func (id ConnectionMonitorId) ID() string {
 
 // ConnectionMonitorID parses a ConnectionMonitor ID into an ConnectionMonitorId struct
 func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ConnectionMonitorID(input string) (*ConnectionMonitorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
+	if resourceId.NetworkWatcherName, err = id.PopSegmentInsensitively("networkWatchers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("connectionMonitors"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("connectionMonitors"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestConnectionMonitorID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/NETWORKWATCHERS/WATCHER1/CONNECTIONMONITORS/CONNECTIONMONITOR1",
-			Error: true,
+			Expected: &ConnectionMonitorId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				NetworkWatcherName: "WATCHER1",
+				Name:               "CONNECTIONMONITOR1",
+			},
 		},
 	}
 
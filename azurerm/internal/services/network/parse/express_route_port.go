@@ -39,7 +39,7 @@ func (id ExpressRoutePortId) ID() string {
 
 // ExpressRoutePortID parses a ExpressRoutePort ID into an ExpressRoutePortId struct
 func ExpressRoutePortID(input string) (*ExpressRoutePortId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ExpressRoutePortID(input string) (*ExpressRoutePortId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("expressRoutePorts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("expressRoutePorts"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id HubRouteTableId) ID() string {
 
 // HubRouteTableID parses a HubRouteTable ID into an HubRouteTableId struct
 func HubRouteTableID(input string) (*HubRouteTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func HubRouteTableID(input string) (*HubRouteTableId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("hubRouteTables"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hubRouteTables"); err != nil {
 		return nil, err
 	}
 
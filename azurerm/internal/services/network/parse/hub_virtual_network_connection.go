@@ -42,7 +42,7 @@ func (id HubVirtualNetworkConnectionId) ID() string {
 
 // HubVirtualNetworkConnectionID parses a HubVirtualNetworkConnection ID into an HubVirtualNetworkConnectionId struct
 func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func HubVirtualNetworkConnectionID(input string) (*HubVirtualNetworkConnectionId
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("hubVirtualNetworkConnections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hubVirtualNetworkConnections"); err != nil {
 		return nil, err
 	}
 
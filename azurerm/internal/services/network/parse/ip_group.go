@@ -39,7 +39,7 @@ func (id IpGroupId) ID() string {
 
 // IpGroupID parses a IpGroup ID into an IpGroupId struct
 func IpGroupID(input string) (*IpGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func IpGroupID(input string) (*IpGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("ipGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("ipGroups"); err != nil {
 		return nil, err
 	}
 
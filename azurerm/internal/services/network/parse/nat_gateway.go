@@ -39,7 +39,7 @@ func (id NatGatewayId) ID() string {
 
 // NatGatewayID parses a NatGateway ID into an NatGatewayId struct
 func NatGatewayID(input string) (*NatGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NatGatewayID(input string) (*NatGatewayId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("natGateways"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("natGateways"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id NetworkInterfaceId) ID() string {
 
 // NetworkInterfaceID parses a NetworkInterface ID into an NetworkInterfaceId struct
 func NetworkInterfaceID(input string) (*NetworkInterfaceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NetworkInterfaceID(input string) (*NetworkInterfaceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("networkInterfaces"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("networkInterfaces"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id NetworkSecurityGroupId) ID() string {
 
 // NetworkSecurityGroupID parses a NetworkSecurityGroup ID into an NetworkSecurityGroupId struct
 func NetworkSecurityGroupID(input string) (*NetworkSecurityGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NetworkSecurityGroupID(input string) (*NetworkSecurityGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("networkSecurityGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("networkSecurityGroups"); err != nil {
 		return nil, err
 	}
 
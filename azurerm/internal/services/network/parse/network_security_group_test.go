@@ -80,7 +80,11 @@ func TestNetworkSecurityGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/NETWORKSECURITYGROUPS/SECURITYGROUP1",
-			Error: true,
+			Expected: &NetworkSecurityGroupId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "SECURITYGROUP1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id NetworkWatcherId) ID() string {
 
 // NetworkWatcherID parses a NetworkWatcher ID into an NetworkWatcherId struct
 func NetworkWatcherID(input string) (*NetworkWatcherId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NetworkWatcherID(input string) (*NetworkWatcherId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("networkWatchers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("networkWatchers"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id PacketCaptureId) ID() string {
 
 // PacketCaptureID parses a PacketCapture ID into an PacketCaptureId struct
 func PacketCaptureID(input string) (*PacketCaptureId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func PacketCaptureID(input string) (*PacketCaptureId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NetworkWatcherName, err = id.PopSegment("networkWatchers"); err != nil {
+	if resourceId.NetworkWatcherName, err = id.PopSegmentInsensitively("networkWatchers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("packetCaptures"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("packetCaptures"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id PointToSiteVpnGatewayId) ID() string {
 
 // PointToSiteVpnGatewayID parses a PointToSiteVpnGateway ID into an PointToSiteVpnGatewayId struct
 func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PointToSiteVpnGatewayID(input string) (*PointToSiteVpnGatewayId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.P2sVpnGatewayName, err = id.PopSegment("p2sVpnGateways"); err != nil {
+	if resourceId.P2sVpnGatewayName, err = id.PopSegmentInsensitively("p2sVpnGateways"); err != nil {
 		return nil, err
 	}
 
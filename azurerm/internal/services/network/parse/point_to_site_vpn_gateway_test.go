@@ -80,7 +80,11 @@ func TestPointToSiteVpnGatewayID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/P2SVPNGATEWAYS/POINTTOSITE1",
-			Error: true,
+			Expected: &PointToSiteVpnGatewayId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				P2sVpnGatewayName: "POINTTOSITE1",
+			},
 		},
 	}
 
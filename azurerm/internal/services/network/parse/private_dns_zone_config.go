@@ -45,7 +45,7 @@ func (id PrivateDnsZoneConfigId) ID() string {
 
 // PrivateDnsZoneConfigID parses a PrivateDnsZoneConfig ID into an PrivateDnsZoneConfigId struct
 func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func PrivateDnsZoneConfigID(input string) (*PrivateDnsZoneConfigId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
+	if resourceId.PrivateEndpointName, err = id.PopSegmentInsensitively("privateEndpoints"); err != nil {
 		return nil, err
 	}
-	if resourceId.PrivateDnsZoneGroupName, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
+	if resourceId.PrivateDnsZoneGroupName, err = id.PopSegmentInsensitively("privateDnsZoneGroups"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneConfigs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZoneConfigs"); err != nil {
 		return nil, err
 	}
 
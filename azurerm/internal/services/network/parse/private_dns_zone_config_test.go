@@ -106,7 +106,13 @@ func TestPrivateDnsZoneConfigID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PRIVATEENDPOINTS/ENDPOINT1/PRIVATEDNSZONEGROUPS/PRIVATEDNSZONEGROUP1/PRIVATEDNSZONECONFIGS/PRIVATEDNSZONECONFIG1",
-			Error: true,
+			Expected: &PrivateDnsZoneConfigId{
+				SubscriptionId:          "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:           "RESGROUP1",
+				PrivateEndpointName:     "ENDPOINT1",
+				PrivateDnsZoneGroupName: "PRIVATEDNSZONEGROUP1",
+				Name:                    "PRIVATEDNSZONECONFIG1",
+			},
 		},
 	}
 
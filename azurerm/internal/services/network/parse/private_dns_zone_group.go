@@ -42,7 +42,7 @@ func (id PrivateDnsZoneGroupId) ID() string {
 
 // PrivateDnsZoneGroupID parses a PrivateDnsZoneGroup ID into an PrivateDnsZoneGroupId struct
 func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func PrivateDnsZoneGroupID(input string) (*PrivateDnsZoneGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PrivateEndpointName, err = id.PopSegment("privateEndpoints"); err != nil {
+	if resourceId.PrivateEndpointName, err = id.PopSegmentInsensitively("privateEndpoints"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("privateDnsZoneGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZoneGroups"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestPrivateDnsZoneGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PRIVATEENDPOINTS/ENDPOINT1/PRIVATEDNSZONEGROUPS/PRIVATEDNSZONEGROUP1",
-			Error: true,
+			Expected: &PrivateDnsZoneGroupId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				PrivateEndpointName: "ENDPOINT1",
+				Name:                "PRIVATEDNSZONEGROUP1",
+			},
 		},
 	}
 
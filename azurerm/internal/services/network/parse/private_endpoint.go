@@ -39,7 +39,7 @@ func (id PrivateEndpointId) ID() string {
 
 // PrivateEndpointID parses a PrivateEndpoint ID into an PrivateEndpointId struct
 func PrivateEndpointID(input string) (*PrivateEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PrivateEndpointID(input string) (*PrivateEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("privateEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id PublicIpAddressId) ID() string {
 
 // PublicIpAddressID parses a PublicIpAddress ID into an PublicIpAddressId struct
 func PublicIpAddressID(input string) (*PublicIpAddressId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PublicIpAddressID(input string) (*PublicIpAddressId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("publicIPAddresses"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("publicIPAddresses"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestPublicIpAddressID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PUBLICIPADDRESSES/PUBLICIPADDRESS1",
-			Error: true,
+			Expected: &PublicIpAddressId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				Name:           "PUBLICIPADDRESS1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id PublicIpPrefixId) ID() string {
 
 // PublicIpPrefixID parses a PublicIpPrefix ID into an PublicIpPrefixId struct
 func PublicIpPrefixID(input string) (*PublicIpPrefixId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PublicIpPrefixID(input string) (*PublicIpPrefixId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PublicIPPrefixeName, err = id.PopSegment("publicIPPrefixes"); err != nil {
+	if resourceId.PublicIPPrefixeName, err = id.PopSegmentInsensitively("publicIPPrefixes"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestPublicIpPrefixID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PUBLICIPPREFIXES/PUBLICIPPREFIX1",
-			Error: true,
+			Expected: &PublicIpPrefixId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				PublicIPPrefixeName: "PUBLICIPPREFIX1",
+			},
 		},
 	}
 
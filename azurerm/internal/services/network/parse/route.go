@@ -42,7 +42,7 @@ func (id RouteId) ID() string {
 
 // RouteID parses a Route ID into an RouteId struct
 func RouteID(input string) (*RouteId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func RouteID(input string) (*RouteId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RouteTableName, err = id.PopSegment("routeTables"); err != nil {
+	if resourceId.RouteTableName, err = id.PopSegmentInsensitively("routeTables"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("routes"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("routes"); err != nil {
 		return nil, err
 	}
 
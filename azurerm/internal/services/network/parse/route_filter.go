@@ -39,7 +39,7 @@ func (id RouteFilterId) ID() string {
 
 // RouteFilterID parses a RouteFilter ID into an RouteFilterId struct
 func RouteFilterID(input string) (*RouteFilterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func RouteFilterID(input string) (*RouteFilterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("routeFilters"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("routeFilters"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id RouteTableId) ID() string {
 
 // RouteTableID parses a RouteTable ID into an RouteTableId struct
 func RouteTableID(input string) (*RouteTableId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func RouteTableID(input string) (*RouteTableId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("routeTables"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("routeTables"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestRouteID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/ROUTETABLES/ROUTETABLE1/ROUTES/ROUTE1",
-			Error: true,
+			Expected: &RouteId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				RouteTableName: "ROUTETABLE1",
+				Name:           "ROUTE1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id SecurityPartnerProviderId) ID() string {
 
 // SecurityPartnerProviderID parses a SecurityPartnerProvider ID into an SecurityPartnerProviderId struct
 func SecurityPartnerProviderID(input string) (*SecurityPartnerProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SecurityPartnerProviderID(input string) (*SecurityPartnerProviderId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("securityPartnerProviders"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("securityPartnerProviders"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id SubnetId) ID() string {
 
 // SubnetID parses a Subnet ID into an SubnetId struct
 func SubnetID(input string) (*SubnetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SubnetID(input string) (*SubnetId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualNetworkName, err = id.PopSegment("virtualNetworks"); err != nil {
+	if resourceId.VirtualNetworkName, err = id.PopSegmentInsensitively("virtualNetworks"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("subnets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("subnets"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id SubnetServiceEndpointStoragePolicyId) ID() string {
 
 // SubnetServiceEndpointStoragePolicyID parses a SubnetServiceEndpointStoragePolicy ID into an SubnetServiceEndpointStoragePolicyId struct
 func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointStoragePolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SubnetServiceEndpointStoragePolicyID(input string) (*SubnetServiceEndpointS
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServiceEndpointPolicyName, err = id.PopSegment("serviceEndpointPolicies"); err != nil {
+	if resourceId.ServiceEndpointPolicyName, err = id.PopSegmentInsensitively("serviceEndpointPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestSubnetServiceEndpointStoragePolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/SERVICEENDPOINTPOLICIES/POLICY1",
-			Error: true,
+			Expected: &SubnetServiceEndpointStoragePolicyId{
+				SubscriptionId:            "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:             "RESGROUP1",
+				ServiceEndpointPolicyName: "POLICY1",
+			},
 		},
 	}
 
@@ -93,7 +93,12 @@ func TestSubnetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKS/NETWORK1/SUBNETS/SUBNET1",
-			Error: true,
+			Expected: &SubnetId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				VirtualNetworkName: "NETWORK1",
+				Name:               "SUBNET1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id VirtualHubId) ID() string {
 
 // VirtualHubID parses a VirtualHub ID into an VirtualHubId struct
 func VirtualHubID(input string) (*VirtualHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualHubID(input string) (*VirtualHubId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualHubs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
 		return nil, err
 	}
 
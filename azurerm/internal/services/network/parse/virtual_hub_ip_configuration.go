@@ -42,7 +42,7 @@ func (id VirtualHubIpConfigurationId) ID() string {
 
 // VirtualHubIpConfigurationID parses a VirtualHubIpConfiguration ID into an VirtualHubIpConfigurationId struct
 func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualHubIpConfigurationID(input string) (*VirtualHubIpConfigurationId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualHubName, err = id.PopSegment("virtualHubs"); err != nil {
+	if resourceId.VirtualHubName, err = id.PopSegmentInsensitively("virtualHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
+	if resourceId.IpConfigurationName, err = id.PopSegmentInsensitively("ipConfigurations"); err != nil {
 		return nil, err
 	}
 
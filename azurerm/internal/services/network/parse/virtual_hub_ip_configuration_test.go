@@ -93,7 +93,12 @@ func TestVirtualHubIpConfigurationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALHUBS/VIRTUALHUB1/IPCONFIGURATIONS/IPCONFIGURATION1",
-			Error: true,
+			Expected: &VirtualHubIpConfigurationId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				VirtualHubName:      "VIRTUALHUB1",
+				IpConfigurationName: "IPCONFIGURATION1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id VirtualNetworkId) ID() string {
 
 // VirtualNetworkID parses a VirtualNetwork ID into an VirtualNetworkId struct
 func VirtualNetworkID(input string) (*VirtualNetworkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualNetworkID(input string) (*VirtualNetworkId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualNetworks"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualNetworks"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id VirtualNetworkGatewayId) ID() string {
 
 // VirtualNetworkGatewayID parses a VirtualNetworkGateway ID into an VirtualNetworkGatewayId struct
 func VirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualNetworkGatewayID(input string) (*VirtualNetworkGatewayId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualNetworkGateways"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualNetworkGateways"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id VirtualNetworkGatewayIpConfigurationId) ID() string {
 
 // VirtualNetworkGatewayIpConfigurationID parses a VirtualNetworkGatewayIpConfiguration ID into an VirtualNetworkGatewayIpConfigurationId struct
 func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewayIpConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualNetworkGatewayIpConfigurationID(input string) (*VirtualNetworkGatewa
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualNetworkGatewayName, err = id.PopSegment("virtualNetworkGateways"); err != nil {
+	if resourceId.VirtualNetworkGatewayName, err = id.PopSegmentInsensitively("virtualNetworkGateways"); err != nil {
 		return nil, err
 	}
-	if resourceId.IpConfigurationName, err = id.PopSegment("ipConfigurations"); err != nil {
+	if resourceId.IpConfigurationName, err = id.PopSegmentInsensitively("ipConfigurations"); err != nil {
 		return nil, err
 	}
 
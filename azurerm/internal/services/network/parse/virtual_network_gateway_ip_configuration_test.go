@@ -93,7 +93,12 @@ func TestVirtualNetworkGatewayIpConfigurationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALNETWORKGATEWAYS/GW1/IPCONFIGURATIONS/CFG1",
-			Error: true,
+			Expected: &VirtualNetworkGatewayIpConfigurationId{
+				SubscriptionId:            "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:             "RESGROUP1",
+				VirtualNetworkGatewayName: "GW1",
+				IpConfigurationName:       "CFG1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id VirtualWanId) ID() string {
 
 // VirtualWanID parses a VirtualWan ID into an VirtualWanId struct
 func VirtualWanID(input string) (*VirtualWanId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VirtualWanID(input string) (*VirtualWanId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("virtualWans"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualWans"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id VpnConnectionId) ID() string {
 
 // VpnConnectionID parses a VpnConnection ID into an VpnConnectionId struct
 func VpnConnectionID(input string) (*VpnConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VpnConnectionID(input string) (*VpnConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VpnGatewayName, err = id.PopSegment("vpnGateways"); err != nil {
+	if resourceId.VpnGatewayName, err = id.PopSegmentInsensitively("vpnGateways"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("vpnConnections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnConnections"); err != nil {
 		return nil, err
 	}
 
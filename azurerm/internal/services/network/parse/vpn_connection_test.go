@@ -93,7 +93,12 @@ func TestVpnConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VPNGATEWAYS/VPNGATEWAY1/VPNCONNECTIONS/VPNCONNECTION1",
-			Error: true,
+			Expected: &VpnConnectionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				VpnGatewayName: "VPNGATEWAY1",
+				Name:           "VPNCONNECTION1",
+			},
 		},
 	}
 
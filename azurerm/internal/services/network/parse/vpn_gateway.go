@@ -39,7 +39,7 @@ func (id VpnGatewayId) ID() string {
 
 // VpnGatewayID parses a VpnGateway ID into an VpnGatewayId struct
 func VpnGatewayID(input string) (*VpnGatewayId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VpnGatewayID(input string) (*VpnGatewayId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("vpnGateways"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnGateways"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id VpnServerConfigurationId) ID() string {
 
 // VpnServerConfigurationID parses a VpnServerConfiguration ID into an VpnServerConfigurationId struct
 func VpnServerConfigurationID(input string) (*VpnServerConfigurationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VpnServerConfigurationID(input string) (*VpnServerConfigurationId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("vpnServerConfigurations"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnServerConfigurations"); err != nil {
 		return nil, err
 	}
 
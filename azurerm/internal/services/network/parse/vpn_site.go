@@ -39,7 +39,7 @@ func (id VpnSiteId) ID() string {
 
 // VpnSiteID parses a VpnSite ID into an VpnSiteId struct
 func VpnSiteID(input string) (*VpnSiteId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func VpnSiteID(input string) (*VpnSiteId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("vpnSites"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnSites"); err != nil {
 		return nil, err
 	}
 
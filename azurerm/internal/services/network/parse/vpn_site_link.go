@@ -42,7 +42,7 @@ func (id VpnSiteLinkId) ID() string {
 
 // VpnSiteLinkID parses a VpnSiteLink ID into an VpnSiteLinkId struct
 func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VpnSiteLinkID(input string) (*VpnSiteLinkId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VpnSiteName, err = id.PopSegment("vpnSites"); err != nil {
+	if resourceId.VpnSiteName, err = id.PopSegmentInsensitively("vpnSites"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("vpnSiteLinks"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("vpnSiteLinks"); err != nil {
 		return nil, err
 	}
 
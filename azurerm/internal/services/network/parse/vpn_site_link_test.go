@@ -93,7 +93,12 @@ func TestVpnSiteLinkID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VPNSITES/VPNSITE1/VPNSITELINKS/VPNSITELINK1",
-			Error: true,
+			Expected: &VpnSiteLinkId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				VpnSiteName:    "VPNSITE1",
+				Name:           "VPNSITELINK1",
+			},
 		},
 	}
 
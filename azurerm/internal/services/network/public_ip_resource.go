@@ -44,7 +44,7 @@ func resourcePublicIp() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validation.StringIsNotEmpty,
+				ValidateFunc: validation.ResourceName("Microsoft.Network/publicIPAddresses"),
 			},
 
 			"location": azure.SchemaLocation(),
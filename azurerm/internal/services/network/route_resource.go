@@ -105,8 +105,11 @@ func resourceRouteCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		}
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.RouteTableName, routeTableResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	route := network.Route{
 		Name: utils.String(id.Name),
@@ -175,8 +178,11 @@ func resourceRouteDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 		return err
 	}
 
-	locks.ByName(id.RouteTableName, routeTableResourceName)
-	defer locks.UnlockByName(id.RouteTableName, routeTableResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.RouteTableName, routeTableResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RouteTableName, id.Name)
 	if err != nil {
@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const subnetNatGatewayAssociationResourceName = "azurerm_subnet_nat_gateway_association"
+
 func resourceSubnetNatGatewayAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSubnetNatGatewayAssociationCreate,
@@ -52,6 +54,7 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetNatGatewayAssociationResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for Subnet <-> NAT Gateway Association creation.")
 	subnetId := d.Get("subnet_id").(string)
@@ -72,12 +75,15 @@ func resourceSubnetNatGatewayAssociationCreate(d *pluginsdk.ResourceData, meta i
 
 	gatewayName := parsedGatewayId.Name
 
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(gatewayName, natGatewayResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -162,6 +168,7 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetNatGatewayAssociationResourceName, d.Id())
 
 	id, err := parse.SubnetID(d.Id())
 	if err != nil {
@@ -195,10 +202,14 @@ func resourceSubnetNatGatewayAssociationDelete(d *pluginsdk.ResourceData, meta i
 	}
 
 	gatewayName := parsedGatewayId.Path["natGateways"]
-	locks.ByName(gatewayName, natGatewayResourceName)
-	defer locks.UnlockByName(gatewayName, natGatewayResourceName)
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(gatewayName, natGatewayResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// ensure we get the latest state
 	subnet, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
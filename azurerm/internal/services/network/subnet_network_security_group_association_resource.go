@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const subnetNetworkSecurityGroupAssociationResourceName = "azurerm_subnet_network_security_group_association"
+
 func resourceSubnetNetworkSecurityGroupAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSubnetNetworkSecurityGroupAssociationCreate,
@@ -53,6 +55,7 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetNetworkSecurityGroupAssociationResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for Subnet <-> Network Security Group Association creation.")
 
@@ -69,18 +72,19 @@ func resourceSubnetNetworkSecurityGroupAssociationCreate(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
 	subnetName := parsedSubnetId.Path["subnets"]
 	virtualNetworkName := parsedSubnetId.Path["virtualNetworks"]
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -168,6 +172,7 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetNetworkSecurityGroupAssociationResourceName, d.Id())
 
 	id, err := azure.ParseAzureResourceID(d.Id())
 	if err != nil {
@@ -204,14 +209,15 @@ func resourceSubnetNetworkSecurityGroupAssociationDelete(d *pluginsdk.ResourceDa
 		return err
 	}
 
-	locks.ByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-	defer locks.UnlockByName(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, SubnetResourceName)
-	defer locks.UnlockByName(subnetName, SubnetResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(parsedNetworkSecurityGroupId.Name, networkSecurityGroupResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(subnetName, SubnetResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
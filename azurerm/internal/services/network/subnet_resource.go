@@ -41,9 +41,10 @@ func resourceSubnet() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceName("Microsoft.Network/virtualNetworks/subnets"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -197,8 +198,11 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		return tf.ImportAsExistsError("azurerm_subnet", id.ID())
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	properties := network.SubnetPropertiesFormat{}
 	if value, ok := d.GetOk("address_prefixes"); ok {
@@ -398,17 +402,21 @@ func resourceSubnetDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, SubnetResourceName, d.Id())
 
 	id, err := parse.SubnetID(d.Id())
 	if err != nil {
 		return err
 	}
 
-	locks.ByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
-
-	locks.ByName(id.Name, SubnetResourceName)
-	defer locks.UnlockByName(id.Name, SubnetResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(id.VirtualNetworkName, VirtualNetworkResourceName),
+		locks.NameKey(id.Name, SubnetResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualNetworkName, id.Name)
 	if err != nil {
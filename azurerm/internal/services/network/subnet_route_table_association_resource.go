@@ -16,6 +16,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+const subnetRouteTableAssociationResourceName = "azurerm_subnet_route_table_association"
+
 func resourceSubnetRouteTableAssociation() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceSubnetRouteTableAssociationCreate,
@@ -53,6 +55,7 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetRouteTableAssociationResourceName, d.Id())
 
 	log.Printf("[INFO] preparing arguments for Subnet <-> Route Table Association creation.")
 
@@ -69,15 +72,18 @@ func resourceSubnetRouteTableAssociationCreate(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
 	subnetName := parsedSubnetId.Name
 	virtualNetworkName := parsedSubnetId.VirtualNetworkName
 	resourceGroup := parsedSubnetId.ResourceGroup
 
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	subnet, err := client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
 	if err != nil {
@@ -165,6 +171,7 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 	client := meta.(*clients.Client).Network.SubnetsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
+	ctx = locks.WithHolder(ctx, subnetRouteTableAssociationResourceName, d.Id())
 
 	id, err := parse.SubnetID(d.Id())
 	if err != nil {
@@ -201,11 +208,14 @@ func resourceSubnetRouteTableAssociationDelete(d *pluginsdk.ResourceData, meta i
 		return err
 	}
 
-	locks.ByName(parsedRouteTableId.Name, routeTableResourceName)
-	defer locks.UnlockByName(parsedRouteTableId.Name, routeTableResourceName)
-
-	locks.ByName(virtualNetworkName, VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx,
+		locks.NameKey(parsedRouteTableId.Name, routeTableResourceName),
+		locks.NameKey(virtualNetworkName, VirtualNetworkResourceName),
+	)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	// then re-retrieve it to ensure we've got the latest state
 	read, err = client.Get(ctx, resourceGroup, virtualNetworkName, subnetName, "")
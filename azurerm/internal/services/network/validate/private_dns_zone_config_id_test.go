@@ -85,7 +85,7 @@ func TestPrivateDnsZoneConfigID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/PRIVATEENDPOINTS/ENDPOINT1/PRIVATEDNSZONEGROUPS/PRIVATEDNSZONEGROUP1/PRIVATEDNSZONECONFIGS/PRIVATEDNSZONECONFIG1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -61,7 +61,7 @@ func TestVirtualWanID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/VIRTUALWANS/VIRTUALWAN1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
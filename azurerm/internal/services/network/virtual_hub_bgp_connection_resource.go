@@ -76,8 +76,11 @@ func resourceVirtualHubBgpConnectionCreate(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
-	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	name := d.Get("name").(string)
 
@@ -167,8 +170,11 @@ func resourceVirtualHubBgpConnectionDelete(d *pluginsdk.ResourceData, meta inter
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
@@ -172,8 +172,11 @@ func resourceVirtualHubConnectionCreateOrUpdate(d *pluginsdk.ResourceData, meta
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
-	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	name := d.Get("name").(string)
 
@@ -279,8 +282,11 @@ func resourceVirtualHubConnectionDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
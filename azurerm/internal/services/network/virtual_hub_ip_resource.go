@@ -93,8 +93,11 @@ func resourceVirtualHubIPCreateUpdate(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
-	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	name := d.Get("name").(string)
 
@@ -207,8 +210,11 @@ func resourceVirtualHubIPDelete(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.IpConfigurationName)
 	if err != nil {
@@ -116,8 +116,11 @@ func resourceVirtualHubCreateUpdate(d *pluginsdk.ResourceData, meta interface{})
 	name := d.Get("name").(string)
 	resourceGroup := d.Get("resource_group_name").(string)
 
-	locks.ByName(name, virtualHubResourceName)
-	defer locks.UnlockByName(name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, resourceGroup, name)
@@ -247,8 +250,11 @@ func resourceVirtualHubDelete(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
-	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.Name)
 	if err != nil {
@@ -121,8 +121,11 @@ func resourceVirtualHubRouteTableCreateUpdate(d *pluginsdk.ResourceData, meta in
 		return err
 	}
 
-	locks.ByName(id.Name, virtualHubResourceName)
-	defer locks.UnlockByName(id.Name, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.Name, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	name := d.Get("name").(string)
 
@@ -214,8 +217,11 @@ func resourceVirtualHubRouteTableDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
-	locks.ByName(id.VirtualHubName, virtualHubResourceName)
-	defer locks.UnlockByName(id.VirtualHubName, virtualHubResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(id.VirtualHubName, virtualHubResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.VirtualHubName, id.Name)
 	if err != nil {
@@ -42,7 +42,7 @@ func (id NotificationHubId) ID() string {
 
 // NotificationHubID parses a NotificationHub ID into an NotificationHubId struct
 func NotificationHubID(input string) (*NotificationHubId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NotificationHubID(input string) (*NotificationHubId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("notificationHubs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("notificationHubs"); err != nil {
 		return nil, err
 	}
 
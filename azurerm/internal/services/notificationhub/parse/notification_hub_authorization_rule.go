@@ -45,7 +45,7 @@ func (id NotificationHubAuthorizationRuleId) ID() string {
 
 // NotificationHubAuthorizationRuleID parses a NotificationHubAuthorizationRule ID into an NotificationHubAuthorizationRuleId struct
 func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func NotificationHubAuthorizationRuleID(input string) (*NotificationHubAuthoriza
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.NotificationHubName, err = id.PopSegment("notificationHubs"); err != nil {
+	if resourceId.NotificationHubName, err = id.PopSegmentInsensitively("notificationHubs"); err != nil {
 		return nil, err
 	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("AuthorizationRules"); err != nil {
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("AuthorizationRules"); err != nil {
 		return nil, err
 	}
 
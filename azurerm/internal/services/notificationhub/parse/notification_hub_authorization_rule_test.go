@@ -106,7 +106,13 @@ func TestNotificationHubAuthorizationRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NAMESPACE1/NOTIFICATIONHUBS/HUB1/AUTHORIZATIONRULES/AUTHORIZATIONRULE1",
-			Error: true,
+			Expected: &NotificationHubAuthorizationRuleId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "RESGROUP1",
+				NamespaceName:         "NAMESPACE1",
+				NotificationHubName:   "HUB1",
+				AuthorizationRuleName: "AUTHORIZATIONRULE1",
+			},
 		},
 	}
 
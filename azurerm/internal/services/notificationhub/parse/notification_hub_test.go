@@ -93,7 +93,12 @@ func TestNotificationHubID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NOTIFICATIONHUBS/NAMESPACES/NAMESPACE1/NOTIFICATIONHUBS/HUB1",
-			Error: true,
+			Expected: &NotificationHubId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				NamespaceName:  "NAMESPACE1",
+				Name:           "HUB1",
+			},
 		},
 	}
 
@@ -2,7 +2,8 @@ package parse
 
 import (
 	"fmt"
-	"regexp"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PolicyAssignmentId struct {
@@ -14,30 +15,18 @@ type PolicyAssignmentId struct {
 func PolicyAssignmentID(input string) (*PolicyAssignmentId, error) {
 	// in general, the id of a assignment should be:
 	// {scope}/providers/Microsoft.Authorization/policyAssignment/{name}
-	regex := regexp.MustCompile(`/providers/[Mm]icrosoft\.[Aa]uthorization/policy[Aa]ssignments/`)
-	if !regex.MatchString(input) {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q", input)
-	}
-
-	segments := regex.Split(input, -1)
-
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: Expected 2 segments after split", input)
-	}
-
-	scope := segments[0]
-	name := segments[1]
-	if name == "" {
-		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: assignment name is empty", input)
+	id, err := resourceid.ParseScopedIDInsensitively(input, "Microsoft.Authorization", "policyAssignments")
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: %+v", input, err)
 	}
 
-	scopeId, err := PolicyScopeID(scope)
+	scopeId, err := PolicyScopeID(id.Scope)
 	if err != nil {
 		return nil, fmt.Errorf("unable to parse Policy Assignment ID %q: %+v", input, err)
 	}
 
 	return &PolicyAssignmentId{
-		Name:          name,
+		Name:          id.Name,
 		PolicyScopeId: scopeId,
 	}, nil
 }
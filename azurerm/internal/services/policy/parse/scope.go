@@ -3,8 +3,7 @@ package parse
 import (
 	"fmt"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
-	parseMgmtGroup "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/managementgroup/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type PolicyScopeId interface {
@@ -52,41 +51,38 @@ func PolicyScopeID(input string) (PolicyScopeId, error) {
 		return nil, fmt.Errorf("unable to parse Remediation Scope ID: ID is empty")
 	}
 
-	if isManagementGroupId(input) {
-		id, _ := parseMgmtGroup.ManagementGroupID(input)
+	scope, err := resourceid.ParseScope(input)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse Policy Scope ID %q: %+v", input, err)
+	}
+
+	switch scope.Type {
+	case resourceid.ManagementGroupScopeType:
 		return ScopeAtManagementGroup{
 			scopeId:             input,
-			ManagementGroupName: id.Name,
+			ManagementGroupName: scope.ManagementGroupName,
 		}, nil
-	}
-	// scope is not a management group ID, should be subscription ID, resource group ID or a resource ID
-	id, err := azure.ParseAzureResourceID(input)
-	if err != nil {
-		return nil, fmt.Errorf("unable to parse Policy Remediation ID %q: %+v", input, err)
-	}
-	noExtraSegments := id.ValidateNoEmptySegments(input) == nil
-	if id.ResourceGroup == "" && noExtraSegments {
-		// should be a subscription id
+
+	case resourceid.SubscriptionScopeType:
 		return ScopeAtSubscription{
 			scopeId:        input,
-			SubscriptionId: id.SubscriptionID,
+			SubscriptionId: scope.SubscriptionId,
 		}, nil
-	}
-	if id.ResourceGroup != "" && noExtraSegments {
-		// should be a resourceGroup id
+
+	case resourceid.ResourceGroupScopeType:
 		return ScopeAtResourceGroup{
 			scopeId:        input,
-			SubscriptionId: id.SubscriptionID,
-			ResourceGroup:  id.ResourceGroup,
+			SubscriptionId: scope.SubscriptionId,
+			ResourceGroup:  scope.ResourceGroup,
 		}, nil
 	}
-	// should be a resource ID
+
+	// Policies can only be assigned to Resources within a Subscription
+	if scope.SubscriptionId == "" {
+		return nil, fmt.Errorf("unable to parse Policy Scope ID %q: expected the Resource to be within a Subscription", input)
+	}
+
 	return ScopeAtResource{
 		scopeId: input,
 	}, nil
 }
-
-func isManagementGroupId(input string) bool {
-	_, err := parseMgmtGroup.ManagementGroupID(input)
-	return err == nil
-}
@@ -42,7 +42,7 @@ func (id VirtualMachineConfigurationPolicyAssignmentId) ID() string {
 
 // VirtualMachineConfigurationPolicyAssignmentID parses a VirtualMachineConfigurationPolicyAssignment ID into an VirtualMachineConfigurationPolicyAssignmentId struct
 func VirtualMachineConfigurationPolicyAssignmentID(input string) (*VirtualMachineConfigurationPolicyAssignmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualMachineConfigurationPolicyAssignmentID(input string) (*VirtualMachin
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.VirtualMachineName, err = id.PopSegment("virtualMachines"); err != nil {
+	if resourceId.VirtualMachineName, err = id.PopSegmentInsensitively("virtualMachines"); err != nil {
 		return nil, err
 	}
-	if resourceId.GuestConfigurationAssignmentName, err = id.PopSegment("guestConfigurationAssignments"); err != nil {
+	if resourceId.GuestConfigurationAssignmentName, err = id.PopSegmentInsensitively("guestConfigurationAssignments"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestVirtualMachineConfigurationPolicyAssignmentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.COMPUTE/VIRTUALMACHINES/VM1/PROVIDERS/MICROSOFT.GUESTCONFIGURATION/GUESTCONFIGURATIONASSIGNMENTS/ASSIGNMENT1",
-			Error: true,
+			Expected: &VirtualMachineConfigurationPolicyAssignmentId{
+				SubscriptionId:                   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                    "RESGROUP1",
+				VirtualMachineName:               "VM1",
+				GuestConfigurationAssignmentName: "ASSIGNMENT1",
+			},
 		},
 	}
 
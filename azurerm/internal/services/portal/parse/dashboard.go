@@ -39,7 +39,7 @@ func (id DashboardId) ID() string {
 
 // DashboardID parses a Dashboard ID into an DashboardId struct
 func DashboardID(input string) (*DashboardId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func DashboardID(input string) (*DashboardId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("dashboards"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("dashboards"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id FlexibleServerId) ID() string {
 
 // FlexibleServerID parses a FlexibleServer ID into an FlexibleServerId struct
 func FlexibleServerID(input string) (*FlexibleServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func FlexibleServerID(input string) (*FlexibleServerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("flexibleServers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("flexibleServers"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id FlexibleServerFirewallRuleId) ID() string {
 
 // FlexibleServerFirewallRuleID parses a FlexibleServerFirewallRule ID into an FlexibleServerFirewallRuleId struct
 func FlexibleServerFirewallRuleID(input string) (*FlexibleServerFirewallRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FlexibleServerFirewallRuleID(input string) (*FlexibleServerFirewallRuleId,
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.FlexibleServerName, err = id.PopSegment("flexibleServers"); err != nil {
+	if resourceId.FlexibleServerName, err = id.PopSegmentInsensitively("flexibleServers"); err != nil {
 		return nil, err
 	}
-	if resourceId.FirewallRuleName, err = id.PopSegment("firewallRules"); err != nil {
+	if resourceId.FirewallRuleName, err = id.PopSegmentInsensitively("firewallRules"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestFlexibleServerFirewallRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DBFORPOSTGRESQL/FLEXIBLESERVERS/FLEXIBLESERVER1/FIREWALLRULES/FIREWALLRULE1",
-			Error: true,
+			Expected: &FlexibleServerFirewallRuleId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				FlexibleServerName: "FLEXIBLESERVER1",
+				FirewallRuleName:   "FIREWALLRULE1",
+			},
 		},
 	}
 
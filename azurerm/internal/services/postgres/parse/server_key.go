@@ -42,7 +42,7 @@ func (id ServerKeyId) ID() string {
 
 // ServerKeyID parses a ServerKey ID into an ServerKeyId struct
 func ServerKeyID(input string) (*ServerKeyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ServerKeyID(input string) (*ServerKeyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.KeyName, err = id.PopSegment("keys"); err != nil {
+	if resourceId.KeyName, err = id.PopSegmentInsensitively("keys"); err != nil {
 		return nil, err
 	}
 
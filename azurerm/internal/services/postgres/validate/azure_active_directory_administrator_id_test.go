@@ -73,7 +73,7 @@ func TestAzureActiveDirectoryAdministratorID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.DBFORPOSTGRESQL/SERVERS/SERVER1/ADMINISTRATORS/ACTIVEDIRECTORY",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
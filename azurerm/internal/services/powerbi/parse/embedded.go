@@ -39,7 +39,7 @@ func (id EmbeddedId) ID() string {
 
 // EmbeddedID parses a Embedded ID into an EmbeddedId struct
 func EmbeddedID(input string) (*EmbeddedId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func EmbeddedID(input string) (*EmbeddedId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.CapacityName, err = id.PopSegment("capacities"); err != nil {
+	if resourceId.CapacityName, err = id.PopSegmentInsensitively("capacities"); err != nil {
 		return nil, err
 	}
 
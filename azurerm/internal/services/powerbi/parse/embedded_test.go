@@ -80,7 +80,11 @@ func TestEmbeddedID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.POWERBIDEDICATED/CAPACITIES/CAPACITY1",
-			Error: true,
+			Expected: &EmbeddedId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				CapacityName:   "CAPACITY1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id PrivateDnsZoneId) ID() string {
 
 // PrivateDnsZoneID parses a PrivateDnsZone ID into an PrivateDnsZoneId struct
 func PrivateDnsZoneID(input string) (*PrivateDnsZoneId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PrivateDnsZoneID(input string) (*PrivateDnsZoneId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("privateDnsZones"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateDnsZones"); err != nil {
 		return nil, err
 	}
 
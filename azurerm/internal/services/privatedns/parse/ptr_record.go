@@ -42,7 +42,7 @@ func (id PtrRecordId) ID() string {
 
 // PtrRecordID parses a PtrRecord ID into an PtrRecordId struct
 func PtrRecordID(input string) (*PtrRecordId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func PtrRecordID(input string) (*PtrRecordId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
+	if resourceId.PrivateDnsZoneName, err = id.PopSegmentInsensitively("privateDnsZones"); err != nil {
 		return nil, err
 	}
-	if resourceId.PTRName, err = id.PopSegment("PTR"); err != nil {
+	if resourceId.PTRName, err = id.PopSegmentInsensitively("PTR"); err != nil {
 		return nil, err
 	}
 
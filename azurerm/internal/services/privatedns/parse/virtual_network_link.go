@@ -42,7 +42,7 @@ func (id VirtualNetworkLinkId) ID() string {
 
 // VirtualNetworkLinkID parses a VirtualNetworkLink ID into an VirtualNetworkLinkId struct
 func VirtualNetworkLinkID(input string) (*VirtualNetworkLinkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func VirtualNetworkLinkID(input string) (*VirtualNetworkLinkId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PrivateDnsZoneName, err = id.PopSegment("privateDnsZones"); err != nil {
+	if resourceId.PrivateDnsZoneName, err = id.PopSegmentInsensitively("privateDnsZones"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("virtualNetworkLinks"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("virtualNetworkLinks"); err != nil {
 		return nil, err
 	}
 
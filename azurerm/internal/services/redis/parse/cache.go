@@ -39,7 +39,7 @@ func (id CacheId) ID() string {
 
 // CacheID parses a Cache ID into an CacheId struct
 func CacheID(input string) (*CacheId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func CacheID(input string) (*CacheId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RediName, err = id.PopSegment("Redis"); err != nil {
+	if resourceId.RediName, err = id.PopSegmentInsensitively("Redis"); err != nil {
 		return nil, err
 	}
 
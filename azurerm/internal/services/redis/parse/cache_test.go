@@ -80,7 +80,11 @@ func TestCacheID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CACHE/REDIS/REDIS1",
-			Error: true,
+			Expected: &CacheId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				RediName:       "REDIS1",
+			},
 		},
 	}
 
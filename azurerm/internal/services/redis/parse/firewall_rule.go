@@ -42,7 +42,7 @@ func (id FirewallRuleId) ID() string {
 
 // FirewallRuleID parses a FirewallRule ID into an FirewallRuleId struct
 func FirewallRuleID(input string) (*FirewallRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FirewallRuleID(input string) (*FirewallRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RediName, err = id.PopSegment("Redis"); err != nil {
+	if resourceId.RediName, err = id.PopSegmentInsensitively("Redis"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("firewallRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("firewallRules"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id LinkedServerId) ID() string {
 
 // LinkedServerID parses a LinkedServer ID into an LinkedServerId struct
 func LinkedServerID(input string) (*LinkedServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func LinkedServerID(input string) (*LinkedServerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RediName, err = id.PopSegment("Redis"); err != nil {
+	if resourceId.RediName, err = id.PopSegmentInsensitively("Redis"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("linkedServers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("linkedServers"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestLinkedServerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.CACHE/REDIS/REDIS1/LINKEDSERVERS/LINKEDSERVER1",
-			Error: true,
+			Expected: &LinkedServerId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				RediName:       "REDIS1",
+				Name:           "LINKEDSERVER1",
+			},
 		},
 	}
 
@@ -9,12 +9,15 @@ import (
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/redis/mgmt/2020-06-01/redis"
+	"github.com/Azure/go-autorest/autorest"
+	autorestAzure "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	networkParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/parse"
 	networkValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network/validate"
@@ -48,9 +51,10 @@ func resourceRedisCache() *pluginsdk.Resource {
 
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.ResourceName("Microsoft.Cache/redis"),
 			},
 
 			"location": {
@@ -286,6 +290,8 @@ func resourceRedisCache() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(nameavailability.CustomizeDiff("Redis Cache", redisCacheNameAvailability)),
 	}
 }
 
@@ -364,11 +370,11 @@ func resourceRedisCacheCreate(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		unlock, err := locks.Acquire(ctx, locks.NameKey(parsed.VirtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(parsed.Name, network.SubnetResourceName))
+		if err != nil {
+			return err
+		}
+		defer unlock()
 
 		parameters.SubnetID = utils.String(v.(string))
 	}
@@ -624,11 +630,11 @@ func resourceRedisCacheDelete(d *pluginsdk.ResourceData, meta interface{}) error
 			return err
 		}
 
-		locks.ByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-		defer locks.UnlockByName(parsed.VirtualNetworkName, network.VirtualNetworkResourceName)
-
-		locks.ByName(parsed.Name, network.SubnetResourceName)
-		defer locks.UnlockByName(parsed.Name, network.SubnetResourceName)
+		unlock, err := locks.Acquire(ctx, locks.NameKey(parsed.VirtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(parsed.Name, network.SubnetResourceName))
+		if err != nil {
+			return err
+		}
+		defer unlock()
 	}
 
 	future, err := client.Delete(ctx, id.ResourceGroup, id.RediName)
@@ -886,3 +892,33 @@ func flattenRedisPatchSchedules(schedule redis.PatchSchedule) []interface{} {
 func getRedisConnectionString(redisHostName string, sslPort int32, accessKey string, enableSslPort bool) string {
 	return fmt.Sprintf("%s:%d,password=%s,ssl=%t,abortConnect=False", redisHostName, sslPort, accessKey, enableSslPort)
 }
+
+func redisCacheNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	resp, err := client.Redis.Client.CheckNameAvailability(ctx, redis.CheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Cache/redis"),
+	})
+	if err == nil {
+		return &nameavailability.Result{
+			Available: true,
+		}, nil
+	}
+
+	// the API returns a `409 Conflict` (rather than a result) when the name isn't available - any
+	// other error means that the availability couldn't be checked
+	if !utils.ResponseWasConflict(resp) {
+		return nil, err
+	}
+
+	result := nameavailability.Result{
+		Available: false,
+		Reason:    "AlreadyExists",
+	}
+	if detailed, ok := err.(autorest.DetailedError); ok {
+		if requestErr, ok := detailed.Original.(*autorestAzure.RequestError); ok && requestErr.ServiceError != nil {
+			result.Reason = requestErr.ServiceError.Code
+			result.Message = requestErr.ServiceError.Message
+		}
+	}
+	return &result, nil
+}
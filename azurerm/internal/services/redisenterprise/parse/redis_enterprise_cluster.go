@@ -39,7 +39,7 @@ func (id RedisEnterpriseClusterId) ID() string {
 
 // RedisEnterpriseClusterID parses a RedisEnterpriseCluster ID into an RedisEnterpriseClusterId struct
 func RedisEnterpriseClusterID(input string) (*RedisEnterpriseClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func RedisEnterpriseClusterID(input string) (*RedisEnterpriseClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RedisEnterpriseName, err = id.PopSegment("redisEnterprise"); err != nil {
+	if resourceId.RedisEnterpriseName, err = id.PopSegmentInsensitively("redisEnterprise"); err != nil {
 		return nil, err
 	}
 
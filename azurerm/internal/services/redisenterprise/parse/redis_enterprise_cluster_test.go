@@ -80,7 +80,11 @@ func TestRedisEnterpriseClusterID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.CACHE/REDISENTERPRISE/CLUSTER1",
-			Error: true,
+			Expected: &RedisEnterpriseClusterId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESOURCEGROUP1",
+				RedisEnterpriseName: "CLUSTER1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id RedisEnterpriseDatabaseId) ID() string {
 
 // RedisEnterpriseDatabaseID parses a RedisEnterpriseDatabase ID into an RedisEnterpriseDatabaseId struct
 func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func RedisEnterpriseDatabaseID(input string) (*RedisEnterpriseDatabaseId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.RedisEnterpriseName, err = id.PopSegment("redisEnterprise"); err != nil {
+	if resourceId.RedisEnterpriseName, err = id.PopSegmentInsensitively("redisEnterprise"); err != nil {
 		return nil, err
 	}
-	if resourceId.DatabaseName, err = id.PopSegment("databases"); err != nil {
+	if resourceId.DatabaseName, err = id.PopSegmentInsensitively("databases"); err != nil {
 		return nil, err
 	}
 
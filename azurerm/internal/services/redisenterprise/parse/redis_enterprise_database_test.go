@@ -93,7 +93,12 @@ func TestRedisEnterpriseDatabaseID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESOURCEGROUP1/PROVIDERS/MICROSOFT.CACHE/REDISENTERPRISE/CLUSTER1/DATABASES/DATABASE1",
-			Error: true,
+			Expected: &RedisEnterpriseDatabaseId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESOURCEGROUP1",
+				RedisEnterpriseName: "CLUSTER1",
+				DatabaseName:        "DATABASE1",
+			},
 		},
 	}
 
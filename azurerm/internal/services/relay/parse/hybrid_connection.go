@@ -42,7 +42,7 @@ func (id HybridConnectionId) ID() string {
 
 // HybridConnectionID parses a HybridConnection ID into an HybridConnectionId struct
 func HybridConnectionID(input string) (*HybridConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func HybridConnectionID(input string) (*HybridConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("hybridConnections"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hybridConnections"); err != nil {
 		return nil, err
 	}
 
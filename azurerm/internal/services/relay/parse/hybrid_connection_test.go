@@ -93,7 +93,12 @@ func TestHybridConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.RELAY/NAMESPACES/NAMESPACE1/HYBRIDCONNECTIONS/HYBRIDCONNECTION1",
-			Error: true,
+			Expected: &HybridConnectionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				NamespaceName:  "NAMESPACE1",
+				Name:           "HYBRIDCONNECTION1",
+			},
 		},
 	}
 
@@ -10,6 +10,7 @@ import (
 
 type Client struct {
 	DeploymentsClient           *resources.DeploymentsClient
+	GenericResourcesClient      *GenericResourcesClient
 	GroupsClient                *resources.GroupsClient
 	LocksClient                 *locks.ManagementLocksClient
 	ProvidersClient             *providers.ProvidersClient
@@ -21,6 +22,9 @@ func NewClient(o *common.ClientOptions) *Client {
 	deploymentsClient := resources.NewDeploymentsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&deploymentsClient.Client, o.ResourceManagerAuthorizer)
 
+	genericResourcesClient := NewGenericResourcesClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
+	o.ConfigureClient(&genericResourcesClient.Client, o.ResourceManagerAuthorizer)
+
 	groupsClient := resources.NewGroupsClientWithBaseURI(o.ResourceManagerEndpoint, o.SubscriptionId)
 	o.ConfigureClient(&groupsClient.Client, o.ResourceManagerAuthorizer)
 
@@ -40,6 +44,7 @@ func NewClient(o *common.ClientOptions) *Client {
 	return &Client{
 		GroupsClient:                &groupsClient,
 		DeploymentsClient:           &deploymentsClient,
+		GenericResourcesClient:      &genericResourcesClient,
 		LocksClient:                 &locksClient,
 		ProvidersClient:             &providersClient,
 		ResourcesClient:             &resourcesClient,
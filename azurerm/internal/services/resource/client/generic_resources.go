@@ -0,0 +1,183 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2020-06-01/resources"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/polling"
+)
+
+// GenericResourcesClient performs Create/Read/Update/Delete operations for any Resource ID using the
+// specified API Version. Unlike the Resources Client the request and response bodies are untyped, so
+// that all of the fields exposed by the API Version can be used.
+type GenericResourcesClient struct {
+	resources.BaseClient
+}
+
+// GenericResourceResult is the response from the API for a Generic Resource
+type GenericResourceResult struct {
+	autorest.Response
+
+	// Body is the (untyped) Response Body
+	Body map[string]interface{}
+}
+
+func NewGenericResourcesClientWithBaseURI(endpoint string, subscriptionId string) GenericResourcesClient {
+	return GenericResourcesClient{
+		BaseClient: resources.NewWithBaseURI(endpoint, subscriptionId),
+	}
+}
+
+// Get retrieves the resource with the specified ID using the specified API Version
+func (client GenericResourcesClient) Get(ctx context.Context, resourceId string, apiVersion string) (result GenericResourceResult, err error) {
+	req, err := client.preparer(ctx, resourceId, apiVersion, autorest.AsGet())
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Get", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Get", resp, "Failure sending request")
+		return
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK),
+		autorest.ByUnmarshallingJSON(&result.Body),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Get", resp, "Failure responding to request")
+	}
+	return
+}
+
+// CreateOrUpdate creates (or replaces) the resource with the specified ID using the specified API Version,
+// and then waits for the Long Running Operation to complete (if any)
+func (client GenericResourcesClient) CreateOrUpdate(ctx context.Context, resourceId string, apiVersion string, body map[string]interface{}) error {
+	req, err := client.preparer(ctx, resourceId, apiVersion,
+		autorest.AsContentType("application/json; charset=utf-8"),
+		autorest.AsPut(),
+		autorest.WithJSON(body))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", "CreateOrUpdate", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", "CreateOrUpdate", resp, "Failure sending request")
+	}
+
+	return client.waitForCompletion(ctx, "CreateOrUpdate", resp)
+}
+
+// Delete deletes the resource with the specified ID using the specified API Version, and then waits
+// for the Long Running Operation to complete (if any). A resource which doesn't exist is treated as deleted.
+func (client GenericResourcesClient) Delete(ctx context.Context, resourceId string, apiVersion string) error {
+	req, err := client.preparer(ctx, resourceId, apiVersion, autorest.AsDelete())
+	if err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Delete", nil, "Failure preparing request")
+	}
+
+	resp, err := client.Send(req, autorest.DoRetryForStatusCodes(client.RetryAttempts, client.RetryDuration, autorest.StatusCodesForRetry...))
+	if err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Delete", resp, "Failure sending request")
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		return autorest.Respond(resp, autorest.ByClosing())
+	}
+
+	return client.waitForCompletion(ctx, "Delete", resp)
+}
+
+// Action invokes the specified Action (e.g. `listKeys`) on the resource with the specified ID using the specified
+// API Version, and then waits for the Long Running Operation to complete (if any) - returning the Response Body
+func (client GenericResourcesClient) Action(ctx context.Context, resourceId string, action string, apiVersion string, body map[string]interface{}) (result GenericResourceResult, err error) {
+	decorators := []autorest.PrepareDecorator{
+		autorest.AsPost(),
+	}
+	if body != nil {
+		decorators = append(decorators,
+			autorest.AsContentType("application/json; charset=utf-8"),
+			autorest.WithJSON(body))
+	}
+	req, err := client.preparer(ctx, fmt.Sprintf("%s/%s", strings.TrimSuffix(resourceId, "/"), action), apiVersion, decorators...)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Action", nil, "Failure preparing request")
+		return
+	}
+
+	resp, err := client.Send(req, azure.DoRetryWithRegistration(client.Client))
+	if err != nil {
+		result.Response = autorest.Response{Response: resp}
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Action", resp, "Failure sending request")
+		return
+	}
+
+	// Actions which complete immediately return the result in the response, otherwise the
+	// result is retrieved once the Long Running Operation has completed
+	if resp.StatusCode == http.StatusAccepted || resp.StatusCode == http.StatusCreated {
+		poller, perr := polling.NewLongRunningPollerFromResponse(ctx, resp, client.Client)
+		if perr != nil {
+			result.Response = autorest.Response{Response: resp}
+			err = autorest.NewErrorWithError(perr, "client.GenericResourcesClient", "Action", resp, "Failure sending request")
+			return
+		}
+
+		if err = poller.PollUntilDone(); err != nil {
+			result.Response = autorest.Response{Response: poller.HttpResponse}
+			err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Action", poller.HttpResponse, "Failure polling the Long Running Operation")
+			return
+		}
+
+		resp = poller.HttpResponse
+	}
+
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(http.StatusOK, http.StatusNoContent),
+		autorest.ByUnmarshallingJSON(&result.Body),
+		autorest.ByClosing())
+	result.Response = autorest.Response{Response: resp}
+	if err != nil {
+		err = autorest.NewErrorWithError(err, "client.GenericResourcesClient", "Action", resp, "Failure responding to request")
+	}
+	return
+}
+
+func (client GenericResourcesClient) preparer(ctx context.Context, resourceId string, apiVersion string, decorators ...autorest.PrepareDecorator) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": apiVersion,
+	}
+
+	decorators = append(decorators,
+		autorest.WithBaseURL(client.BaseURI),
+		autorest.WithPath(resourceId),
+		autorest.WithQueryParameters(queryParameters))
+	preparer := autorest.CreatePreparer(decorators...)
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+
+// waitForCompletion waits for the Long Running Operation started by the response to complete - which
+// completes immediately when the API doesn't return a Long Running Operation
+func (client GenericResourcesClient) waitForCompletion(ctx context.Context, method string, resp *http.Response) error {
+	poller, err := polling.NewLongRunningPollerFromResponse(ctx, resp, client.Client)
+	if err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", method, resp, "Failure sending request")
+	}
+
+	if err := poller.PollUntilDone(); err != nil {
+		return autorest.NewErrorWithError(err, "client.GenericResourcesClient", method, poller.HttpResponse, "Failure polling the Long Running Operation")
+	}
+
+	return nil
+}
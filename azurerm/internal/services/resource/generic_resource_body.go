@@ -0,0 +1,132 @@
+package resource
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// genericResourceReadOnlyFields are the top-level fields returned by the API which can't be specified
+// in the Request Body, and as such are excluded when the configured Body isn't known (e.g. during import)
+var genericResourceReadOnlyFields = []string{
+	"etag",
+	"id",
+	"name",
+	"systemData",
+	"type",
+}
+
+// expandGenericResourceBody parses the JSON Body specified in the configuration
+func expandGenericResourceBody(input string) (map[string]interface{}, error) {
+	body := make(map[string]interface{})
+	if input == "" {
+		return body, nil
+	}
+
+	if err := json.Unmarshal([]byte(input), &body); err != nil {
+		return nil, fmt.Errorf("parsing the `body` as a JSON Object: %+v", err)
+	}
+
+	return body, nil
+}
+
+// flattenGenericResourceBody returns the Response Body from the API as a JSON string. When the configured Body
+// is known, the Response Body is limited to the fields which are specified within the configured Body - such that
+// fields which are added by the API (e.g. default or read-only values) don't show as a diff, but changes to the
+// fields which are specified in the configuration do.
+func flattenGenericResourceBody(configured string, remote map[string]interface{}) (string, error) {
+	var output interface{}
+	if configured == "" {
+		output = withoutGenericResourceReadOnlyFields(remote)
+	} else {
+		config, err := expandGenericResourceBody(configured)
+		if err != nil {
+			return "", err
+		}
+		output = projectGenericResourceBody(config, remote)
+	}
+
+	result, err := json.Marshal(output)
+	if err != nil {
+		return "", fmt.Errorf("serializing the Response Body: %+v", err)
+	}
+
+	return string(result), nil
+}
+
+// projectGenericResourceBody returns the value from the remote projected onto the fields
+// which are defined in the configuration
+func projectGenericResourceBody(config interface{}, remote interface{}) interface{} {
+	switch configValue := config.(type) {
+	case map[string]interface{}:
+		remoteValue, ok := remote.(map[string]interface{})
+		if !ok {
+			return remote
+		}
+
+		output := make(map[string]interface{}, len(configValue))
+		for key, value := range configValue {
+			v, ok := findGenericResourceBodyKey(remoteValue, key)
+			if !ok {
+				// fields which aren't returned from the API (e.g. secrets) retain the configured value
+				output[key] = value
+				continue
+			}
+			output[key] = projectGenericResourceBody(value, v)
+		}
+		return output
+
+	case []interface{}:
+		remoteValue, ok := remote.([]interface{})
+		if !ok || len(remoteValue) != len(configValue) {
+			return remote
+		}
+
+		output := make([]interface{}, len(configValue))
+		for i := range configValue {
+			output[i] = projectGenericResourceBody(configValue[i], remoteValue[i])
+		}
+		return output
+	}
+
+	return remote
+}
+
+// findGenericResourceBodyKey returns the value for the specified key - which is matched case-insensitively
+// when there isn't an exact match, since some API's return the field names with a different casing
+func findGenericResourceBodyKey(input map[string]interface{}, key string) (interface{}, bool) {
+	if v, ok := input[key]; ok {
+		return v, true
+	}
+
+	for k, v := range input {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+func withoutGenericResourceReadOnlyFields(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+	for k, v := range input {
+		output[k] = v
+	}
+
+	for _, field := range genericResourceReadOnlyFields {
+		delete(output, field)
+	}
+
+	if properties, ok := output["properties"].(map[string]interface{}); ok {
+		withoutProvisioningState := make(map[string]interface{}, len(properties))
+		for k, v := range properties {
+			if k != "provisioningState" {
+				withoutProvisioningState[k] = v
+			}
+		}
+		output["properties"] = withoutProvisioningState
+	}
+
+	return output
+}
@@ -0,0 +1,88 @@
+package resource
+
+import (
+	"testing"
+)
+
+func TestFlattenGenericResourceBody(t *testing.T) {
+	remote := map[string]interface{}{
+		"id":       "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		"name":     "network1",
+		"type":     "Microsoft.Network/virtualNetworks",
+		"etag":     "W/\"abc123\"",
+		"location": "westeurope",
+		"tags": map[string]interface{}{
+			"environment": "production",
+		},
+		"properties": map[string]interface{}{
+			"provisioningState": "Succeeded",
+			"resourceGuid":      "8f6d8d1e-3cc3-4b8a-8d8d-0b6b5d0f2b3e",
+			"addressSpace": map[string]interface{}{
+				"addressPrefixes": []interface{}{"10.0.0.0/16"},
+			},
+			"subnets": []interface{}{
+				map[string]interface{}{
+					"name": "subnet1",
+					"properties": map[string]interface{}{
+						"addressPrefix":     "10.0.1.0/24",
+						"provisioningState": "Succeeded",
+					},
+				},
+			},
+		},
+	}
+
+	testData := []struct {
+		Name       string
+		Configured string
+		Expected   string
+	}{
+		{
+			Name:       "Import",
+			Configured: "",
+			Expected:   `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]},"resourceGuid":"8f6d8d1e-3cc3-4b8a-8d8d-0b6b5d0f2b3e","subnets":[{"name":"subnet1","properties":{"addressPrefix":"10.0.1.0/24","provisioningState":"Succeeded"}}]},"tags":{"environment":"production"}}`,
+		},
+		{
+			Name:       "Fields added by the API are ignored",
+			Configured: `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+			Expected:   `{"location":"westeurope","properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+		},
+		{
+			Name:       "Changed fields are returned",
+			Configured: `{"location":"westeurope","tags":{"environment":"development"}}`,
+			Expected:   `{"location":"westeurope","tags":{"environment":"production"}}`,
+		},
+		{
+			Name:       "Nested objects within lists are projected",
+			Configured: `{"properties":{"subnets":[{"name":"subnet1","properties":{"addressPrefix":"10.0.1.0/24"}}]}}`,
+			Expected:   `{"properties":{"subnets":[{"name":"subnet1","properties":{"addressPrefix":"10.0.1.0/24"}}]}}`,
+		},
+		{
+			Name:       "Lists with a different length are returned as-is",
+			Configured: `{"properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16","10.1.0.0/16"]}}}`,
+			Expected:   `{"properties":{"addressSpace":{"addressPrefixes":["10.0.0.0/16"]}}}`,
+		},
+		{
+			Name:       "Fields which aren't returned retain the configured value",
+			Configured: `{"properties":{"sharedKey":"secret"}}`,
+			Expected:   `{"properties":{"sharedKey":"secret"}}`,
+		},
+		{
+			Name:       "Fields are matched case-insensitively",
+			Configured: `{"Location":"westeurope"}`,
+			Expected:   `{"Location":"westeurope"}`,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		actual, err := flattenGenericResourceBody(v.Configured, remote)
+		if err != nil {
+			t.Fatalf("flattening: %+v", err)
+		}
+		if actual != v.Expected {
+			t.Fatalf("Expected %s but got %s", v.Expected, actual)
+		}
+	}
+}
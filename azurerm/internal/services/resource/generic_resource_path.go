@@ -0,0 +1,140 @@
+package resource
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// genericResourcePathSegment is a single segment within a Path Expression, which is either
+// a field name, an index into a list, or a projection over all of the items within a list
+type genericResourcePathSegment struct {
+	field      string
+	index      *int
+	projection bool
+}
+
+// evaluateGenericResourcePath returns the value at the specified Path Expression within the Response Body
+// for a resource - returning nil when the value doesn't exist. The Path Expression is a subset of JMESPath:
+//
+// * `properties.provisioningState` returns the field `provisioningState` within the `properties` object
+// * `properties.subnets[0]` returns the first item within the `subnets` list (negative indexes count from the end)
+// * `properties.subnets[*].name` returns the `name` of each item within the `subnets` list
+//
+// Field names are matched case-insensitively when there isn't an exact match.
+func evaluateGenericResourcePath(input interface{}, path string) (interface{}, error) {
+	segments, err := parseGenericResourcePath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluateGenericResourcePathSegments(input, segments), nil
+}
+
+func evaluateGenericResourcePathSegments(input interface{}, segments []genericResourcePathSegment) interface{} {
+	if len(segments) == 0 || input == nil {
+		return input
+	}
+
+	segment := segments[0]
+	switch {
+	case segment.field != "":
+		v, ok := input.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value, ok := findGenericResourceBodyKey(v, segment.field)
+		if !ok {
+			return nil
+		}
+		return evaluateGenericResourcePathSegments(value, segments[1:])
+
+	case segment.index != nil:
+		v, ok := input.([]interface{})
+		if !ok {
+			return nil
+		}
+
+		index := *segment.index
+		if index < 0 {
+			index += len(v)
+		}
+		if index < 0 || index >= len(v) {
+			return nil
+		}
+		return evaluateGenericResourcePathSegments(v[index], segments[1:])
+
+	case segment.projection:
+		v, ok := input.([]interface{})
+		if !ok {
+			return nil
+		}
+
+		// as with JMESPath, items where the remaining expression evaluates to null are excluded
+		output := make([]interface{}, 0)
+		for _, item := range v {
+			if value := evaluateGenericResourcePathSegments(item, segments[1:]); value != nil {
+				output = append(output, value)
+			}
+		}
+		return output
+	}
+
+	return nil
+}
+
+// parseGenericResourcePath parses the Path Expression into the segments it's comprised of
+func parseGenericResourcePath(input string) ([]genericResourcePathSegment, error) {
+	if strings.TrimSpace(input) == "" {
+		return nil, fmt.Errorf("the path expression was empty")
+	}
+
+	segments := make([]genericResourcePathSegment, 0)
+	for i, part := range strings.Split(input, ".") {
+		field := part
+		indexes := ""
+		if idx := strings.Index(part, "["); idx != -1 {
+			field = part[:idx]
+			indexes = part[idx:]
+		}
+
+		if field == "" && (i > 0 || indexes == "") {
+			return nil, fmt.Errorf("the path expression %q contains an empty field name", input)
+		}
+		if strings.ContainsAny(field, "]* ") {
+			return nil, fmt.Errorf("the field name %q within the path expression %q is invalid", field, input)
+		}
+		if field != "" {
+			segments = append(segments, genericResourcePathSegment{
+				field: field,
+			})
+		}
+
+		for indexes != "" {
+			end := strings.Index(indexes, "]")
+			if !strings.HasPrefix(indexes, "[") || end == -1 {
+				return nil, fmt.Errorf("the path expression %q contains an unterminated index", input)
+			}
+
+			value := indexes[1:end]
+			indexes = indexes[end+1:]
+			if value == "*" {
+				segments = append(segments, genericResourcePathSegment{
+					projection: true,
+				})
+				continue
+			}
+
+			index, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, fmt.Errorf("the index %q within the path expression %q must be a number or `*`", value, input)
+			}
+			segments = append(segments, genericResourcePathSegment{
+				index: &index,
+			})
+		}
+	}
+
+	return segments, nil
+}
@@ -0,0 +1,136 @@
+package resource
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEvaluateGenericResourcePath(t *testing.T) {
+	input := map[string]interface{}{
+		"location": "westeurope",
+		"sku": map[string]interface{}{
+			"name": "Standard",
+		},
+		"properties": map[string]interface{}{
+			"enabled":  true,
+			"capacity": float64(2),
+			"subnets": []interface{}{
+				map[string]interface{}{
+					"name": "first",
+				},
+				map[string]interface{}{
+					"name": "second",
+				},
+				map[string]interface{}{
+					"id": "no-name",
+				},
+			},
+			"matrix": []interface{}{
+				[]interface{}{"a", "b"},
+			},
+		},
+	}
+
+	testData := []struct {
+		Path     string
+		Expected string
+		Error    bool
+	}{
+		{
+			Path:  "",
+			Error: true,
+		},
+		{
+			Path:     "location",
+			Expected: `"westeurope"`,
+		},
+		{
+			Path:     "sku.name",
+			Expected: `"Standard"`,
+		},
+		{
+			Path:     "SKU.Name",
+			Expected: `"Standard"`,
+		},
+		{
+			Path:     "properties.enabled",
+			Expected: `true`,
+		},
+		{
+			Path:     "properties.capacity",
+			Expected: `2`,
+		},
+		{
+			Path:     "properties.subnets[0].name",
+			Expected: `"first"`,
+		},
+		{
+			Path:     "properties.subnets[-2].name",
+			Expected: `"second"`,
+		},
+		{
+			Path:     "properties.subnets[3]",
+			Expected: `null`,
+		},
+		{
+			Path:     "properties.subnets[*].name",
+			Expected: `["first","second"]`,
+		},
+		{
+			Path:     "properties.matrix[0][1]",
+			Expected: `"b"`,
+		},
+		{
+			Path:     "properties.doesNotExist",
+			Expected: `null`,
+		},
+		{
+			Path:     "location.nested",
+			Expected: `null`,
+		},
+		{
+			Path:     "sku[0]",
+			Expected: `null`,
+		},
+		{
+			Path:  "sku.",
+			Error: true,
+		},
+		{
+			Path:  "properties..enabled",
+			Error: true,
+		},
+		{
+			Path:  "properties.subnets[0",
+			Error: true,
+		},
+		{
+			Path:  "properties.subnets[first]",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Path)
+
+		actual, err := evaluateGenericResourcePath(input, v.Path)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expected no error but got: %+v", err)
+		}
+		if v.Error {
+			t.Fatalf("Expected an error but didn't get one")
+		}
+
+		result, err := json.Marshal(actual)
+		if err != nil {
+			t.Fatalf("serializing: %+v", err)
+		}
+		if string(result) != v.Expected {
+			t.Fatalf("Expected %s but got %s", v.Expected, string(result))
+		}
+	}
+}
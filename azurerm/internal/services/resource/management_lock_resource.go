@@ -3,12 +3,13 @@ package resource
 import (
 	"fmt"
 	"log"
-	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/resources/mgmt/2016-09-01/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
@@ -21,8 +22,10 @@ func resourceManagementLock() *pluginsdk.Resource {
 		Create: resourceManagementLockCreateUpdate,
 		Read:   resourceManagementLockRead,
 		Delete: resourceManagementLockDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ManagementLockID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -40,9 +43,10 @@ func resourceManagementLock() *pluginsdk.Resource {
 			},
 
 			"scope": {
-				Type:     pluginsdk.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateScopeID,
 			},
 
 			"lock_level": {
@@ -119,7 +123,7 @@ func resourceManagementLockRead(d *pluginsdk.ResourceData, meta interface{}) err
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseAzureRMLockId(d.Id())
+	id, err := parse.ManagementLockID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -149,7 +153,7 @@ func resourceManagementLockDelete(d *pluginsdk.ResourceData, meta interface{}) e
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
-	id, err := ParseAzureRMLockId(d.Id())
+	id, err := parse.ManagementLockID(d.Id())
 	if err != nil {
 		return err
 	}
@@ -165,23 +169,3 @@ func resourceManagementLockDelete(d *pluginsdk.ResourceData, meta interface{}) e
 
 	return nil
 }
-
-type AzureManagementLockId struct {
-	Scope string
-	Name  string
-}
-
-func ParseAzureRMLockId(id string) (*AzureManagementLockId, error) {
-	segments := strings.Split(id, "/providers/Microsoft.Authorization/locks/")
-	if len(segments) != 2 {
-		return nil, fmt.Errorf("Expected ID to be in the format `{scope}/providers/Microsoft.Authorization/locks/{name} - got %d segments", len(segments))
-	}
-
-	scope := segments[0]
-	name := segments[1]
-	lockId := AzureManagementLockId{
-		Scope: scope,
-		Name:  name,
-	}
-	return &lockId, nil
-}
@@ -0,0 +1,132 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+type GenericResourceId struct {
+	// ParentId is the ID of the scope within which this resource exists - which is either a Subscription,
+	// a Resource Group, or the Parent Resource (for a Child Resource or an Extension Resource)
+	ParentId string
+
+	// ResourceType is the Resource Type of this resource, e.g. `Microsoft.Network/virtualNetworks/subnets`
+	ResourceType string
+
+	// Name is the name of this resource
+	Name string
+}
+
+func NewGenericResourceID(parentId, resourceType, name string) GenericResourceId {
+	return GenericResourceId{
+		ParentId:     strings.TrimSuffix(parentId, "/"),
+		ResourceType: resourceType,
+		Name:         name,
+	}
+}
+
+func (id GenericResourceId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Parent ID %q", id.ParentId),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", id.ResourceType, segmentsStr)
+}
+
+func (id GenericResourceId) ID() string {
+	namespace, types := splitResourceType(id.ResourceType)
+
+	// Resource Groups are the only resource (other than a Subscription) which isn't within a Resource Provider
+	if strings.EqualFold(id.ResourceType, "Microsoft.Resources/resourceGroups") {
+		return fmt.Sprintf("%s/resourceGroups/%s", id.ParentId, id.Name)
+	}
+
+	// Child Resources are nested within the Parent Resource (which is within the same Resource Provider)
+	if len(types) > 1 {
+		return fmt.Sprintf("%s/%s/%s", id.ParentId, types[len(types)-1], id.Name)
+	}
+
+	return fmt.Sprintf("%s/providers/%s/%s/%s", id.ParentId, namespace, types[0], id.Name)
+}
+
+// ParentResourceType returns the Resource Type which the Parent ID is expected to be, for a Child
+// Resource (e.g. `Microsoft.Network/virtualNetworks` for `Microsoft.Network/virtualNetworks/subnets`)
+// or an empty string when this isn't a Child Resource
+func (id GenericResourceId) ParentResourceType() string {
+	namespace, types := splitResourceType(id.ResourceType)
+	if len(types) <= 1 {
+		return ""
+	}
+
+	return fmt.Sprintf("%s/%s", namespace, strings.Join(types[:len(types)-1], "/"))
+}
+
+// GenericResourceID parses a Resource ID for any Resource Type into a GenericResourceId struct
+func GenericResourceID(input string) (*GenericResourceId, error) {
+	if !strings.HasPrefix(input, "/") {
+		return nil, fmt.Errorf("ID was expected to start with a `/`")
+	}
+
+	segments := strings.Split(strings.TrimPrefix(input, "/"), "/")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("ID contained an empty segment")
+		}
+	}
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+	if len(segments)%2 != 0 {
+		return nil, fmt.Errorf("ID was expected to contain key/value pairs but got an odd number of segments")
+	}
+
+	// a Resource Group isn't within a Resource Provider
+	if len(segments) == 4 && strings.EqualFold(segments[2], "resourceGroups") {
+		return &GenericResourceId{
+			ParentId:     "/" + strings.Join(segments[:2], "/"),
+			ResourceType: "Microsoft.Resources/resourceGroups",
+			Name:         segments[3],
+		}, nil
+	}
+
+	// the Resource Type is defined by the last Resource Provider within the ID, since this could be an Extension Resource
+	providersIndex := -1
+	for i := 0; i < len(segments); i += 2 {
+		if strings.EqualFold(segments[i], "providers") {
+			providersIndex = i
+		}
+	}
+	if providersIndex == -1 {
+		return nil, fmt.Errorf("ID was missing the 'providers' element")
+	}
+
+	namespace := segments[providersIndex+1]
+	pairs := segments[providersIndex+2:]
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("ID was missing the Resource Type after the 'providers' element")
+	}
+
+	types := make([]string, 0)
+	for i := 0; i < len(pairs); i += 2 {
+		types = append(types, pairs[i])
+	}
+
+	parentSegments := segments[:providersIndex]
+	if len(types) > 1 {
+		parentSegments = segments[:len(segments)-2]
+	}
+
+	return &GenericResourceId{
+		ParentId:     "/" + strings.Join(parentSegments, "/"),
+		ResourceType: fmt.Sprintf("%s/%s", namespace, strings.Join(types, "/")),
+		Name:         segments[len(segments)-1],
+	}, nil
+}
+
+// splitResourceType splits the Resource Type (e.g. `Microsoft.Network/virtualNetworks/subnets`)
+// into the Resource Provider Namespace and the Types (e.g. `virtualNetworks` and `subnets`)
+func splitResourceType(input string) (string, []string) {
+	segments := strings.Split(input, "/")
+	return segments[0], segments[1:]
+}
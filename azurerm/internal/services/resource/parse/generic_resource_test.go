@@ -0,0 +1,282 @@
+package parse
+
+import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = GenericResourceId{}
+
+func TestGenericResourceIDFormatter(t *testing.T) {
+	testData := []struct {
+		Input    GenericResourceId
+		Expected string
+	}{
+		{
+			// Resource Group
+			Input:    NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012", "Microsoft.Resources/resourceGroups", "group1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+		},
+		{
+			// Subscription-level resource
+			Input:    NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012", "Microsoft.Authorization/policyDefinitions", "policy1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/policy1",
+		},
+		{
+			// Resource Group-level resource
+			Input:    NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/", "Microsoft.Network/virtualNetworks", "network1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+		},
+		{
+			// Child resource
+			Input:    NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1", "Microsoft.Network/virtualNetworks/subnets", "subnet1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+		},
+		{
+			// Extension resource
+			Input:    NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1", "Microsoft.Insights/diagnosticSettings", "setting1"),
+			Expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Expected)
+
+		actual := v.Input.ID()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestGenericResourceIDParentResourceType(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Expected string
+	}{
+		{
+			Input:    "Microsoft.Network/virtualNetworks",
+			Expected: "",
+		},
+		{
+			Input:    "Microsoft.Network/virtualNetworks/subnets",
+			Expected: "Microsoft.Network/virtualNetworks",
+		},
+		{
+			Input:    "Microsoft.Sql/servers/databases/backupShortTermRetentionPolicies",
+			Expected: "Microsoft.Sql/servers/databases",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual := NewGenericResourceID("/subscriptions/12345678-1234-9876-4563-123456789012", v.Input, "name").ParentResourceType()
+		if actual != v.Expected {
+			t.Fatalf("Expected %q but got %q", v.Expected, actual)
+		}
+	}
+}
+
+func TestGenericResourceID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *GenericResourceId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing SubscriptionId
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing value for SubscriptionId
+			Input: "/subscriptions/",
+			Error: true,
+		},
+
+		{
+			// Subscription
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012",
+			Error: true,
+		},
+
+		{
+			// missing value for Providers
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/",
+			Error: true,
+		},
+
+		{
+			// missing Resource Type
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Network",
+			Error: true,
+		},
+
+		{
+			// missing Name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+
+		{
+			// Resource Group
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012",
+				ResourceType: "Microsoft.Resources/resourceGroups",
+				Name:         "group1",
+			},
+		},
+
+		{
+			// Subscription-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/policyDefinitions/policy1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012",
+				ResourceType: "Microsoft.Authorization/policyDefinitions",
+				Name:         "policy1",
+			},
+		},
+
+		{
+			// Resource Group-level resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				ResourceType: "Microsoft.Network/virtualNetworks",
+				Name:         "network1",
+			},
+		},
+
+		{
+			// Child resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/subnets/subnet1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				ResourceType: "Microsoft.Network/virtualNetworks/subnets",
+				Name:         "subnet1",
+			},
+		},
+
+		{
+			// Extension resource
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1/providers/Microsoft.Insights/diagnosticSettings/setting1",
+			Expected: &GenericResourceId{
+				ParentId:     "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Network/virtualNetworks/network1",
+				ResourceType: "Microsoft.Insights/diagnosticSettings",
+				Name:         "setting1",
+			},
+		},
+
+		{
+			// empty segment
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups//providers/Microsoft.Network/virtualNetworks/network1",
+			Error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := GenericResourceID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.ParentId != v.Expected.ParentId {
+			t.Fatalf("Expected %q but got %q for ParentId", v.Expected.ParentId, actual.ParentId)
+		}
+		if actual.ResourceType != v.Expected.ResourceType {
+			t.Fatalf("Expected %q but got %q for ResourceType", v.Expected.ResourceType, actual.ResourceType)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+
+		// the ID should round-trip
+		if formatted := actual.ID(); formatted != v.Input {
+			t.Fatalf("Expected the ID to round-trip to %q but got %q", v.Input, formatted)
+		}
+	}
+}
+
+func TestResourceTypeWithApiVersion(t *testing.T) {
+	testData := []struct {
+		Input        string
+		Error        bool
+		ResourceType string
+		ApiVersion   string
+	}{
+		{
+			Input: "",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network/virtualNetworks",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network@2021-02-01",
+			Error: true,
+		},
+		{
+			Input: "Microsoft.Network/virtualNetworks@latest",
+			Error: true,
+		},
+		{
+			Input:        "Microsoft.Network/virtualNetworks@2021-02-01",
+			ResourceType: "Microsoft.Network/virtualNetworks",
+			ApiVersion:   "2021-02-01",
+		},
+		{
+			Input:        "Microsoft.Network/virtualNetworks/subnets@2021-02-01",
+			ResourceType: "Microsoft.Network/virtualNetworks/subnets",
+			ApiVersion:   "2021-02-01",
+		},
+		{
+			Input:        "Microsoft.ContainerService/managedClusters@2021-03-01-preview",
+			ResourceType: "Microsoft.ContainerService/managedClusters",
+			ApiVersion:   "2021-03-01-preview",
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		resourceType, apiVersion, err := ResourceTypeWithApiVersion(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if resourceType != v.ResourceType {
+			t.Fatalf("Expected %q but got %q for ResourceType", v.ResourceType, resourceType)
+		}
+		if apiVersion != v.ApiVersion {
+			t.Fatalf("Expected %q but got %q for ApiVersion", v.ApiVersion, apiVersion)
+		}
+	}
+}
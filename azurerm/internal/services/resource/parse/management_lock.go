@@ -0,0 +1,61 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+type ManagementLockId struct {
+	Scope string
+	Name  string
+}
+
+func NewManagementLockID(scope, name string) ManagementLockId {
+	return ManagementLockId{
+		Scope: scope,
+		Name:  name,
+	}
+}
+
+func (id ManagementLockId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Scope %q", id.Scope),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Management Lock", segmentsStr)
+}
+
+func (id ManagementLockId) ID() string {
+	fmtString := "/%s/providers/Microsoft.Authorization/locks/%s"
+	return fmt.Sprintf(fmtString, strings.TrimPrefix(id.Scope, "/"), id.Name)
+}
+
+// Segments returns the Segments which make up a ManagementLock ID
+func (id ManagementLockId) Segments() []resourceid.Segment {
+	return resourceid.ScopedSegments("Microsoft.Authorization", "locks")
+}
+
+// ScopedId returns this ManagementLock ID as a Scoped ID, which allows the Scope to be parsed
+func (id ManagementLockId) ScopedId() resourceid.ScopedId {
+	return resourceid.NewScopedID(id.Scope, "Microsoft.Authorization", "locks", id.Name)
+}
+
+// ManagementLockID parses a ManagementLock ID into an ManagementLockId struct
+func ManagementLockID(input string) (*ManagementLockId, error) {
+	result, err := resourceid.NewParser(ManagementLockId{}.Segments()).Parse(input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ManagementLockId{
+		Scope: result.Parsed["scope"],
+		Name:  result.Parsed["name"],
+	}
+
+	return &resourceId, nil
+}
@@ -0,0 +1,140 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+var _ resourceid.Formatter = ManagementLockId{}
+
+func TestManagementLockIDFormatter(t *testing.T) {
+	actual := NewManagementLockID("/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1", "name1").ID()
+	expected := "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/name1"
+	if actual != expected {
+		t.Fatalf("Expected %q but got %q", expected, actual)
+	}
+}
+
+func TestManagementLockID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *ManagementLockId
+	}{
+
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+
+		{
+			// missing scope
+			Input: "/",
+			Error: true,
+		},
+
+		{
+			// missing staticProviders
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/",
+			Error: true,
+		},
+
+		{
+			// missing provider
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/",
+			Error: true,
+		},
+
+		{
+			// missing resourceType
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/",
+			Error: true,
+		},
+
+		{
+			// missing name
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/",
+			Error: true,
+		},
+
+		{
+			// valid
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/name1",
+			Expected: &ManagementLockId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "name1",
+			},
+		},
+
+		{
+			// upper-cased segment names
+			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/PROVIDERS/MICROSOFT.AUTHORIZATION/LOCKS/name1",
+			Expected: &ManagementLockId{
+				Scope: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1",
+				Name:  "name1",
+			},
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.Input)
+
+		actual, err := ManagementLockID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if actual.Scope != v.Expected.Scope {
+			t.Fatalf("Expected %q but got %q for Scope", v.Expected.Scope, actual.Scope)
+		}
+		if actual.Name != v.Expected.Name {
+			t.Fatalf("Expected %q but got %q for Name", v.Expected.Name, actual.Name)
+		}
+	}
+}
+
+func TestManagementLockIDRoundTrip(t *testing.T) {
+	segments := ManagementLockId{}.Segments()
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		values := resourceid.RandomValues(segments, r)
+		input := NewManagementLockID(values["scope"], values["name"]).ID()
+
+		actual, err := ManagementLockID(input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", input, err)
+		}
+
+		if actual.Scope != values["scope"] {
+			t.Fatalf("Expected %q but got %q for Scope", values["scope"], actual.Scope)
+		}
+		if actual.Name != values["name"] {
+			t.Fatalf("Expected %q but got %q for Name", values["name"], actual.Name)
+		}
+
+		for _, invalid := range resourceid.InvalidResourceIds(segments, values) {
+			_, err := ManagementLockID(invalid.Input)
+			var segmentErr resourceid.SegmentError
+			if !errors.As(err, &segmentErr) {
+				t.Fatalf("Expected a SegmentError for %q but got: %+v", invalid.Input, err)
+			}
+			if segmentErr.Segment.Name != invalid.Segment {
+				t.Fatalf("Expected the segment %q to be invalid for %q but got %q", invalid.Segment, invalid.Input, segmentErr.Segment.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ResourceActionId is the ID of an Action (e.g. `listKeys`) invoked on a resource
+type ResourceActionId struct {
+	ResourceId string
+	Action     string
+}
+
+func NewResourceActionID(resourceId, action string) ResourceActionId {
+	return ResourceActionId{
+		ResourceId: strings.TrimSuffix(resourceId, "/"),
+		Action:     action,
+	}
+}
+
+func (id ResourceActionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Action %q", id.Action),
+		fmt.Sprintf("Resource ID %q", id.ResourceId),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Action", segmentsStr)
+}
+
+func (id ResourceActionId) ID() string {
+	return fmt.Sprintf("%s/%s", id.ResourceId, id.Action)
+}
@@ -39,7 +39,7 @@ func (id ResourceGroupTemplateDeploymentId) ID() string {
 
 // ResourceGroupTemplateDeploymentID parses a ResourceGroupTemplateDeployment ID into an ResourceGroupTemplateDeploymentId struct
 func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ResourceGroupTemplateDeploymentID(input string) (*ResourceGroupTemplateDepl
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
+	if resourceId.DeploymentName, err = id.PopSegmentInsensitively("deployments"); err != nil {
 		return nil, err
 	}
 
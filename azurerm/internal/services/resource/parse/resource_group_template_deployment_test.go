@@ -80,7 +80,11 @@ func TestResourceGroupTemplateDeploymentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/GROUP1/PROVIDERS/MICROSOFT.RESOURCES/DEPLOYMENTS/DEPLOY1",
-			Error: true,
+			Expected: &ResourceGroupTemplateDeploymentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "GROUP1",
+				DeploymentName: "DEPLOY1",
+			},
 		},
 	}
 
@@ -1,9 +1,12 @@
 package parse
 
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
 import (
 	"fmt"
+	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type ResourceProviderId struct {
@@ -18,33 +21,39 @@ func NewResourceProviderID(subscriptionId, resourceProvider string) ResourceProv
 	}
 }
 
+func (id ResourceProviderId) String() string {
+	segments := []string{
+		fmt.Sprintf("Resource Provider %q", id.ResourceProvider),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Resource Provider", segmentsStr)
+}
+
 func (id ResourceProviderId) ID() string {
 	fmtString := "/subscriptions/%s/providers/%s"
 	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceProvider)
 }
 
+// Segments returns the Segments which make up a ResourceProvider ID
+func (id ResourceProviderId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+		resourceid.StaticSegment("staticSubscriptions", "subscriptions"),
+		resourceid.UserSpecifiedSegment("subscriptionId", "12345678-1234-9876-4563-123456789012"),
+		resourceid.StaticSegment("staticProviders", "providers"),
+		resourceid.UserSpecifiedSegment("resourceProvider", "Instruments.Didgeridoo"),
+	}
+}
+
 // ResourceProviderID parses a ResourceProvider ID into an ResourceProviderId struct
 func ResourceProviderID(input string) (*ResourceProviderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	result, err := resourceid.NewParser(ResourceProviderId{}.Segments()).Parse(input, true)
 	if err != nil {
 		return nil, err
 	}
 
 	resourceId := ResourceProviderId{
-		SubscriptionId:   id.SubscriptionID,
-		ResourceProvider: id.Provider,
-	}
-
-	if resourceId.SubscriptionId == "" {
-		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
-	}
-
-	if resourceId.ResourceProvider == "" {
-		return nil, fmt.Errorf("ID was missing the 'providers' element")
-	}
-
-	if err := id.ValidateNoEmptySegments(input); err != nil {
-		return nil, err
+		SubscriptionId:   result.Parsed["subscriptionId"],
+		ResourceProvider: result.Parsed["resourceProvider"],
 	}
 
 	return &resourceId, nil
@@ -3,6 +3,8 @@ package parse
 // NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
 
 import (
+	"errors"
+	"math/rand"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
@@ -32,25 +34,25 @@ func TestResourceProviderID(t *testing.T) {
 		},
 
 		{
-			// missing SubscriptionId
+			// missing staticSubscriptions
 			Input: "/",
 			Error: true,
 		},
 
 		{
-			// missing value for SubscriptionId
+			// missing subscriptionId
 			Input: "/subscriptions/",
 			Error: true,
 		},
 
 		{
-			// missing Providers
+			// missing staticProviders
 			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/",
 			Error: true,
 		},
 
 		{
-			// missing value for Providers
+			// missing resourceProvider
 			Input: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/",
 			Error: true,
 		},
@@ -65,9 +67,12 @@ func TestResourceProviderID(t *testing.T) {
 		},
 
 		{
-			// upper-cased
-			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/INSTRUMENTS.DIDGERIDOO",
-			Error: true,
+			// upper-cased segment names
+			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/Instruments.Didgeridoo",
+			Expected: &ResourceProviderId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceProvider: "Instruments.Didgeridoo",
+			},
 		},
 	}
 
@@ -94,3 +99,36 @@ func TestResourceProviderID(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceProviderIDRoundTrip(t *testing.T) {
+	segments := ResourceProviderId{}.Segments()
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		values := resourceid.RandomValues(segments, r)
+		input := NewResourceProviderID(values["subscriptionId"], values["resourceProvider"]).ID()
+
+		actual, err := ResourceProviderID(input)
+		if err != nil {
+			t.Fatalf("parsing %q: %+v", input, err)
+		}
+
+		if actual.SubscriptionId != values["subscriptionId"] {
+			t.Fatalf("Expected %q but got %q for SubscriptionId", values["subscriptionId"], actual.SubscriptionId)
+		}
+		if actual.ResourceProvider != values["resourceProvider"] {
+			t.Fatalf("Expected %q but got %q for ResourceProvider", values["resourceProvider"], actual.ResourceProvider)
+		}
+
+		for _, invalid := range resourceid.InvalidResourceIds(segments, values) {
+			_, err := ResourceProviderID(invalid.Input)
+			var segmentErr resourceid.SegmentError
+			if !errors.As(err, &segmentErr) {
+				t.Fatalf("Expected a SegmentError for %q but got: %+v", invalid.Input, err)
+			}
+			if segmentErr.Segment.Name != invalid.Segment {
+				t.Fatalf("Expected the segment %q to be invalid for %q but got %q", invalid.Segment, invalid.Input, segmentErr.Segment.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package parse
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var resourceTypeWithApiVersionRegex = regexp.MustCompile(`^([A-Za-z0-9.]+/[A-Za-z0-9]+(/[A-Za-z0-9]+)*)@([0-9]{4}-[0-9]{2}-[0-9]{2}(-[A-Za-z0-9]+)?)$`)
+
+// ResourceTypeWithApiVersion parses a Resource Type in the format `Microsoft.Foo/bars@2021-01-01`
+// into the Resource Type (`Microsoft.Foo/bars`) and the API Version (`2021-01-01`)
+func ResourceTypeWithApiVersion(input string) (resourceType string, apiVersion string, err error) {
+	matches := resourceTypeWithApiVersionRegex.FindStringSubmatch(input)
+	if matches == nil {
+		return "", "", fmt.Errorf("expected the Resource Type to be in the format `{Namespace}/{Type}@{API Version}` (e.g. `Microsoft.Network/virtualNetworks@2021-02-01`) but got %q", input)
+	}
+
+	return matches[1], matches[3], nil
+}
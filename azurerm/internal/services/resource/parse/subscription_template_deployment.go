@@ -36,7 +36,7 @@ func (id SubscriptionTemplateDeploymentId) ID() string {
 
 // SubscriptionTemplateDeploymentID parses a SubscriptionTemplateDeployment ID into an SubscriptionTemplateDeploymentId struct
 func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func SubscriptionTemplateDeploymentID(input string) (*SubscriptionTemplateDeploy
 		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
 	}
 
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
+	if resourceId.DeploymentName, err = id.PopSegmentInsensitively("deployments"); err != nil {
 		return nil, err
 	}
 
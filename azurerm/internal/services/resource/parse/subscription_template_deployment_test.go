@@ -67,7 +67,10 @@ func TestSubscriptionTemplateDeploymentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.RESOURCES/DEPLOYMENTS/DEPLOY1",
-			Error: true,
+			Expected: &SubscriptionTemplateDeploymentId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				DeploymentName: "DEPLOY1",
+			},
 		},
 	}
 
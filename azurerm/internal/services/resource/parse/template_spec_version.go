@@ -42,7 +42,7 @@ func (id TemplateSpecVersionId) ID() string {
 
 // TemplateSpecVersionID parses a TemplateSpecVersion ID into an TemplateSpecVersionId struct
 func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func TemplateSpecVersionID(input string) (*TemplateSpecVersionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.TemplateSpecName, err = id.PopSegment("templateSpecs"); err != nil {
+	if resourceId.TemplateSpecName, err = id.PopSegmentInsensitively("templateSpecs"); err != nil {
 		return nil, err
 	}
-	if resourceId.VersionName, err = id.PopSegment("versions"); err != nil {
+	if resourceId.VersionName, err = id.PopSegmentInsensitively("versions"); err != nil {
 		return nil, err
 	}
 
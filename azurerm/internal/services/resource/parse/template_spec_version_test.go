@@ -93,7 +93,12 @@ func TestTemplateSpecVersionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/TEMPLATESPECRG/PROVIDERS/MICROSOFT.RESOURCES/TEMPLATESPECS/TEMPLATESPEC1/VERSIONS/V1.0",
-			Error: true,
+			Expected: &TemplateSpecVersionId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "TEMPLATESPECRG",
+				TemplateSpecName: "TEMPLATESPEC1",
+				VersionName:      "V1.0",
+			},
 		},
 	}
 
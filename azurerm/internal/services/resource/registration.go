@@ -50,12 +50,17 @@ func (r Registration) PackagePath() string {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		GenericResourceDataSource{},
+		ResourceActionDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
+		GenericResource{},
+		ResourceActionResource{},
 		ResourceProviderRegistrationResource{},
 	}
 }
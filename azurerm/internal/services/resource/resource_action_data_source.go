@@ -0,0 +1,97 @@
+package resource
+
+import (
+	"context"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var _ sdk.DataSource = ResourceActionDataSource{}
+
+// ResourceActionDataSource invokes an Action (e.g. `listKeys`) on a resource each time it's read, and as such
+// should only be used for Actions which don't make any changes
+type ResourceActionDataSource struct{}
+
+type ResourceActionDataSourceModel struct {
+	ResourceId string `tfschema:"resource_id"`
+	Action     string `tfschema:"action"`
+	Type       string `tfschema:"type"`
+	Body       string `tfschema:"body"`
+	Output     string `tfschema:"output"`
+}
+
+func (r ResourceActionDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceActionName,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceTypeWithApiVersion,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+	}
+}
+
+func (r ResourceActionDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ResourceActionDataSource) ModelObject() interface{} {
+	return ResourceActionDataSourceModel{}
+}
+
+func (r ResourceActionDataSource) ResourceType() string {
+	return "azurerm_resource_action"
+}
+
+func (r ResourceActionDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ResourceActionDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			id := parse.NewResourceActionID(config.ResourceId, config.Action)
+			output, err := invokeResourceAction(ctx, metadata.Client, id, config.Type, config.Body)
+			if err != nil {
+				return err
+			}
+
+			config.Output = *output
+			metadata.SetID(id)
+			return metadata.Encode(&config)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
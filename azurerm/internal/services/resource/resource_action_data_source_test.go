@@ -0,0 +1,39 @@
+package resource_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type ResourceActionDataSource struct {
+}
+
+func TestAccResourceActionDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource_action", "test")
+	r := ResourceActionDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").MatchesRegex(regexp.MustCompile(`"keys"`)),
+			),
+		},
+	})
+}
+
+func (ResourceActionDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "listKeys"
+  type        = "Microsoft.Storage/storageAccounts@2021-04-01"
+}
+`, ResourceActionResource{}.template(data))
+}
@@ -0,0 +1,213 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var _ sdk.Resource = ResourceActionResource{}
+
+// ResourceActionResource invokes an Action (e.g. `regenerateKey`) on a resource when it's created, or when any
+// of the arguments (including the `triggers`) change. Since an Action can't be undone, deleting this resource
+// only removes it from the State.
+type ResourceActionResource struct{}
+
+type ResourceActionModel struct {
+	ResourceId string            `tfschema:"resource_id"`
+	Action     string            `tfschema:"action"`
+	Type       string            `tfschema:"type"`
+	Body       string            `tfschema:"body"`
+	Triggers   map[string]string `tfschema:"triggers"`
+	Output     string            `tfschema:"output"`
+}
+
+func (r ResourceActionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"action": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceActionName,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ResourceTypeWithApiVersion,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+
+		"triggers": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r ResourceActionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		// the response from an Action commonly contains secrets (e.g. `listKeys`)
+		"output": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r ResourceActionResource) ModelObject() interface{} {
+	return ResourceActionModel{}
+}
+
+func (r ResourceActionResource) ResourceType() string {
+	return "azurerm_resource_action"
+}
+
+func (r ResourceActionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config ResourceActionModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			id := parse.NewResourceActionID(config.ResourceId, config.Action)
+			metadata.Logger.Infof("invoking %s..", id)
+			output, err := invokeResourceAction(ctx, metadata.Client, id, config.Type, config.Body)
+			if err != nil {
+				return err
+			}
+
+			config.Output = *output
+			if err := metadata.Encode(&config); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var state ResourceActionModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id := parse.NewResourceActionID(state.ResourceId, state.Action)
+			_, apiVersion, err := parse.ResourceTypeWithApiVersion(state.Type)
+			if err != nil {
+				return err
+			}
+
+			// the Action is invoked again if the resource it was invoked on is recreated
+			resp, err := client.Get(ctx, id.ResourceId, apiVersion)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %q: %+v", id.ResourceId, err)
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// an Action can't be undone, so this is only removed from the State
+			metadata.Logger.Infof("removing %s from the state..", metadata.ResourceData.Id())
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r ResourceActionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		errors = append(errors, fmt.Errorf("%s can't be imported since the Action is invoked when this resource is created", r.ResourceType()))
+		return
+	}
+}
+
+// invokeResourceAction invokes the Action on the resource using the API Version from the specified Resource
+// Type - and returns the Response Body as a JSON string
+func invokeResourceAction(ctx context.Context, client *clients.Client, id parse.ResourceActionId, resourceTypeWithApiVersion string, body string) (*string, error) {
+	resourceType, apiVersion, err := parse.ResourceTypeWithApiVersion(resourceTypeWithApiVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId, err := parse.GenericResourceID(id.ResourceId)
+	if err != nil {
+		return nil, fmt.Errorf("parsing `resource_id`: %+v", err)
+	}
+	if !strings.EqualFold(resourceId.ResourceType, resourceType) {
+		return nil, fmt.Errorf("the `type` %q doesn't match the Resource Type of the `resource_id` %q", resourceType, resourceId.ResourceType)
+	}
+
+	var payload map[string]interface{}
+	if body != "" {
+		payload, err = expandGenericResourceBody(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := client.Resource.GenericResourcesClient.Action(ctx, id.ResourceId, id.Action, apiVersion, payload)
+	if err != nil {
+		return nil, fmt.Errorf("invoking %s: %+v", id, err)
+	}
+
+	output := ""
+	if resp.Body != nil {
+		result, err := json.Marshal(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("serializing the Response Body for %s: %+v", id, err)
+		}
+		output = string(result)
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,108 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ResourceActionResource struct {
+}
+
+func TestAccResourceAction_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("output").MatchesRegex(regexp.MustCompile(`"keys"`)),
+			),
+		},
+	})
+}
+
+func TestAccResourceAction_triggers(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_action", "test")
+	r := ResourceActionResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.basic(data, "2"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (ResourceActionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	resourceId := state.Attributes["resource_id"]
+	apiVersion := state.Attributes["type"][strings.LastIndex(state.Attributes["type"], "@")+1:]
+
+	// the Action exists for as long as the resource it was invoked on exists
+	resp, err := client.Resource.GenericResourcesClient.Get(ctx, resourceId, apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %q: %+v", resourceId, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (ResourceActionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
+func (r ResourceActionResource) basic(data acceptance.TestData, rotation string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource_action" "test" {
+  resource_id = azurerm_storage_account.test.id
+  action      = "regenerateKey"
+  type        = "Microsoft.Storage/storageAccounts@2021-04-01"
+  body = jsonencode({
+    keyName = "key1"
+  })
+
+  triggers = {
+    rotation = %q
+  }
+}
+`, r.template(data), rotation)
+}
@@ -0,0 +1,222 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var _ sdk.DataSource = GenericResourceDataSource{}
+
+type GenericResourceDataSource struct{}
+
+type GenericResourceDataSourceModel struct {
+	ResourceId string                           `tfschema:"resource_id"`
+	Type       string                           `tfschema:"type"`
+	Query      []GenericResourceDataSourceQuery `tfschema:"query"`
+	Output     string                           `tfschema:"output"`
+}
+
+type GenericResourceDataSourceQuery struct {
+	Path        string  `tfschema:"path"`
+	ValueType   string  `tfschema:"type"`
+	StringValue string  `tfschema:"string_value"`
+	NumberValue float64 `tfschema:"number_value"`
+	BoolValue   bool    `tfschema:"bool_value"`
+	JsonValue   string  `tfschema:"json_value"`
+}
+
+const (
+	genericResourceQueryTypeArray  = "array"
+	genericResourceQueryTypeBool   = "bool"
+	genericResourceQueryTypeNull   = "null"
+	genericResourceQueryTypeNumber = "number"
+	genericResourceQueryTypeObject = "object"
+	genericResourceQueryTypeString = "string"
+)
+
+func (r GenericResourceDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceTypeWithApiVersion,
+		},
+
+		"query": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"path": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validateGenericResourcePath,
+					},
+
+					"type": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"string_value": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"number_value": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"bool_value": {
+						Type:     pluginsdk.TypeBool,
+						Computed: true,
+					},
+
+					"json_value": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r GenericResourceDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r GenericResourceDataSource) ModelObject() interface{} {
+	return GenericResourceDataSourceModel{}
+}
+
+func (r GenericResourceDataSource) ResourceType() string {
+	return "azurerm_resource"
+}
+
+func (r GenericResourceDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var config GenericResourceDataSourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			resourceType, apiVersion, err := parse.ResourceTypeWithApiVersion(config.Type)
+			if err != nil {
+				return err
+			}
+
+			id, err := parse.GenericResourceID(config.ResourceId)
+			if err != nil {
+				return fmt.Errorf("parsing `resource_id`: %+v", err)
+			}
+			if !strings.EqualFold(id.ResourceType, resourceType) {
+				return fmt.Errorf("the `type` %q doesn't match the Resource Type of the `resource_id` %q", resourceType, id.ResourceType)
+			}
+
+			resp, err := client.Get(ctx, id.ID(), apiVersion)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			output, err := json.Marshal(resp.Body)
+			if err != nil {
+				return fmt.Errorf("flattening `output`: %+v", err)
+			}
+			config.Output = string(output)
+
+			for i, query := range config.Query {
+				value, err := evaluateGenericResourcePath(resp.Body, query.Path)
+				if err != nil {
+					return fmt.Errorf("evaluating the `path` %q: %+v", query.Path, err)
+				}
+
+				config.Query[i], err = flattenGenericResourceDataSourceQuery(query.Path, value)
+				if err != nil {
+					return fmt.Errorf("flattening the value for the `path` %q: %+v", query.Path, err)
+				}
+			}
+
+			metadata.SetID(id)
+			return metadata.Encode(&config)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+// flattenGenericResourceDataSourceQuery returns the value for the Path Expression, which is exposed both as a
+// typed value (for strings, numbers and booleans) and as JSON (for all types) - along with the type of the value
+func flattenGenericResourceDataSourceQuery(path string, value interface{}) (GenericResourceDataSourceQuery, error) {
+	output := GenericResourceDataSourceQuery{
+		Path: path,
+	}
+
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return output, err
+	}
+	output.JsonValue = string(jsonValue)
+
+	switch v := value.(type) {
+	case nil:
+		output.ValueType = genericResourceQueryTypeNull
+	case string:
+		output.ValueType = genericResourceQueryTypeString
+		output.StringValue = v
+	case float64:
+		output.ValueType = genericResourceQueryTypeNumber
+		output.NumberValue = v
+	case bool:
+		output.ValueType = genericResourceQueryTypeBool
+		output.BoolValue = v
+	case []interface{}:
+		output.ValueType = genericResourceQueryTypeArray
+	default:
+		output.ValueType = genericResourceQueryTypeObject
+	}
+
+	return output, nil
+}
+
+func validateGenericResourcePath(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := parseGenericResourcePath(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+	}
+
+	return warnings, errors
+}
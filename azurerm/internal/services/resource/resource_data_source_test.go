@@ -0,0 +1,64 @@
+package resource_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+)
+
+type GenericResourceDataSource struct {
+}
+
+func TestAccGenericResourceDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_resource", "test")
+	r := GenericResourceDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("output").Exists(),
+				check.That(data.ResourceName).Key("query.0.type").HasValue("string"),
+				check.That(data.ResourceName).Key("query.0.string_value").HasValue(azure.NormalizeLocation(data.Locations.Primary)),
+				check.That(data.ResourceName).Key("query.1.type").HasValue("array"),
+				check.That(data.ResourceName).Key("query.1.json_value").HasValue(`["10.0.0.0/16"]`),
+			),
+		},
+	})
+}
+
+func (GenericResourceDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+data "azurerm_resource" "test" {
+  resource_id = azurerm_virtual_network.test.id
+  type        = "Microsoft.Network/virtualNetworks@2020-11-01"
+
+  query {
+    path = "location"
+  }
+
+  query {
+    path = "properties.addressSpace.addressPrefixes"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
@@ -0,0 +1,376 @@
+package resource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/sdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var _ sdk.Resource = GenericResource{}
+var _ sdk.ResourceWithUpdate = GenericResource{}
+var _ sdk.ResourceWithCustomizeDiff = GenericResource{}
+var _ sdk.ResourceWithCustomImporter = GenericResource{}
+
+// genericResourceImportApiVersionSeparator separates the Resource ID and the API Version used to import a
+// Generic Resource, since the API Version can't be determined from the Resource ID
+const genericResourceImportApiVersionSeparator = "?api-version="
+
+type GenericResource struct{}
+
+type GenericResourceModel struct {
+	Name     string `tfschema:"name"`
+	ParentId string `tfschema:"parent_id"`
+	Type     string `tfschema:"type"`
+	Body     string `tfschema:"body"`
+	Output   string `tfschema:"output"`
+}
+
+func (r GenericResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"parent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		// NOTE: changing the Resource Type forces a new resource, however the API Version can be
+		// updated in-place - which is handled in the CustomizeDiff
+		"type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validate.ResourceTypeWithApiVersion,
+		},
+
+		"body": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsJSON,
+			StateFunc:    utils.NormalizeJson,
+		},
+	}
+}
+
+func (r GenericResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"output": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r GenericResource) ModelObject() interface{} {
+	return GenericResourceModel{}
+}
+
+func (r GenericResource) ResourceType() string {
+	return "azurerm_resource"
+}
+
+func (r GenericResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			var config GenericResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			resourceType, apiVersion, err := parse.ResourceTypeWithApiVersion(config.Type)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewGenericResourceID(config.ParentId, resourceType, config.Name)
+			if err := r.validateParentId(id); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, id.ID(), apiVersion)
+			if err != nil {
+				if !utils.ResponseWasNotFound(existing.Response) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			body, err := expandGenericResourceBody(config.Body)
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("creating %s..", id)
+			if err := client.CreateOrUpdate(ctx, id.ID(), apiVersion, body); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r GenericResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			id, err := parse.GenericResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GenericResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			_, apiVersion, err := parse.ResourceTypeWithApiVersion(state.Type)
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ID(), apiVersion)
+			if err != nil {
+				if utils.ResponseWasNotFound(resp.Response) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			// only the fields which are specified in the configuration are compared, since the API can
+			// return additional fields (e.g. read-only fields, or default values)
+			body, err := flattenGenericResourceBody(state.Body, resp.Body)
+			if err != nil {
+				return fmt.Errorf("flattening `body`: %+v", err)
+			}
+
+			output, err := json.Marshal(resp.Body)
+			if err != nil {
+				return fmt.Errorf("flattening `output`: %+v", err)
+			}
+
+			return metadata.Encode(&GenericResourceModel{
+				Name:     id.Name,
+				ParentId: id.ParentId,
+				Type:     state.Type,
+				Body:     body,
+				Output:   string(output),
+			})
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r GenericResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			id, err := parse.GenericResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config GenericResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return err
+			}
+
+			_, apiVersion, err := parse.ResourceTypeWithApiVersion(config.Type)
+			if err != nil {
+				return err
+			}
+
+			body, err := expandGenericResourceBody(config.Body)
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("updating %s..", id)
+			if err := client.CreateOrUpdate(ctx, id.ID(), apiVersion, body); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r GenericResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.GenericResourcesClient
+
+			id, err := parse.GenericResourceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state GenericResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			_, apiVersion, err := parse.ResourceTypeWithApiVersion(state.Type)
+			if err != nil {
+				return err
+			}
+
+			metadata.Logger.Infof("deleting %s..", id)
+			if err := client.Delete(ctx, id.ID(), apiVersion); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r GenericResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+			if diff.Id() == "" || !diff.HasChange("type") {
+				return nil
+			}
+
+			old, new := diff.GetChange("type")
+			oldResourceType, _, err := parse.ResourceTypeWithApiVersion(old.(string))
+			if err != nil {
+				// the existing value can't be parsed, so we can't determine what's changed
+				return diff.ForceNew("type")
+			}
+			newResourceType, _, err := parse.ResourceTypeWithApiVersion(new.(string))
+			if err != nil {
+				// the new value will be validated by the ValidateFunc
+				return nil
+			}
+
+			if !strings.EqualFold(oldResourceType, newResourceType) {
+				return diff.ForceNew("type")
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r GenericResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return func(i interface{}, k string) (warnings []string, errors []error) {
+		v, ok := i.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected %q to be a string", k))
+			return
+		}
+
+		resourceId, apiVersion, err := splitGenericResourceImportId(v)
+		if err != nil {
+			errors = append(errors, err)
+			return
+		}
+		if apiVersion == "" {
+			errors = append(errors, fmt.Errorf("expected %q to be in the format `{resourceId}%s{apiVersion}`", k, genericResourceImportApiVersionSeparator))
+			return
+		}
+
+		return validate.GenericResourceID(resourceId, k)
+	}
+}
+
+func (r GenericResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		client := metadata.Client.Resource.GenericResourcesClient
+
+		resourceId, apiVersion, err := splitGenericResourceImportId(metadata.ResourceData.Id())
+		if err != nil {
+			return err
+		}
+
+		id, err := parse.GenericResourceID(resourceId)
+		if err != nil {
+			return err
+		}
+
+		resourceType := fmt.Sprintf("%s@%s", id.ResourceType, apiVersion)
+		if _, _, err := parse.ResourceTypeWithApiVersion(resourceType); err != nil {
+			return fmt.Errorf("importing %s: %+v", id, err)
+		}
+
+		resp, err := client.Get(ctx, id.ID(), apiVersion)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("importing %s: the resource was not found", id)
+			}
+
+			return fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		// the Resource ID and the Resource Type are stored without the API Version
+		metadata.ResourceData.SetId(id.ID())
+		return metadata.ResourceData.Set("type", resourceType)
+	}
+}
+
+// validateParentId validates that the Parent ID is of the Resource Type expected by a Child Resource,
+// for example that the Parent of a Subnet is a Virtual Network
+func (r GenericResource) validateParentId(id parse.GenericResourceId) error {
+	expected := id.ParentResourceType()
+	if expected == "" {
+		return nil
+	}
+
+	parent, err := parse.GenericResourceID(id.ParentId)
+	if err != nil {
+		return fmt.Errorf("parsing `parent_id`: %+v", err)
+	}
+
+	if !strings.EqualFold(parent.ResourceType, expected) {
+		return fmt.Errorf("the Resource Type %q must be nested within a %q but the `parent_id` is a %q", id.ResourceType, expected, parent.ResourceType)
+	}
+
+	return nil
+}
+
+// splitGenericResourceImportId splits the ID used to import a Generic Resource into the Resource ID
+// and the API Version (when specified)
+func splitGenericResourceImportId(input string) (resourceId string, apiVersion string, err error) {
+	idx := strings.Index(input, genericResourceImportApiVersionSeparator)
+	if idx == -1 {
+		return input, "", nil
+	}
+
+	resourceId = input[:idx]
+	apiVersion = input[idx+len(genericResourceImportApiVersionSeparator):]
+	if apiVersion == "" {
+		return "", "", fmt.Errorf("the API Version was empty")
+	}
+
+	return resourceId, apiVersion, nil
+}
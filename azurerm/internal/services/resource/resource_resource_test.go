@@ -0,0 +1,213 @@
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type GenericResource struct {
+}
+
+func TestAccGenericResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "2020-11-01"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data, "2020-11-01"),
+	})
+}
+
+func TestAccGenericResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "2020-11-01"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccGenericResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "2020-11-01"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data, "2021-02-01"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data, "2021-02-01"),
+		{
+			Config: r.basic(data, "2021-02-01"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func TestAccGenericResource_childResource(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource", "test")
+	r := GenericResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.childResource(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		r.importStep(data, "2020-11-01"),
+	})
+}
+
+func (GenericResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.GenericResourceID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiVersion := state.Attributes["type"][strings.LastIndex(state.Attributes["type"], "@")+1:]
+	resp, err := client.Resource.GenericResourcesClient.Get(ctx, id.ID(), apiVersion)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return utils.Bool(false), nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+// importStep imports the resource using the specified API Version - since the `body` contains all
+// of the fields returned from the API when imported, this is ignored
+func (GenericResource) importStep(data acceptance.TestData, apiVersion string) acceptance.TestStep {
+	step := data.ImportStep("body")
+	step.ImportStateIdFunc = func(state *acceptance.State) (string, error) {
+		rs, ok := state.RootModule().Resources[data.ResourceName]
+		if !ok {
+			return "", fmt.Errorf("%q was not found in the state", data.ResourceName)
+		}
+
+		return fmt.Sprintf("%s?api-version=%s", rs.Primary.ID, apiVersion), nil
+	}
+	return step
+}
+
+func (GenericResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = %q
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r GenericResource) basic(data acceptance.TestData, apiVersion string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "test" {
+  name      = "acctestvn-%d"
+  parent_id = azurerm_resource_group.test.id
+  type      = "Microsoft.Network/virtualNetworks@%s"
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16"]
+      }
+    }
+  })
+}
+`, r.template(data), data.RandomInteger, apiVersion)
+}
+
+func (r GenericResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "import" {
+  name      = azurerm_resource.test.name
+  parent_id = azurerm_resource.test.parent_id
+  type      = azurerm_resource.test.type
+  body      = azurerm_resource.test.body
+}
+`, r.basic(data, "2020-11-01"))
+}
+
+func (r GenericResource) complete(data acceptance.TestData, apiVersion string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_resource" "test" {
+  name      = "acctestvn-%d"
+  parent_id = azurerm_resource_group.test.id
+  type      = "Microsoft.Network/virtualNetworks@%s"
+  body = jsonencode({
+    location = azurerm_resource_group.test.location
+    properties = {
+      addressSpace = {
+        addressPrefixes = ["10.0.0.0/16", "10.1.0.0/16"]
+      }
+    }
+    tags = {
+      environment = "Production"
+    }
+  })
+}
+`, r.template(data), data.RandomInteger, apiVersion)
+}
+
+func (r GenericResource) childResource(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_resource" "test" {
+  name      = "internal"
+  parent_id = azurerm_virtual_network.test.id
+  type      = "Microsoft.Network/virtualNetworks/subnets@2020-11-01"
+  body = jsonencode({
+    properties = {
+      addressPrefix = "10.0.2.0/24"
+    }
+  })
+}
+`, r.template(data), data.RandomInteger)
+}
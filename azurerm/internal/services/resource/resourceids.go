@@ -4,5 +4,7 @@ package resource
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceGroupTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Resources/deployments/deploy1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=SubscriptionTemplateDeployment -id=/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Resources/deployments/deploy1
 //go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=TemplateSpecVersion -id=/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/templateSpecRG/providers/Microsoft.Resources/templateSpecs/templateSpec1/versions/v1.0
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ResourceProvider -segments=static:subscriptions,user:subscriptionId=12345678-1234-9876-4563-123456789012,static:providers,user:resourceProvider=Instruments.Didgeridoo
+//go:generate go run ../../tools/generator-resource-id/main.go -path=./ -name=ManagementLock -scoped=Microsoft.Authorization/locks
 
-// ResourceProvider is manually maintained since the generator doesn't support outputting this information at this time
+// GenericResource is manually maintained since this can be any Resource Type within any scope
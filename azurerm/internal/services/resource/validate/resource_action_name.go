@@ -0,0 +1,22 @@
+package validate
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// ResourceActionName validates that the specified value is the name of an Action which can be
+// invoked on a resource, e.g. `listKeys` or `regenerateKey`
+func ResourceActionName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if !regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`).MatchString(v) {
+		errors = append(errors, fmt.Errorf("%q must start with a letter and can only contain letters and numbers, got %q", k, v))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,49 @@
+package validate
+
+import "testing"
+
+func TestResourceActionName(t *testing.T) {
+	cases := []struct {
+		Input string
+		Valid bool
+	}{
+		{
+			Input: "",
+			Valid: false,
+		},
+		{
+			Input: "listKeys",
+			Valid: true,
+		},
+		{
+			Input: "regenerateKey",
+			Valid: true,
+		},
+		{
+			Input: "listKeys2",
+			Valid: true,
+		},
+		{
+			Input: "2listKeys",
+			Valid: false,
+		},
+		{
+			Input: "list/keys",
+			Valid: false,
+		},
+		{
+			Input: "listKeys?api-version=2021-01-01",
+			Valid: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %s", tc.Input)
+		_, errors := ResourceActionName(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %t but got %t", tc.Valid, valid)
+		}
+	}
+}
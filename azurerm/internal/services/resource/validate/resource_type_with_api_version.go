@@ -0,0 +1,24 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/resource/parse"
+)
+
+// ResourceTypeWithApiVersion validates that the specified value is a Resource Type with an API Version,
+// e.g. `Microsoft.Network/virtualNetworks@2021-02-01`
+func ResourceTypeWithApiVersion(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, _, err := parse.ResourceTypeWithApiVersion(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is invalid: %+v", k, err))
+		return
+	}
+
+	return warnings, errors
+}
@@ -73,7 +73,7 @@ func TestTemplateSpecVersionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/TEMPLATESPECRG/PROVIDERS/MICROSOFT.RESOURCES/TEMPLATESPECS/TEMPLATESPEC1/VERSIONS/V1.0",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -39,7 +39,7 @@ func (id SearchServiceId) ID() string {
 
 // SearchServiceID parses a SearchService ID into an SearchServiceId struct
 func SearchServiceID(input string) (*SearchServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SearchServiceID(input string) (*SearchServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("searchServices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("searchServices"); err != nil {
 		return nil, err
 	}
 
@@ -2,9 +2,8 @@ package parse
 
 import (
 	"fmt"
-	"strings"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 type AssessmentId struct {
@@ -25,18 +24,13 @@ func (id AssessmentId) ID() string {
 }
 
 func AssessmentID(input string) (*AssessmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := resourceid.ParseScopedID(input, "Microsoft.Security", "assessments")
 	if err != nil {
 		return nil, fmt.Errorf("parsing Security Assessment ID %q: %+v", input, err)
 	}
 
-	parts := strings.Split(input, "/providers/Microsoft.Security/assessments/")
-	if len(parts) != 2 {
-		return nil, fmt.Errorf("parsing Security Assessment ID: %q", id)
-	}
-
 	return &AssessmentId{
-		TargetResourceID: parts[0],
-		Name:             parts[1],
+		TargetResourceID: id.Scope,
+		Name:             id.Name,
 	}, nil
 }
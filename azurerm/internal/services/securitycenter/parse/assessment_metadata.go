@@ -36,7 +36,7 @@ func (id AssessmentMetadataId) ID() string {
 
 // AssessmentMetadataID parses a AssessmentMetadata ID into an AssessmentMetadataId struct
 func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +49,7 @@ func AssessmentMetadataID(input string) (*AssessmentMetadataId, error) {
 		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
 	}
 
-	if resourceId.AssessmentMetadataName, err = id.PopSegment("assessmentMetadata"); err != nil {
+	if resourceId.AssessmentMetadataName, err = id.PopSegmentInsensitively("assessmentMetadata"); err != nil {
 		return nil, err
 	}
 
@@ -67,7 +67,10 @@ func TestAssessmentMetadataID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/PROVIDERS/MICROSOFT.SECURITY/ASSESSMENTMETADATA/METADATA1",
-			Error: true,
+			Expected: &AssessmentMetadataId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				AssessmentMetadataName: "METADATA1",
+			},
 		},
 	}
 
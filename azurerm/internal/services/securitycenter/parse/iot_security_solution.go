@@ -39,7 +39,7 @@ func (id IotSecuritySolutionId) ID() string {
 
 // IotSecuritySolutionID parses a IotSecuritySolution ID into an IotSecuritySolutionId struct
 func IotSecuritySolutionID(input string) (*IotSecuritySolutionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func IotSecuritySolutionID(input string) (*IotSecuritySolutionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("IoTSecuritySolutions"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("IoTSecuritySolutions"); err != nil {
 		return nil, err
 	}
 
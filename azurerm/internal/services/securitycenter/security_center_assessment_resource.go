@@ -48,7 +48,7 @@ func resourceSecurityCenterAssessment() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: azure.ValidateResourceID,
+				ValidateFunc: azure.ValidateResourceScopeID,
 			},
 
 			"status": {
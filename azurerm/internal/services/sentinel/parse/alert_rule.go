@@ -42,7 +42,7 @@ func (id AlertRuleId) ID() string {
 
 // AlertRuleID parses a AlertRule ID into an AlertRuleId struct
 func AlertRuleID(input string) (*AlertRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AlertRuleID(input string) (*AlertRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("alertRules"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("alertRules"); err != nil {
 		return nil, err
 	}
 
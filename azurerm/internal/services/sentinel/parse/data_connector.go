@@ -42,7 +42,7 @@ func (id DataConnectorId) ID() string {
 
 // DataConnectorID parses a DataConnector ID into an DataConnectorId struct
 func DataConnectorID(input string) (*DataConnectorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func DataConnectorID(input string) (*DataConnectorId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("dataConnectors"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("dataConnectors"); err != nil {
 		return nil, err
 	}
 
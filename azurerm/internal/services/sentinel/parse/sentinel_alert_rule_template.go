@@ -42,7 +42,7 @@ func (id SentinelAlertRuleTemplateId) ID() string {
 
 // SentinelAlertRuleTemplateID parses a SentinelAlertRuleTemplate ID into an SentinelAlertRuleTemplateId struct
 func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SentinelAlertRuleTemplateID(input string) (*SentinelAlertRuleTemplateId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.AlertRuleTemplateName, err = id.PopSegment("AlertRuleTemplates"); err != nil {
+	if resourceId.AlertRuleTemplateName, err = id.PopSegmentInsensitively("AlertRuleTemplates"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id NamespaceNetworkRuleSetId) ID() string {
 
 // NamespaceNetworkRuleSetID parses a NamespaceNetworkRuleSet ID into an NamespaceNetworkRuleSetId struct
 func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NamespaceNetworkRuleSetID(input string) (*NamespaceNetworkRuleSetId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.NetworkrulesetName, err = id.PopSegment("networkrulesets"); err != nil {
+	if resourceId.NetworkrulesetName, err = id.PopSegmentInsensitively("networkrulesets"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestNamespaceNetworkRuleSetID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/NAMESPACE1/NETWORKRULESETS/NETWORKRULESET1",
-			Error: true,
+			Expected: &NamespaceNetworkRuleSetId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				NamespaceName:      "NAMESPACE1",
+				NetworkrulesetName: "NETWORKRULESET1",
+			},
 		},
 	}
 
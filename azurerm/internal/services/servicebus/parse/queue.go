@@ -42,7 +42,7 @@ func (id QueueId) ID() string {
 
 // QueueID parses a Queue ID into an QueueId struct
 func QueueID(input string) (*QueueId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func QueueID(input string) (*QueueId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("queues"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("queues"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id QueueAuthorizationRuleId) ID() string {
 
 // QueueAuthorizationRuleID parses a QueueAuthorizationRule ID into an QueueAuthorizationRuleId struct
 func QueueAuthorizationRuleID(input string) (*QueueAuthorizationRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func QueueAuthorizationRuleID(input string) (*QueueAuthorizationRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.QueueName, err = id.PopSegment("queues"); err != nil {
+	if resourceId.QueueName, err = id.PopSegmentInsensitively("queues"); err != nil {
 		return nil, err
 	}
-	if resourceId.AuthorizationRuleName, err = id.PopSegment("authorizationRules"); err != nil {
+	if resourceId.AuthorizationRuleName, err = id.PopSegmentInsensitively("authorizationRules"); err != nil {
 		return nil, err
 	}
 
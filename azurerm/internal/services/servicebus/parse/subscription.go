@@ -45,7 +45,7 @@ func (id SubscriptionId) ID() string {
 
 // SubscriptionID parses a Subscription ID into an SubscriptionId struct
 func SubscriptionID(input string) (*SubscriptionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SubscriptionID(input string) (*SubscriptionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("subscriptions"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("subscriptions"); err != nil {
 		return nil, err
 	}
 
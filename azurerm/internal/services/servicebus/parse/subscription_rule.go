@@ -48,7 +48,7 @@ func (id SubscriptionRuleId) ID() string {
 
 // SubscriptionRuleID parses a SubscriptionRule ID into an SubscriptionRuleId struct
 func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -66,16 +66,16 @@ func SubscriptionRuleID(input string) (*SubscriptionRuleId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.NamespaceName, err = id.PopSegment("namespaces"); err != nil {
+	if resourceId.NamespaceName, err = id.PopSegmentInsensitively("namespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.TopicName, err = id.PopSegment("topics"); err != nil {
+	if resourceId.TopicName, err = id.PopSegmentInsensitively("topics"); err != nil {
 		return nil, err
 	}
-	if resourceId.SubscriptionName, err = id.PopSegment("subscriptions"); err != nil {
+	if resourceId.SubscriptionName, err = id.PopSegmentInsensitively("subscriptions"); err != nil {
 		return nil, err
 	}
-	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
+	if resourceId.RuleName, err = id.PopSegmentInsensitively("rules"); err != nil {
 		return nil, err
 	}
 
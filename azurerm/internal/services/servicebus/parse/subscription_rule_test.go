@@ -119,7 +119,14 @@ func TestSubscriptionRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/NAMESPACE1/TOPICS/TOPIC1/SUBSCRIPTIONS/SUBSCRIPTION1/RULES/RULE1",
-			Error: true,
+			Expected: &SubscriptionRuleId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				NamespaceName:    "NAMESPACE1",
+				TopicName:        "TOPIC1",
+				SubscriptionName: "SUBSCRIPTION1",
+				RuleName:         "RULE1",
+			},
 		},
 	}
 
@@ -106,7 +106,13 @@ func TestSubscriptionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/NAMESPACE1/TOPICS/TOPIC1/SUBSCRIPTIONS/SUBSCRIPTION1",
-			Error: true,
+			Expected: &SubscriptionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				NamespaceName:  "NAMESPACE1",
+				TopicName:      "TOPIC1",
+				Name:           "SUBSCRIPTION1",
+			},
 		},
 	}
 
@@ -106,7 +106,13 @@ func TestTopicAuthorizationRuleID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEBUS/NAMESPACES/NAMESPACE1/TOPICS/TOPIC1/AUTHORIZATIONRULES/AUTHORIZATIONRULE1",
-			Error: true,
+			Expected: &TopicAuthorizationRuleId{
+				SubscriptionId:        "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:         "RESGROUP1",
+				NamespaceName:         "NAMESPACE1",
+				TopicName:             "TOPIC1",
+				AuthorizationRuleName: "AUTHORIZATIONRULE1",
+			},
 		},
 	}
 
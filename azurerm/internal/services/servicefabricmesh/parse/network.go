@@ -39,7 +39,7 @@ func (id NetworkId) ID() string {
 
 // NetworkID parses a Network ID into an NetworkId struct
 func NetworkID(input string) (*NetworkId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func NetworkID(input string) (*NetworkId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("networks"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("networks"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id SecretId) ID() string {
 
 // SecretID parses a Secret ID into an SecretId struct
 func SecretID(input string) (*SecretId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func SecretID(input string) (*SecretId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("secrets"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("secrets"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id SecretValueId) ID() string {
 
 // SecretValueID parses a SecretValue ID into an SecretValueId struct
 func SecretValueID(input string) (*SecretValueId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SecretValueID(input string) (*SecretValueId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SecretName, err = id.PopSegment("secrets"); err != nil {
+	if resourceId.SecretName, err = id.PopSegmentInsensitively("secrets"); err != nil {
 		return nil, err
 	}
-	if resourceId.ValueName, err = id.PopSegment("values"); err != nil {
+	if resourceId.ValueName, err = id.PopSegmentInsensitively("values"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestSecretValueID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEFABRICMESH/SECRETS/SECRET1/VALUES/VALUE1",
-			Error: true,
+			Expected: &SecretValueId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SecretName:     "SECRET1",
+				ValueName:      "VALUE1",
+			},
 		},
 	}
 
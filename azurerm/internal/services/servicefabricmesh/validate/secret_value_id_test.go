@@ -73,7 +73,7 @@ func TestSecretValueID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SERVICEFABRICMESH/SECRETS/SECRET1/VALUES/VALUE1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
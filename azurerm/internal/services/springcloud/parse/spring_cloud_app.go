@@ -42,7 +42,7 @@ func (id SpringCloudAppId) ID() string {
 
 // SpringCloudAppID parses a SpringCloudApp ID into an SpringCloudAppId struct
 func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SpringCloudAppID(input string) (*SpringCloudAppId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
 		return nil, err
 	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
+	if resourceId.AppName, err = id.PopSegmentInsensitively("apps"); err != nil {
 		return nil, err
 	}
 
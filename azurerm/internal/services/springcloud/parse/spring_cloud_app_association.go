@@ -45,7 +45,7 @@ func (id SpringCloudAppAssociationId) ID() string {
 
 // SpringCloudAppAssociationID parses a SpringCloudAppAssociation ID into an SpringCloudAppAssociationId struct
 func SpringCloudAppAssociationID(input string) (*SpringCloudAppAssociationId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SpringCloudAppAssociationID(input string) (*SpringCloudAppAssociationId, er
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
 		return nil, err
 	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
+	if resourceId.AppName, err = id.PopSegmentInsensitively("apps"); err != nil {
 		return nil, err
 	}
-	if resourceId.BindingName, err = id.PopSegment("bindings"); err != nil {
+	if resourceId.BindingName, err = id.PopSegmentInsensitively("bindings"); err != nil {
 		return nil, err
 	}
 
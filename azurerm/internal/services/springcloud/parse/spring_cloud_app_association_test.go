@@ -106,7 +106,13 @@ func TestSpringCloudAppAssociationID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/SPRING1/APPS/APP1/BINDINGS/BIND1",
-			Error: true,
+			Expected: &SpringCloudAppAssociationId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SpringName:     "SPRING1",
+				AppName:        "APP1",
+				BindingName:    "BIND1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id SpringCloudCertificateId) ID() string {
 
 // SpringCloudCertificateID parses a SpringCloudCertificate ID into an SpringCloudCertificateId struct
 func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SpringCloudCertificateID(input string) (*SpringCloudCertificateId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
 		return nil, err
 	}
-	if resourceId.CertificateName, err = id.PopSegment("certificates"); err != nil {
+	if resourceId.CertificateName, err = id.PopSegmentInsensitively("certificates"); err != nil {
 		return nil, err
 	}
 
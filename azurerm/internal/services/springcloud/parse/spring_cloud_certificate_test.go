@@ -93,7 +93,12 @@ func TestSpringCloudCertificateID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/SPRING1/CERTIFICATES/CERT1",
-			Error: true,
+			Expected: &SpringCloudCertificateId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:   "RESGROUP1",
+				SpringName:      "SPRING1",
+				CertificateName: "CERT1",
+			},
 		},
 	}
 
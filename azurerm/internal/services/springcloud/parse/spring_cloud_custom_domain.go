@@ -45,7 +45,7 @@ func (id SpringCloudCustomDomainId) ID() string {
 
 // SpringCloudCustomDomainID parses a SpringCloudCustomDomain ID into an SpringCloudCustomDomainId struct
 func SpringCloudCustomDomainID(input string) (*SpringCloudCustomDomainId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SpringCloudCustomDomainID(input string) (*SpringCloudCustomDomainId, error)
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
 		return nil, err
 	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
+	if resourceId.AppName, err = id.PopSegmentInsensitively("apps"); err != nil {
 		return nil, err
 	}
-	if resourceId.DomainName, err = id.PopSegment("domains"); err != nil {
+	if resourceId.DomainName, err = id.PopSegmentInsensitively("domains"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestSpringCloudCustomDomainID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/SPRING1/APPS/APP1/DOMAINS/DOMAIN.COM",
-			Error: true,
+			Expected: &SpringCloudCustomDomainId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SpringName:     "SPRING1",
+				AppName:        "APP1",
+				DomainName:     "DOMAIN.COM",
+			},
 		},
 	}
 
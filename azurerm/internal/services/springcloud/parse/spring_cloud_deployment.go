@@ -45,7 +45,7 @@ func (id SpringCloudDeploymentId) ID() string {
 
 // SpringCloudDeploymentID parses a SpringCloudDeployment ID into an SpringCloudDeploymentId struct
 func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SpringCloudDeploymentID(input string) (*SpringCloudDeploymentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SpringName, err = id.PopSegment("Spring"); err != nil {
+	if resourceId.SpringName, err = id.PopSegmentInsensitively("Spring"); err != nil {
 		return nil, err
 	}
-	if resourceId.AppName, err = id.PopSegment("apps"); err != nil {
+	if resourceId.AppName, err = id.PopSegmentInsensitively("apps"); err != nil {
 		return nil, err
 	}
-	if resourceId.DeploymentName, err = id.PopSegment("deployments"); err != nil {
+	if resourceId.DeploymentName, err = id.PopSegmentInsensitively("deployments"); err != nil {
 		return nil, err
 	}
 
@@ -85,7 +85,7 @@ func TestSpringCloudCustomDomainID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/SPRING1/APPS/APP1/DOMAINS/DOMAIN.COM",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -85,7 +85,7 @@ func TestSpringCloudDeploymentID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.APPPLATFORM/SPRING/SPRING1/APPS/APP1/DEPLOYMENTS/DEPLOY1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -42,7 +42,7 @@ func (id AzureActiveDirectoryAdministratorId) ID() string {
 
 // AzureActiveDirectoryAdministratorID parses a AzureActiveDirectoryAdministrator ID into an AzureActiveDirectoryAdministratorId struct
 func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdministratorId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AzureActiveDirectoryAdministratorID(input string) (*AzureActiveDirectoryAdm
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.AdministratorName, err = id.PopSegment("administrators"); err != nil {
+	if resourceId.AdministratorName, err = id.PopSegmentInsensitively("administrators"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestAzureActiveDirectoryAdministratorID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SQL/SERVERS/SERVER1/ADMINISTRATORS/ACTIVEDIRECTORY",
-			Error: true,
+			Expected: &AzureActiveDirectoryAdministratorId{
+				SubscriptionId:    "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:     "RESGROUP1",
+				ServerName:        "SERVER1",
+				AdministratorName: "ACTIVEDIRECTORY",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id FailoverGroupId) ID() string {
 
 // FailoverGroupID parses a FailoverGroup ID into an FailoverGroupId struct
 func FailoverGroupID(input string) (*FailoverGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FailoverGroupID(input string) (*FailoverGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerName, err = id.PopSegment("servers"); err != nil {
+	if resourceId.ServerName, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("failoverGroups"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("failoverGroups"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id ServerId) ID() string {
 
 // ServerID parses a Server ID into an ServerId struct
 func ServerID(input string) (*ServerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ServerID(input string) (*ServerId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("servers"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("servers"); err != nil {
 		return nil, err
 	}
 
@@ -59,7 +59,7 @@ func resourceSqlDatabase() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.ValidateMsSqlServerName,
+				ValidateFunc: validation.ResourceName("Microsoft.Sql/servers"),
 			},
 
 			"create_mode": {
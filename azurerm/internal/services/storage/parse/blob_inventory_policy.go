@@ -42,7 +42,7 @@ func (id BlobInventoryPolicyId) ID() string {
 
 // BlobInventoryPolicyID parses a BlobInventoryPolicy ID into an BlobInventoryPolicyId struct
 func BlobInventoryPolicyID(input string) (*BlobInventoryPolicyId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func BlobInventoryPolicyID(input string) (*BlobInventoryPolicyId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.InventoryPolicyName, err = id.PopSegment("inventoryPolicies"); err != nil {
+	if resourceId.InventoryPolicyName, err = id.PopSegmentInsensitively("inventoryPolicies"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestBlobInventoryPolicyID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/STORAGEACCOUNT1/INVENTORYPOLICIES/INVENTORYPOLICY1",
-			Error: true,
+			Expected: &BlobInventoryPolicyId{
+				SubscriptionId:      "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:       "RESGROUP1",
+				StorageAccountName:  "STORAGEACCOUNT1",
+				InventoryPolicyName: "INVENTORYPOLICY1",
+			},
 		},
 	}
 
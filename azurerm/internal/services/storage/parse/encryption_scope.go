@@ -42,7 +42,7 @@ func (id EncryptionScopeId) ID() string {
 
 // EncryptionScopeID parses a EncryptionScope ID into an EncryptionScopeId struct
 func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func EncryptionScopeID(input string) (*EncryptionScopeId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("encryptionScopes"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("encryptionScopes"); err != nil {
 		return nil, err
 	}
 
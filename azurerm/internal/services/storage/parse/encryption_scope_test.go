@@ -93,7 +93,12 @@ func TestEncryptionScopeID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/STORAGEACCOUNT1/ENCRYPTIONSCOPES/ENCRYPTIONSCOPE1",
-			Error: true,
+			Expected: &EncryptionScopeId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				StorageAccountName: "STORAGEACCOUNT1",
+				Name:               "ENCRYPTIONSCOPE1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id StorageAccountId) ID() string {
 
 // StorageAccountID parses a StorageAccount ID into an StorageAccountId struct
 func StorageAccountID(input string) (*StorageAccountId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func StorageAccountID(input string) (*StorageAccountId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("storageAccounts"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
 		return nil, err
 	}
 
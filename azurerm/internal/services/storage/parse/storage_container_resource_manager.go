@@ -45,7 +45,7 @@ func (id StorageContainerResourceManagerId) ID() string {
 
 // StorageContainerResourceManagerID parses a StorageContainerResourceManager ID into an StorageContainerResourceManagerId struct
 func StorageContainerResourceManagerID(input string) (*StorageContainerResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func StorageContainerResourceManagerID(input string) (*StorageContainerResourceM
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.BlobServiceName, err = id.PopSegment("blobServices"); err != nil {
+	if resourceId.BlobServiceName, err = id.PopSegmentInsensitively("blobServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.ContainerName, err = id.PopSegment("containers"); err != nil {
+	if resourceId.ContainerName, err = id.PopSegmentInsensitively("containers"); err != nil {
 		return nil, err
 	}
 
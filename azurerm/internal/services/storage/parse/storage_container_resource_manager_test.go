@@ -106,7 +106,13 @@ func TestStorageContainerResourceManagerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/STORAGEACCOUNT1/BLOBSERVICES/DEFAULT/CONTAINERS/CONTAINER1",
-			Error: true,
+			Expected: &StorageContainerResourceManagerId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				StorageAccountName: "STORAGEACCOUNT1",
+				BlobServiceName:    "DEFAULT",
+				ContainerName:      "CONTAINER1",
+			},
 		},
 	}
 
@@ -45,7 +45,7 @@ func (id StorageShareResourceManagerId) ID() string {
 
 // StorageShareResourceManagerID parses a StorageShareResourceManager ID into an StorageShareResourceManagerId struct
 func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func StorageShareResourceManagerID(input string) (*StorageShareResourceManagerId
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+	if resourceId.StorageAccountName, err = id.PopSegmentInsensitively("storageAccounts"); err != nil {
 		return nil, err
 	}
-	if resourceId.FileServiceName, err = id.PopSegment("fileServices"); err != nil {
+	if resourceId.FileServiceName, err = id.PopSegmentInsensitively("fileServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.FileshareName, err = id.PopSegment("fileshares"); err != nil {
+	if resourceId.FileshareName, err = id.PopSegmentInsensitively("fileshares"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestStorageShareResourceManagerID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGE/STORAGEACCOUNTS/STORAGEACCOUNT1/FILESERVICES/FILESERVICE1/FILESHARES/SHARE1",
-			Error: true,
+			Expected: &StorageShareResourceManagerId{
+				SubscriptionId:     "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:      "RESGROUP1",
+				StorageAccountName: "STORAGEACCOUNT1",
+				FileServiceName:    "FILESERVICE1",
+				FileshareName:      "SHARE1",
+			},
 		},
 	}
 
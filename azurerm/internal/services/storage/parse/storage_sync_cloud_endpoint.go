@@ -45,7 +45,7 @@ func (id StorageSyncCloudEndpointId) ID() string {
 
 // StorageSyncCloudEndpointID parses a StorageSyncCloudEndpoint ID into an StorageSyncCloudEndpointId struct
 func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func StorageSyncCloudEndpointID(input string) (*StorageSyncCloudEndpointId, erro
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
+	if resourceId.StorageSyncServiceName, err = id.PopSegmentInsensitively("storageSyncServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
+	if resourceId.SyncGroupName, err = id.PopSegmentInsensitively("syncGroups"); err != nil {
 		return nil, err
 	}
-	if resourceId.CloudEndpointName, err = id.PopSegment("cloudEndpoints"); err != nil {
+	if resourceId.CloudEndpointName, err = id.PopSegmentInsensitively("cloudEndpoints"); err != nil {
 		return nil, err
 	}
 
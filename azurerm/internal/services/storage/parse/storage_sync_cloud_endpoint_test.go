@@ -106,7 +106,13 @@ func TestStorageSyncCloudEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/STORAGESYNCSERVICE1/SYNCGROUPS/SYNCGROUP1/CLOUDENDPOINTS/CLOUDENDPOINT1",
-			Error: true,
+			Expected: &StorageSyncCloudEndpointId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "RESGROUP1",
+				StorageSyncServiceName: "STORAGESYNCSERVICE1",
+				SyncGroupName:          "SYNCGROUP1",
+				CloudEndpointName:      "CLOUDENDPOINT1",
+			},
 		},
 	}
 
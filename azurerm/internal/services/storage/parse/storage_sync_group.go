@@ -42,7 +42,7 @@ func (id StorageSyncGroupId) ID() string {
 
 // StorageSyncGroupID parses a StorageSyncGroup ID into an StorageSyncGroupId struct
 func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StorageSyncGroupID(input string) (*StorageSyncGroupId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StorageSyncServiceName, err = id.PopSegment("storageSyncServices"); err != nil {
+	if resourceId.StorageSyncServiceName, err = id.PopSegmentInsensitively("storageSyncServices"); err != nil {
 		return nil, err
 	}
-	if resourceId.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
+	if resourceId.SyncGroupName, err = id.PopSegmentInsensitively("syncGroups"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestStorageSyncGroupID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STORAGESYNC/STORAGESYNCSERVICES/STORAGESYNCSERVICE1/SYNCGROUPS/SYNCGROUP1",
-			Error: true,
+			Expected: &StorageSyncGroupId{
+				SubscriptionId:         "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:          "RESGROUP1",
+				StorageSyncServiceName: "STORAGESYNCSERVICE1",
+				SyncGroupName:          "SYNCGROUP1",
+			},
 		},
 	}
 
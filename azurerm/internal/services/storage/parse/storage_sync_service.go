@@ -39,7 +39,7 @@ func (id StorageSyncServiceId) ID() string {
 
 // StorageSyncServiceID parses a StorageSyncService ID into an StorageSyncServiceId struct
 func StorageSyncServiceID(input string) (*StorageSyncServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func StorageSyncServiceID(input string) (*StorageSyncServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("storageSyncServices"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("storageSyncServices"); err != nil {
 		return nil, err
 	}
 
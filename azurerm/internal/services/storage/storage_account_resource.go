@@ -17,6 +17,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/locks"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/network"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/migration"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
@@ -60,7 +61,7 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.StorageAccountName,
+				ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -737,27 +738,30 @@ func resourceStorageAccount() *pluginsdk.Resource {
 				},
 			},
 		},
-		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
-			if d.HasChange("account_kind") {
-				accountKind, changedKind := d.GetChange("account_kind")
-
-				if accountKind != string(storage.Storage) && changedKind != string(storage.StorageV2) {
-					log.Printf("[DEBUG] recreate storage account, could't be migrated from %s to %s", accountKind, changedKind)
-					d.ForceNew("account_kind")
-				} else {
-					log.Printf("[DEBUG] storage account can be upgraded from %s to %s", accountKind, changedKind)
+		CustomizeDiff: pluginsdk.CustomDiffInSequence(
+			func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+				if d.HasChange("account_kind") {
+					accountKind, changedKind := d.GetChange("account_kind")
+
+					if accountKind != string(storage.Storage) && changedKind != string(storage.StorageV2) {
+						log.Printf("[DEBUG] recreate storage account, could't be migrated from %s to %s", accountKind, changedKind)
+						d.ForceNew("account_kind")
+					} else {
+						log.Printf("[DEBUG] storage account can be upgraded from %s to %s", accountKind, changedKind)
+					}
 				}
-			}
 
-			if d.HasChange("large_file_share_enabled") {
-				lfsEnabled, changedEnabled := d.GetChange("large_file_share_enabled")
-				if lfsEnabled.(bool) && !changedEnabled.(bool) {
-					return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
+				if d.HasChange("large_file_share_enabled") {
+					lfsEnabled, changedEnabled := d.GetChange("large_file_share_enabled")
+					if lfsEnabled.(bool) && !changedEnabled.(bool) {
+						return fmt.Errorf("`large_file_share_enabled` cannot be disabled once it's been enabled")
+					}
 				}
-			}
 
-			return nil
-		}),
+				return nil
+			},
+			nameavailability.CustomizeDiff("Storage Account", storageAccountNameAvailability),
+		),
 	}
 }
 
@@ -1620,8 +1624,11 @@ func resourceStorageAccountDelete(d *pluginsdk.ResourceData, meta interface{}) e
 		}
 	}
 
-	locks.MultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
-	defer locks.UnlockMultipleByName(&virtualNetworkNames, network.VirtualNetworkResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKeys(virtualNetworkNames, network.VirtualNetworkResourceName)...)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	resp, err := client.Delete(ctx, resourceGroup, name)
 	if err != nil {
@@ -2557,3 +2564,22 @@ func setEndpointAndHost(d *pluginsdk.ResourceData, ordinalString string, endpoin
 	d.Set(fmt.Sprintf("%s_%s_host", ordinalString, typeString), host)
 	return nil
 }
+
+func storageAccountNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	resp, err := client.Storage.AccountsClient.CheckNameAvailability(ctx, storage.AccountCheckNameAvailabilityParameters{
+		Name: utils.String(name),
+		Type: utils.String("Microsoft.Storage/storageAccounts"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.NameAvailable == nil {
+		return nil, fmt.Errorf("`nameAvailable` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.NameAvailable,
+		Reason:    string(resp.Reason),
+		Message:   utils.NormalizeNilableString(resp.Message),
+	}, nil
+}
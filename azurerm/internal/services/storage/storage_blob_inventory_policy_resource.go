@@ -47,7 +47,7 @@ func resourceStorageBlobInventoryPolicy() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.StorageContainerName,
+				ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts/blobServices/containers"),
 			},
 
 			"rules": {
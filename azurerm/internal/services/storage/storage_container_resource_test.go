@@ -3,14 +3,12 @@ package storage_test
 import (
 	"context"
 	"fmt"
-	"strings"
 	"testing"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/parse"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -401,35 +399,3 @@ resource "azurerm_storage_account" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
-
-func TestValidateStorageContainerName(t *testing.T) {
-	validNames := []string{
-		"valid-name",
-		"valid02-name",
-		"$root",
-		"$web",
-	}
-	for _, v := range validNames {
-		_, errors := validate.StorageContainerName(v, "name")
-		if len(errors) != 0 {
-			t.Fatalf("%q should be a valid Storage Container Name: %q", v, errors)
-		}
-	}
-
-	invalidNames := []string{
-		"InvalidName1",
-		"-invalidname1",
-		"invalid_name",
-		"invalid!",
-		"ww",
-		"$notroot",
-		"$notweb",
-		strings.Repeat("w", 65),
-	}
-	for _, v := range invalidNames {
-		_, errors := validate.StorageContainerName(v, "name")
-		if len(errors) == 0 {
-			t.Fatalf("%q should be an invalid Storage Container Name", v)
-		}
-	}
-}
@@ -31,7 +31,7 @@ func dataSourceStorageTableEntity() *pluginsdk.Resource {
 			"storage_account_name": {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
-				ValidateFunc: validate.StorageAccountName,
+				ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts"),
 			},
 
 			"partition_key": {
@@ -42,7 +42,7 @@ func resourceStorageTableEntity() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.StorageAccountName,
+				ValidateFunc: validation.ResourceName("Microsoft.Storage/storageAccounts"),
 			},
 			"partition_key": {
 				Type:         pluginsdk.TypeString,
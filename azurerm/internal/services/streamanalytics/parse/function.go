@@ -42,7 +42,7 @@ func (id FunctionId) ID() string {
 
 // FunctionID parses a Function ID into an FunctionId struct
 func FunctionID(input string) (*FunctionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FunctionID(input string) (*FunctionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+	if resourceId.StreamingjobName, err = id.PopSegmentInsensitively("streamingjobs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("functions"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("functions"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id OutputId) ID() string {
 
 // OutputID parses a Output ID into an OutputId struct
 func OutputID(input string) (*OutputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func OutputID(input string) (*OutputId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+	if resourceId.StreamingjobName, err = id.PopSegmentInsensitively("streamingjobs"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("outputs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("outputs"); err != nil {
 		return nil, err
 	}
 
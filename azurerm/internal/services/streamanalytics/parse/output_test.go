@@ -93,7 +93,12 @@ func TestOutputID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.STREAMANALYTICS/STREAMINGJOBS/STREAMINGJOB1/OUTPUTS/OUTPUT1",
-			Error: true,
+			Expected: &OutputId{
+				SubscriptionId:   "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:    "RESGROUP1",
+				StreamingjobName: "STREAMINGJOB1",
+				Name:             "OUTPUT1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id StreamInputId) ID() string {
 
 // StreamInputID parses a StreamInput ID into an StreamInputId struct
 func StreamInputID(input string) (*StreamInputId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func StreamInputID(input string) (*StreamInputId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.StreamingjobName, err = id.PopSegment("streamingjobs"); err != nil {
+	if resourceId.StreamingjobName, err = id.PopSegmentInsensitively("streamingjobs"); err != nil {
 		return nil, err
 	}
-	if resourceId.InputName, err = id.PopSegment("inputs"); err != nil {
+	if resourceId.InputName, err = id.PopSegmentInsensitively("inputs"); err != nil {
 		return nil, err
 	}
 
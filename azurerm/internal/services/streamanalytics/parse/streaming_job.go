@@ -39,7 +39,7 @@ func (id StreamingJobId) ID() string {
 
 // StreamingJobID parses a StreamingJob ID into an StreamingJobId struct
 func StreamingJobID(input string) (*StreamingJobId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func StreamingJobID(input string) (*StreamingJobId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("streamingjobs"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("streamingjobs"); err != nil {
 		return nil, err
 	}
 
@@ -45,7 +45,7 @@ func (id ManagedPrivateEndpointId) ID() string {
 
 // ManagedPrivateEndpointID parses a ManagedPrivateEndpoint ID into an ManagedPrivateEndpointId struct
 func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func ManagedPrivateEndpointID(input string) (*ManagedPrivateEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.ManagedVirtualNetworkName, err = id.PopSegment("managedVirtualNetworks"); err != nil {
+	if resourceId.ManagedVirtualNetworkName, err = id.PopSegmentInsensitively("managedVirtualNetworks"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("managedPrivateEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("managedPrivateEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestManagedPrivateEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SYNAPSE/WORKSPACES/WORKSPACE1/MANAGEDVIRTUALNETWORKS/DEFAULT/MANAGEDPRIVATEENDPOINTS/ENDPOINT1",
-			Error: true,
+			Expected: &ManagedPrivateEndpointId{
+				SubscriptionId:            "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:             "RESGROUP1",
+				WorkspaceName:             "WORKSPACE1",
+				ManagedVirtualNetworkName: "DEFAULT",
+				Name:                      "ENDPOINT1",
+			},
 		},
 	}
 
@@ -37,9 +37,15 @@ func TestSynapseRoleAssignmentID(t *testing.T) {
 			},
 		},
 		{
-			Name:     "Wrong Casing",
-			Input:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/Workspaces/workspace1|00000000",
-			Expected: nil,
+			Name:  "Different Casing",
+			Input: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/resourceGroup1/providers/Microsoft.Synapse/Workspaces/workspace1|00000000",
+			Expected: &RoleAssignmentId{
+				Workspace: WorkspaceId{
+					ResourceGroup: "resourceGroup1",
+					Name:          "workspace1",
+				},
+				DataPlaneAssignmentId: "00000000",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id SparkPoolId) ID() string {
 
 // SparkPoolID parses a SparkPool ID into an SparkPoolId struct
 func SparkPoolID(input string) (*SparkPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SparkPoolID(input string) (*SparkPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.BigDataPoolName, err = id.PopSegment("bigDataPools"); err != nil {
+	if resourceId.BigDataPoolName, err = id.PopSegmentInsensitively("bigDataPools"); err != nil {
 		return nil, err
 	}
 
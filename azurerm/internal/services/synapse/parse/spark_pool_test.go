@@ -93,7 +93,12 @@ func TestSparkPoolID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.SYNAPSE/WORKSPACES/WORKSPACE1/BIGDATAPOOLS/BIGDATAPOOL1",
-			Error: true,
+			Expected: &SparkPoolId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:   "RESGROUP1",
+				WorkspaceName:   "WORKSPACE1",
+				BigDataPoolName: "BIGDATAPOOL1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id SqlPoolId) ID() string {
 
 // SqlPoolID parses a SqlPool ID into an SqlPoolId struct
 func SqlPoolID(input string) (*SqlPoolId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func SqlPoolID(input string) (*SqlPoolId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.WorkspaceName, err = id.PopSegment("workspaces"); err != nil {
+	if resourceId.WorkspaceName, err = id.PopSegmentInsensitively("workspaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("sqlPools"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("sqlPools"); err != nil {
 		return nil, err
 	}
 
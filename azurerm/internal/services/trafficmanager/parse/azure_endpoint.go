@@ -42,7 +42,7 @@ func (id AzureEndpointId) ID() string {
 
 // AzureEndpointID parses a AzureEndpoint ID into an AzureEndpointId struct
 func AzureEndpointID(input string) (*AzureEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AzureEndpointID(input string) (*AzureEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+	if resourceId.TrafficManagerProfileName, err = id.PopSegmentInsensitively("trafficManagerProfiles"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("azureEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("azureEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestAzureEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/TRAFFICMANAGERPROFILE1/AZUREENDPOINTS/AZUREENDPOINT1",
-			Error: true,
+			Expected: &AzureEndpointId{
+				SubscriptionId:            "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:             "RESGROUP1",
+				TrafficManagerProfileName: "TRAFFICMANAGERPROFILE1",
+				Name:                      "AZUREENDPOINT1",
+			},
 		},
 	}
 
@@ -42,7 +42,7 @@ func (id ExternalEndpointId) ID() string {
 
 // ExternalEndpointID parses a ExternalEndpoint ID into an ExternalEndpointId struct
 func ExternalEndpointID(input string) (*ExternalEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ExternalEndpointID(input string) (*ExternalEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+	if resourceId.TrafficManagerProfileName, err = id.PopSegmentInsensitively("trafficManagerProfiles"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("externalEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("externalEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -42,7 +42,7 @@ func (id NestedEndpointId) ID() string {
 
 // NestedEndpointID parses a NestedEndpoint ID into an NestedEndpointId struct
 func NestedEndpointID(input string) (*NestedEndpointId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func NestedEndpointID(input string) (*NestedEndpointId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.TrafficManagerProfileName, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+	if resourceId.TrafficManagerProfileName, err = id.PopSegmentInsensitively("trafficManagerProfiles"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("nestedEndpoints"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("nestedEndpoints"); err != nil {
 		return nil, err
 	}
 
@@ -93,7 +93,12 @@ func TestNestedEndpointID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.NETWORK/TRAFFICMANAGERPROFILES/TRAFFICMANAGERPROFILE1/NESTEDENDPOINTS/NESTEDENDPOINT1",
-			Error: true,
+			Expected: &NestedEndpointId{
+				SubscriptionId:            "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:             "RESGROUP1",
+				TrafficManagerProfileName: "TRAFFICMANAGERPROFILE1",
+				Name:                      "NESTEDENDPOINT1",
+			},
 		},
 	}
 
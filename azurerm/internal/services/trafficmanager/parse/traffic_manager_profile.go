@@ -39,7 +39,7 @@ func (id TrafficManagerProfileId) ID() string {
 
 // TrafficManagerProfileID parses a TrafficManagerProfile ID into an TrafficManagerProfileId struct
 func TrafficManagerProfileID(input string) (*TrafficManagerProfileId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func TrafficManagerProfileID(input string) (*TrafficManagerProfileId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("trafficManagerProfiles"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("trafficManagerProfiles"); err != nil {
 		return nil, err
 	}
 
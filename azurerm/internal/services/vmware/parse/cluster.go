@@ -42,7 +42,7 @@ func (id ClusterId) ID() string {
 
 // ClusterID parses a Cluster ID into an ClusterId struct
 func ClusterID(input string) (*ClusterId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func ClusterID(input string) (*ClusterId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.PrivateCloudName, err = id.PopSegment("privateClouds"); err != nil {
+	if resourceId.PrivateCloudName, err = id.PopSegmentInsensitively("privateClouds"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("clusters"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("clusters"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id PrivateCloudId) ID() string {
 
 // PrivateCloudID parses a PrivateCloud ID into an PrivateCloudId struct
 func PrivateCloudID(input string) (*PrivateCloudId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func PrivateCloudID(input string) (*PrivateCloudId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("privateClouds"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("privateClouds"); err != nil {
 		return nil, err
 	}
 
@@ -52,7 +52,7 @@ func (r AppServiceEnvironmentV3Resource) Arguments() map[string]*pluginsdk.Schem
 			Type:         pluginsdk.TypeString,
 			Required:     true,
 			ForceNew:     true,
-			ValidateFunc: validate.AppServiceEnvironmentName,
+			ValidateFunc: validation.ResourceName("Microsoft.Web/hostingEnvironments"),
 		},
 
 		"resource_group_name": azure.SchemaResourceGroupName(),
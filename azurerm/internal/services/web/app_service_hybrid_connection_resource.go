@@ -16,7 +16,6 @@ import (
 	relayParse "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay/parse"
 	relayValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/relay/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -47,7 +46,7 @@ func resourceAppServiceHybridConnection() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.AppServiceName,
+				ValidateFunc: validation.ResourceName("Microsoft.Web/sites"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -1,6 +1,7 @@
 package web
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -10,8 +11,8 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/nameavailability"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -44,7 +45,7 @@ func resourceAppService() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: validate.AppServiceName,
+				ValidateFunc: validation.ResourceName("Microsoft.Web/sites"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -198,6 +199,8 @@ func resourceAppService() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(nameavailability.CustomizeDiffWithName("App Service", appServiceNameToCheck, appServiceNameAvailability)),
 	}
 }
 
@@ -834,3 +837,48 @@ func flattenAppServiceSiteCredential(input *web.UserProperties) []interface{} {
 
 	return append(results, result)
 }
+
+// appServiceNameToCheck returns the name of the App Service which should be checked for availability - which
+// is the Fully Qualified Domain Name when the App Service Plan is within an App Service Environment
+func appServiceNameToCheck(ctx context.Context, client *clients.Client, d *pluginsdk.ResourceDiff) (string, error) {
+	if !d.NewValueKnown("name") || !d.NewValueKnown("app_service_plan_id") {
+		return "", nil
+	}
+
+	name := d.Get("name").(string)
+	aspID, err := parse.AppServicePlanID(d.Get("app_service_plan_id").(string))
+	if err != nil {
+		return "", err
+	}
+
+	appServicePlan, err := client.Web.AppServicePlansClient.Get(ctx, aspID.ResourceGroup, aspID.ServerfarmName)
+	if err != nil {
+		// the App Service Plan may not exist yet, in which case the availability is checked during creation
+		return "", nil
+	}
+	if appServicePlan.HostingEnvironmentProfile != nil && appServicePlan.HostingEnvironmentProfile.Name != nil {
+		name = fmt.Sprintf("%s.%s.appserviceenvironment.net", name, *appServicePlan.HostingEnvironmentProfile.Name)
+	}
+
+	return name, nil
+}
+
+func appServiceNameAvailability(ctx context.Context, client *clients.Client, name string) (*nameavailability.Result, error) {
+	resp, err := client.Web.BaseClient.CheckNameAvailability(ctx, web.ResourceNameAvailabilityRequest{
+		Name:   utils.String(name),
+		Type:   web.CheckNameResourceTypesMicrosoftWebsites,
+		IsFqdn: utils.Bool(strings.Contains(name, ".")),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.NameAvailable == nil {
+		return nil, fmt.Errorf("`nameAvailable` was nil")
+	}
+
+	return &nameavailability.Result{
+		Available: *resp.NameAvailable,
+		Reason:    string(resp.Reason),
+		Message:   utils.NormalizeNilableString(resp.Message),
+	}, nil
+}
@@ -10,7 +10,6 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
-	webValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -43,7 +42,7 @@ func resourceAppServiceSlot() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: webValidate.AppServiceName,
+				ValidateFunc: validation.ResourceName("Microsoft.Web/sites/slots"),
 			},
 
 			"identity": schemaAppServiceIdentity(),
@@ -56,7 +55,7 @@ func resourceAppServiceSlot() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: webValidate.AppServiceName,
+				ValidateFunc: validation.ResourceName("Microsoft.Web/sites"),
 			},
 
 			"app_service_plan_id": {
@@ -82,11 +82,11 @@ func resourceAppServiceVirtualNetworkSwiftConnectionCreateUpdate(d *pluginsdk.Re
 		}
 	}
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(virtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(subnetName, network.SubnetResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	exists, err := client.Get(ctx, resourceGroup, name)
 	if err != nil {
@@ -172,11 +172,11 @@ func resourceAppServiceVirtualNetworkSwiftConnectionDelete(d *pluginsdk.Resource
 	subnetName := subnetID.Name
 	virtualNetworkName := subnetID.VirtualNetworkName
 
-	locks.ByName(virtualNetworkName, network.VirtualNetworkResourceName)
-	defer locks.UnlockByName(virtualNetworkName, network.VirtualNetworkResourceName)
-
-	locks.ByName(subnetName, network.SubnetResourceName)
-	defer locks.UnlockByName(subnetName, network.SubnetResourceName)
+	unlock, err := locks.Acquire(ctx, locks.NameKey(virtualNetworkName, network.VirtualNetworkResourceName), locks.NameKey(subnetName, network.SubnetResourceName))
+	if err != nil {
+		return err
+	}
+	defer unlock()
 
 	read, err := client.GetSwiftVirtualNetworkConnection(ctx, id.ResourceGroup, id.SiteName)
 	if err != nil {
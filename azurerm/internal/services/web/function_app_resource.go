@@ -10,9 +10,7 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
-	storageValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/storage/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/parse"
-	webValidate "github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/web/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tags"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/suppress"
@@ -46,7 +44,7 @@ func resourceFunctionApp() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Required:     true,
 				ForceNew:     true,
-				ValidateFunc: webValidate.AppServiceName,
+				ValidateFunc: validation.ResourceName("Microsoft.Web/sites"),
 			},
 
 			"resource_group_name": azure.SchemaResourceGroupName(),
@@ -167,7 +165,7 @@ func resourceFunctionApp() *pluginsdk.Resource {
 				Optional:      true,
 				Computed:      true, // Remove this in 3.0
 				ForceNew:      true,
-				ValidateFunc:  storageValidate.StorageAccountName,
+				ValidateFunc:  validation.ResourceName("Microsoft.Storage/storageAccounts"),
 				ConflictsWith: []string{"storage_connection_string"},
 			},
 
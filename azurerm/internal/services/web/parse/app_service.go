@@ -39,7 +39,7 @@ func (id AppServiceId) ID() string {
 
 // AppServiceID parses a AppService ID into an AppServiceId struct
 func AppServiceID(input string) (*AppServiceId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AppServiceID(input string) (*AppServiceId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
 
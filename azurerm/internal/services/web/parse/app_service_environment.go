@@ -39,7 +39,7 @@ func (id AppServiceEnvironmentId) ID() string {
 
 // AppServiceEnvironmentID parses a AppServiceEnvironment ID into an AppServiceEnvironmentId struct
 func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AppServiceEnvironmentID(input string) (*AppServiceEnvironmentId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.HostingEnvironmentName, err = id.PopSegment("hostingEnvironments"); err != nil {
+	if resourceId.HostingEnvironmentName, err = id.PopSegmentInsensitively("hostingEnvironments"); err != nil {
 		return nil, err
 	}
 
@@ -39,7 +39,7 @@ func (id AppServicePlanId) ID() string {
 
 // AppServicePlanID parses a AppServicePlan ID into an AppServicePlanId struct
 func AppServicePlanID(input string) (*AppServicePlanId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func AppServicePlanID(input string) (*AppServicePlanId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.ServerfarmName, err = id.PopSegment("serverfarms"); err != nil {
+	if resourceId.ServerfarmName, err = id.PopSegmentInsensitively("serverfarms"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestAppServicePlanID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SERVERFARMS/FARM1",
-			Error: true,
+			Expected: &AppServicePlanId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				ServerfarmName: "FARM1",
+			},
 		},
 	}
 
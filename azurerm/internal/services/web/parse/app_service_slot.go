@@ -42,7 +42,7 @@ func (id AppServiceSlotId) ID() string {
 
 // AppServiceSlotID parses a AppServiceSlot ID into an AppServiceSlotId struct
 func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func AppServiceSlotID(input string) (*AppServiceSlotId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+	if resourceId.SlotName, err = id.PopSegmentInsensitively("slots"); err != nil {
 		return nil, err
 	}
 
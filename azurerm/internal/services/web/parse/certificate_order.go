@@ -39,7 +39,7 @@ func (id CertificateOrderId) ID() string {
 
 // CertificateOrderID parses a CertificateOrder ID into an CertificateOrderId struct
 func CertificateOrderID(input string) (*CertificateOrderId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func CertificateOrderID(input string) (*CertificateOrderId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("certificateOrders"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("certificateOrders"); err != nil {
 		return nil, err
 	}
 
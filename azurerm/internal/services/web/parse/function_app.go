@@ -39,7 +39,7 @@ func (id FunctionAppId) ID() string {
 
 // FunctionAppID parses a FunctionApp ID into an FunctionAppId struct
 func FunctionAppID(input string) (*FunctionAppId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func FunctionAppID(input string) (*FunctionAppId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
 
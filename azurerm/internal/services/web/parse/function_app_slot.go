@@ -42,7 +42,7 @@ func (id FunctionAppSlotId) ID() string {
 
 // FunctionAppSlotID parses a FunctionAppSlot ID into an FunctionAppSlotId struct
 func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func FunctionAppSlotID(input string) (*FunctionAppSlotId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+	if resourceId.SlotName, err = id.PopSegmentInsensitively("slots"); err != nil {
 		return nil, err
 	}
 
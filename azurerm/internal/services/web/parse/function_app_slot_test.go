@@ -93,7 +93,12 @@ func TestFunctionAppSlotID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/SLOTS/SLOT1",
-			Error: true,
+			Expected: &FunctionAppSlotId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SiteName:       "SITE1",
+				SlotName:       "SLOT1",
+			},
 		},
 	}
 
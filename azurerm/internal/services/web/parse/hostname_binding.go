@@ -42,7 +42,7 @@ func (id HostnameBindingId) ID() string {
 
 // HostnameBindingID parses a HostnameBinding ID into an HostnameBindingId struct
 func HostnameBindingID(input string) (*HostnameBindingId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -60,10 +60,10 @@ func HostnameBindingID(input string) (*HostnameBindingId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
-	if resourceId.Name, err = id.PopSegment("hostNameBindings"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("hostNameBindings"); err != nil {
 		return nil, err
 	}
 
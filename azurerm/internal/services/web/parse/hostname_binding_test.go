@@ -93,7 +93,12 @@ func TestHostnameBindingID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/MYGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/HOSTNAMEBINDINGS/BINDING1",
-			Error: true,
+			Expected: &HostnameBindingId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "MYGROUP1",
+				SiteName:       "SITE1",
+				Name:           "BINDING1",
+			},
 		},
 	}
 
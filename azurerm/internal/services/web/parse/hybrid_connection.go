@@ -45,7 +45,7 @@ func (id HybridConnectionId) ID() string {
 
 // HybridConnectionID parses a HybridConnection ID into an HybridConnectionId struct
 func HybridConnectionID(input string) (*HybridConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func HybridConnectionID(input string) (*HybridConnectionId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
-	if resourceId.HybridConnectionNamespaceName, err = id.PopSegment("hybridConnectionNamespaces"); err != nil {
+	if resourceId.HybridConnectionNamespaceName, err = id.PopSegmentInsensitively("hybridConnectionNamespaces"); err != nil {
 		return nil, err
 	}
-	if resourceId.RelayName, err = id.PopSegment("relays"); err != nil {
+	if resourceId.RelayName, err = id.PopSegmentInsensitively("relays"); err != nil {
 		return nil, err
 	}
 
@@ -106,7 +106,13 @@ func TestHybridConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/HYBRIDCONNECTIONNAMESPACES/HYBRIDCONNECTIONNAMESPACE1/RELAYS/RELAY1",
-			Error: true,
+			Expected: &HybridConnectionId{
+				SubscriptionId:                "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:                 "RESGROUP1",
+				SiteName:                      "SITE1",
+				HybridConnectionNamespaceName: "HYBRIDCONNECTIONNAMESPACE1",
+				RelayName:                     "RELAY1",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id ManagedCertificateId) ID() string {
 
 // ManagedCertificateID parses a ManagedCertificate ID into an ManagedCertificateId struct
 func ManagedCertificateID(input string) (*ManagedCertificateId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func ManagedCertificateID(input string) (*ManagedCertificateId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.CertificateName, err = id.PopSegment("certificates"); err != nil {
+	if resourceId.CertificateName, err = id.PopSegmentInsensitively("certificates"); err != nil {
 		return nil, err
 	}
 
@@ -80,7 +80,11 @@ func TestManagedCertificateID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/CERTIFICATES/CUSTOMHOST.CONTOSO.COM",
-			Error: true,
+			Expected: &ManagedCertificateId{
+				SubscriptionId:  "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:   "RESGROUP1",
+				CertificateName: "CUSTOMHOST.CONTOSO.COM",
+			},
 		},
 	}
 
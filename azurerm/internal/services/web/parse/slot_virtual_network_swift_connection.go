@@ -45,7 +45,7 @@ func (id SlotVirtualNetworkSwiftConnectionId) ID() string {
 
 // SlotVirtualNetworkSwiftConnectionID parses a SlotVirtualNetworkSwiftConnection ID into an SlotVirtualNetworkSwiftConnectionId struct
 func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwiftConnectionId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +63,13 @@ func SlotVirtualNetworkSwiftConnectionID(input string) (*SlotVirtualNetworkSwift
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.SiteName, err = id.PopSegment("sites"); err != nil {
+	if resourceId.SiteName, err = id.PopSegmentInsensitively("sites"); err != nil {
 		return nil, err
 	}
-	if resourceId.SlotName, err = id.PopSegment("slots"); err != nil {
+	if resourceId.SlotName, err = id.PopSegmentInsensitively("slots"); err != nil {
 		return nil, err
 	}
-	if resourceId.ConfigName, err = id.PopSegment("config"); err != nil {
+	if resourceId.ConfigName, err = id.PopSegmentInsensitively("config"); err != nil {
 		return nil, err
 	}
 
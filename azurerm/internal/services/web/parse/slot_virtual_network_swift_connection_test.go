@@ -106,7 +106,13 @@ func TestSlotVirtualNetworkSwiftConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/SLOTS/SLOT1/CONFIG/VIRTUALNETWORK",
-			Error: true,
+			Expected: &SlotVirtualNetworkSwiftConnectionId{
+				SubscriptionId: "12345678-1234-9876-4563-123456789012",
+				ResourceGroup:  "RESGROUP1",
+				SiteName:       "SITE1",
+				SlotName:       "SLOT1",
+				ConfigName:     "VIRTUALNETWORK",
+			},
 		},
 	}
 
@@ -39,7 +39,7 @@ func (id StaticSiteId) ID() string {
 
 // StaticSiteID parses a StaticSite ID into an StaticSiteId struct
 func StaticSiteID(input string) (*StaticSiteId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -57,7 +57,7 @@ func StaticSiteID(input string) (*StaticSiteId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
-	if resourceId.Name, err = id.PopSegment("staticSites"); err != nil {
+	if resourceId.Name, err = id.PopSegmentInsensitively("staticSites"); err != nil {
 		return nil, err
 	}
 
@@ -35,7 +35,7 @@ func TestValidateAppServiceID(t *testing.T) {
 		},
 		{
 			ID:    "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/foo/providers/Microsoft.Web/Sites/duckduckgo",
-			Valid: false,
+			Valid: true,
 		},
 	}
 
@@ -49,43 +49,3 @@ func TestValidateAppServiceID(t *testing.T) {
 		}
 	}
 }
-
-func TestValidateAppServiceName(t *testing.T) {
-	cases := []struct {
-		Value    string
-		ErrCount int
-	}{
-		{
-			Value:    "ab",
-			ErrCount: 0,
-		},
-		{
-			Value:    "abc",
-			ErrCount: 0,
-		},
-		{
-			Value:    "webapp1",
-			ErrCount: 0,
-		},
-		{
-			Value:    "hello-world",
-			ErrCount: 0,
-		},
-		{
-			Value:    "hello_world",
-			ErrCount: 1,
-		},
-		{
-			Value:    "helloworld21!",
-			ErrCount: 1,
-		},
-	}
-
-	for _, tc := range cases {
-		_, errors := AppServiceName(tc.Value, "azurerm_app_service")
-
-		if len(errors) != tc.ErrCount {
-			t.Fatalf("Expected the App Service Name to trigger a validation error for '%s'", tc.Value)
-		}
-	}
-}
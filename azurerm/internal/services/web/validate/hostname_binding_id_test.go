@@ -73,7 +73,7 @@ func TestHostnameBindingID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/MYGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/HOSTNAMEBINDINGS/BINDING1",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -85,7 +85,7 @@ func TestSlotVirtualNetworkSwiftConnectionID(t *testing.T) {
 		{
 			// upper-cased
 			Input: "/SUBSCRIPTIONS/12345678-1234-9876-4563-123456789012/RESOURCEGROUPS/RESGROUP1/PROVIDERS/MICROSOFT.WEB/SITES/SITE1/SLOTS/SLOT1/CONFIG/VIRTUALNETWORK",
-			Valid: false,
+			Valid: true,
 		},
 	}
 	for _, tc := range cases {
@@ -0,0 +1,69 @@
+package skus
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+)
+
+// supportedSkus can be (validly) nil - as such this shouldn't be relied on
+// this is keyed by the lower-cased Resource Type (e.g. `virtualmachines`) and then the lower-cased SKU Name
+var supportedSkus map[string]map[string]*Sku
+
+// CacheSupportedSkus attempts to retrieve the SKUs supported by the Compute Resource Provider (e.g. Virtual Machine
+// Sizes and Disk SKUs) from the Resource Manager API and caches them, for use in enhanced validation
+func CacheSupportedSkus(ctx context.Context, client *compute.ResourceSkusClient) {
+	skus, err := availableSkus(ctx, client)
+	if err != nil {
+		log.Printf("[DEBUG] error retrieving Compute SKUs: %s. Enhanced validation will be unavailable", err)
+		return
+	}
+
+	supportedSkus = skus
+}
+
+func availableSkus(ctx context.Context, client *compute.ResourceSkusClient) (map[string]map[string]*Sku, error) {
+	output := make(map[string]map[string]*Sku)
+
+	iterator, err := client.ListComplete(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("listing Compute SKUs: %+v", err)
+	}
+	for iterator.NotDone() {
+		addResourceSku(output, iterator.Value())
+
+		if err := iterator.NextWithContext(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// addResourceSku adds the ResourceSku to the specified map - since the API returns the same SKU
+// once per Location, the Locations for each SKU are merged together
+func addResourceSku(skus map[string]map[string]*Sku, input compute.ResourceSku) {
+	if input.ResourceType == nil || input.Name == nil {
+		return
+	}
+
+	resourceType := skuKey(*input.ResourceType)
+	if _, ok := skus[resourceType]; !ok {
+		skus[resourceType] = make(map[string]*Sku)
+	}
+
+	name := skuKey(*input.Name)
+	sku, ok := skus[resourceType][name]
+	if !ok {
+		sku = &Sku{
+			Name:                *input.Name,
+			Locations:           make(map[string][]string),
+			RestrictedLocations: make(map[string]struct{}),
+		}
+		skus[resourceType][name] = sku
+	}
+
+	sku.mergeResourceSku(input)
+}
@@ -0,0 +1,103 @@
+package skus
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+)
+
+// Sku is a SKU for a Resource Type within the Compute Resource Provider (e.g. a Virtual Machine Size)
+// along with the Locations and Availability Zones in which it's available
+type Sku struct {
+	// Name is the name of this SKU, e.g. `Standard_F2`
+	Name string
+
+	// Locations is a map of the normalized Location to the Availability Zones within that Location
+	// in which this SKU is available to this Subscription
+	Locations map[string][]string
+
+	// RestrictedLocations is the set of normalized Locations in which this SKU exists but
+	// isn't available to this Subscription
+	RestrictedLocations map[string]struct{}
+}
+
+// ZonesInLocation returns the Availability Zones in which this SKU is available in the specified Location
+func (s Sku) ZonesInLocation(loc string) []string {
+	return s.Locations[location.Normalize(loc)]
+}
+
+// mergeResourceSku merges the Locations (and Availability Zones) from the specified ResourceSku into this Sku,
+// excluding any which are restricted for this Subscription
+func (s *Sku) mergeResourceSku(input compute.ResourceSku) {
+	restrictedLocations := make(map[string]struct{})
+	restrictedZones := make(map[string]map[string]struct{})
+	if input.Restrictions != nil {
+		for _, restriction := range *input.Restrictions {
+			switch restriction.Type {
+			case compute.Location:
+				if restriction.Values != nil {
+					for _, v := range *restriction.Values {
+						restrictedLocations[location.Normalize(v)] = struct{}{}
+					}
+				}
+
+			case compute.Zone:
+				if info := restriction.RestrictionInfo; info != nil && info.Locations != nil && info.Zones != nil {
+					for _, loc := range *info.Locations {
+						normalized := location.Normalize(loc)
+						if _, ok := restrictedZones[normalized]; !ok {
+							restrictedZones[normalized] = make(map[string]struct{})
+						}
+						for _, zone := range *info.Zones {
+							restrictedZones[normalized][zone] = struct{}{}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	if input.LocationInfo != nil {
+		for _, info := range *input.LocationInfo {
+			if info.Location == nil {
+				continue
+			}
+
+			loc := location.Normalize(*info.Location)
+			if _, ok := restrictedLocations[loc]; ok {
+				s.RestrictedLocations[loc] = struct{}{}
+				continue
+			}
+
+			zones := make([]string, 0)
+			if info.Zones != nil {
+				for _, zone := range *info.Zones {
+					if _, restricted := restrictedZones[loc][zone]; !restricted {
+						zones = append(zones, zone)
+					}
+				}
+			}
+			s.Locations[loc] = append(s.Locations[loc], zones...)
+		}
+	}
+
+	// older SKUs may only expose the list of Locations, without any Zone information
+	if input.Locations != nil {
+		for _, v := range *input.Locations {
+			loc := location.Normalize(v)
+			if _, ok := restrictedLocations[loc]; ok {
+				s.RestrictedLocations[loc] = struct{}{}
+				continue
+			}
+			if _, ok := s.Locations[loc]; !ok {
+				s.Locations[loc] = make([]string, 0)
+			}
+		}
+	}
+}
+
+// skuKey returns the key used to cache the SKU, since both the Resource Type and SKU Name are case-insensitive
+func skuKey(input string) string {
+	return strings.ToLower(input)
+}
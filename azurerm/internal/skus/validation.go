@@ -0,0 +1,191 @@
+package skus
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/location"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceproviders"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// this is only here to aid testing
+var enhancedEnabled = features.EnhancedValidationEnabled()
+
+// AvailabilityFields defines the fields within a resource which are validated by ValidateAvailability
+type AvailabilityFields struct {
+	// ResourceType is the Resource Type of this resource (e.g. `Microsoft.Compute/virtualMachines`)
+	// which is used to validate the Location is supported
+	ResourceType string
+
+	// SkuResourceType is the Resource Type within the Compute Resource Provider which the SKU is
+	// listed for (e.g. `virtualMachines` or `disks`)
+	SkuResourceType string
+
+	// LocationField is the name of the field containing the Location, which can be empty
+	// when the Location isn't defined within this resource (e.g. for a child resource)
+	LocationField string
+
+	// SkuField is the name of the field containing the SKU Name, e.g. `size`
+	SkuField string
+
+	// ZonesField is the name of the field containing the Availability Zone(s), which can
+	// either be a string or a list of strings - or empty when Zones aren't supported
+	ZonesField string
+}
+
+// ValidateAvailability returns a CustomizeDiffFunc which validates that the Location is supported for this
+// Resource Type, and that the SKU is available in this Location (and the specified Availability Zones) -
+// so that invalid combinations are surfaced during the plan, rather than after provisioning has started.
+//
+// NOTE: this is best-effort - if the users offline, or the API doesn't return this information then
+// this validation is skipped
+func ValidateAvailability(fields AvailabilityFields) pluginsdk.CustomizeDiffFunc {
+	return func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+		if !enhancedEnabled {
+			return nil
+		}
+
+		// existing resources are only validated when one of these fields changes, since a SKU which
+		// is no longer available for new resources can continue to be used by existing resources
+		if d.Id() != "" {
+			changed := false
+			for _, field := range []string{fields.LocationField, fields.SkuField, fields.ZonesField} {
+				if field != "" && d.HasChange(field) {
+					changed = true
+				}
+			}
+			if !changed {
+				return nil
+			}
+		}
+
+		loc := ""
+		if values := knownValues(d, fields.LocationField); len(values) > 0 {
+			loc = values[0]
+		}
+		sku := ""
+		if values := knownValues(d, fields.SkuField); len(values) > 0 {
+			sku = values[0]
+		}
+		zones := knownValues(d, fields.ZonesField)
+
+		if err := validateLocation(fields.ResourceType, loc, resourceproviders.SupportedLocationsForResourceType(fields.ResourceType)); err != nil {
+			return err
+		}
+
+		return validateSku(fields.SkuResourceType, sku, loc, zones)
+	}
+}
+
+// knownValues returns the value(s) for the specified field, when these are known during the plan
+func knownValues(d *pluginsdk.ResourceDiff, field string) []string {
+	if field == "" || !d.NewValueKnown(field) {
+		return nil
+	}
+
+	output := make([]string, 0)
+	switch v := d.Get(field).(type) {
+	case string:
+		if v != "" {
+			output = append(output, v)
+		}
+
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s != "" {
+				output = append(output, s)
+			}
+		}
+	}
+
+	return output
+}
+
+func validateLocation(resourceType, loc string, supported *[]string) error {
+	if resourceType == "" || loc == "" || supported == nil {
+		return nil
+	}
+
+	for _, v := range *supported {
+		if location.Normalize(v) == location.Normalize(loc) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%q is not available in the location %q - the supported locations are: %s", resourceType, location.Normalize(loc), formatLocations(*supported))
+}
+
+func validateSku(resourceType, name, loc string, zones []string) error {
+	if resourceType == "" || name == "" || supportedSkus == nil {
+		return nil
+	}
+
+	skus, ok := supportedSkus[skuKey(resourceType)]
+	if !ok {
+		return nil
+	}
+
+	sku, ok := skus[skuKey(name)]
+	if !ok {
+		return fmt.Errorf("the SKU %q was not found in the list of supported SKUs for %q", name, resourceType)
+	}
+
+	// when the Location isn't known (e.g. it's defined on a parent resource) only the SKU name can be validated
+	if loc == "" {
+		return nil
+	}
+
+	normalized := location.Normalize(loc)
+	availableZones, ok := sku.Locations[normalized]
+	if !ok {
+		if _, restricted := sku.RestrictedLocations[normalized]; restricted {
+			return fmt.Errorf("the SKU %q is not available to this Subscription in the location %q", name, normalized)
+		}
+
+		locations := make([]string, 0)
+		for v := range sku.Locations {
+			locations = append(locations, v)
+		}
+		return fmt.Errorf("the SKU %q is not available in the location %q - this SKU is available in: %s", name, normalized, formatLocations(locations))
+	}
+
+	for _, zone := range zones {
+		found := false
+		for _, v := range availableZones {
+			if v == zone {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			if len(availableZones) == 0 {
+				return fmt.Errorf("the SKU %q does not support Availability Zones in the location %q", name, normalized)
+			}
+
+			sortedZones := append([]string{}, availableZones...)
+			sort.Strings(sortedZones)
+			return fmt.Errorf("the SKU %q is not available in Availability Zone %q in the location %q - this SKU is available in the Availability Zones: %s", name, zone, normalized, strings.Join(sortedZones, ", "))
+		}
+	}
+
+	return nil
+}
+
+func formatLocations(input []string) string {
+	locations := make([]string, 0)
+	for _, v := range input {
+		locations = append(locations, location.Normalize(v))
+	}
+	sort.Strings(locations)
+
+	if len(locations) == 0 {
+		return "(none)"
+	}
+
+	return strings.Join(locations, ", ")
+}
@@ -0,0 +1,244 @@
+package skus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2020-12-01/compute"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func testSkus() map[string]map[string]*Sku {
+	skus := make(map[string]map[string]*Sku)
+	addResourceSku(skus, compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String("Standard_F2"),
+		Locations:    &[]string{"westeurope"},
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("westeurope"),
+				Zones:    &[]string{"3", "1", "2"},
+			},
+		},
+		Restrictions: &[]compute.ResourceSkuRestrictions{
+			{
+				Type: compute.Zone,
+				RestrictionInfo: &compute.ResourceSkuRestrictionInfo{
+					Locations: &[]string{"westeurope"},
+					Zones:     &[]string{"3"},
+				},
+			},
+		},
+	})
+	addResourceSku(skus, compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String("Standard_F2"),
+		Locations:    &[]string{"UKSouth"},
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("UKSouth"),
+			},
+		},
+	})
+	addResourceSku(skus, compute.ResourceSku{
+		ResourceType: utils.String("virtualMachines"),
+		Name:         utils.String("Standard_F2"),
+		Locations:    &[]string{"eastus"},
+		LocationInfo: &[]compute.ResourceSkuLocationInfo{
+			{
+				Location: utils.String("eastus"),
+			},
+		},
+		Restrictions: &[]compute.ResourceSkuRestrictions{
+			{
+				Type:       compute.Location,
+				Values:     &[]string{"eastus"},
+				ReasonCode: compute.NotAvailableForSubscription,
+			},
+		},
+	})
+	addResourceSku(skus, compute.ResourceSku{
+		ResourceType: utils.String("disks"),
+		Name:         utils.String("Premium_LRS"),
+		Locations:    &[]string{"westeurope"},
+	})
+	return skus
+}
+
+func TestAddResourceSku(t *testing.T) {
+	skus := testSkus()
+
+	sku, ok := skus["virtualmachines"]["standard_f2"]
+	if !ok {
+		t.Fatalf("expected the SKU `Standard_F2` to be cached")
+	}
+	if len(sku.Locations) != 2 {
+		t.Fatalf("expected the SKU to be available in 2 locations but got %d", len(sku.Locations))
+	}
+	if zones := sku.ZonesInLocation("West Europe"); len(zones) != 2 {
+		t.Fatalf("expected the SKU to be available in 2 zones in `westeurope` but got %+v", zones)
+	}
+	if _, ok := sku.RestrictedLocations["eastus"]; !ok {
+		t.Fatalf("expected `eastus` to be restricted")
+	}
+}
+
+func TestValidateSku(t *testing.T) {
+	testData := []struct {
+		Name         string
+		ResourceType string
+		Sku          string
+		Location     string
+		Zones        []string
+		Valid        bool
+	}{
+		{
+			Name:         "Available",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Location:     "westeurope",
+			Valid:        true,
+		},
+		{
+			Name:         "Available in a Zone",
+			ResourceType: "virtualMachines",
+			Sku:          "standard_f2",
+			Location:     "West Europe",
+			Zones:        []string{"1", "2"},
+			Valid:        true,
+		},
+		{
+			Name:         "Restricted Zone",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Location:     "westeurope",
+			Zones:        []string{"3"},
+			Valid:        false,
+		},
+		{
+			Name:         "Zones aren't supported",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Location:     "uksouth",
+			Zones:        []string{"1"},
+			Valid:        false,
+		},
+		{
+			Name:         "Restricted Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Location:     "eastus",
+			Valid:        false,
+		},
+		{
+			Name:         "Unavailable Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Location:     "westus",
+			Valid:        false,
+		},
+		{
+			Name:         "Unknown SKU",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_Z999",
+			Location:     "westeurope",
+			Valid:        false,
+		},
+		{
+			Name:         "Unknown SKU without a Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_Z999",
+			Valid:        false,
+		},
+		{
+			Name:         "Known SKU without a Location",
+			ResourceType: "virtualMachines",
+			Sku:          "Standard_F2",
+			Zones:        []string{"3"},
+			Valid:        true,
+		},
+		{
+			Name:         "Unknown Resource Type",
+			ResourceType: "snapshots",
+			Sku:          "Standard_LRS",
+			Location:     "westeurope",
+			Valid:        true,
+		},
+		{
+			Name:         "Disk SKU",
+			ResourceType: "disks",
+			Sku:          "Premium_LRS",
+			Location:     "westeurope",
+			Valid:        true,
+		},
+	}
+
+	supportedSkus = testSkus()
+	defer func() {
+		supportedSkus = nil
+	}()
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		err := validateSku(v.ResourceType, v.Sku, v.Location, v.Zones)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
+
+func TestValidateSkuWhenOffline(t *testing.T) {
+	supportedSkus = nil
+	if err := validateSku("virtualMachines", "Standard_Z999", "westeurope", []string{"1"}); err != nil {
+		t.Fatalf("expected no error when the SKUs aren't available but got %+v", err)
+	}
+}
+
+func TestValidateLocation(t *testing.T) {
+	testData := []struct {
+		Name      string
+		Location  string
+		Supported *[]string
+		Valid     bool
+	}{
+		{
+			Name:      "Unknown",
+			Location:  "westeurope",
+			Supported: nil,
+			Valid:     true,
+		},
+		{
+			Name:      "Supported",
+			Location:  "westeurope",
+			Supported: &[]string{"West Europe", "UK South"},
+			Valid:     true,
+		},
+		{
+			Name:      "Supported Display Name",
+			Location:  "UK South",
+			Supported: &[]string{"West Europe", "UK South"},
+			Valid:     true,
+		},
+		{
+			Name:      "Unsupported",
+			Location:  "westus",
+			Supported: &[]string{"West Europe", "UK South"},
+			Valid:     false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q", v.Name)
+
+		err := validateLocation("Microsoft.Compute/virtualMachines", v.Location, v.Supported)
+		if v.Valid && err != nil {
+			t.Fatalf("expected no error but got %+v", err)
+		}
+		if !v.Valid && err == nil {
+			t.Fatalf("expected an error but didn't get one")
+		}
+	}
+}
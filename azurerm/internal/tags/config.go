@@ -0,0 +1,117 @@
+package tags
+
+import (
+	"strings"
+)
+
+// Config defines the Tags which are applied to, and ignored on, every resource
+// these are configured in the Provider block using `default_tags` and `ignore_tags`
+//
+// NOTE: since a Provider block can be aliased, this is available on the Client for each
+// Provider block - rather than being configured globally
+type Config struct {
+	// DefaultTags are the Tags which are applied to every resource, unless overridden in the resource's `tags`
+	DefaultTags map[string]string
+
+	// IgnoreTags defines the Tags which should be ignored when reading the Tags for a resource
+	IgnoreTags IgnoreConfig
+}
+
+// IgnoreConfig defines the Tags which should be ignored, for example because they're managed by Azure Policy
+type IgnoreConfig struct {
+	// Keys is a list of Tag Keys which should be ignored
+	Keys []string
+
+	// KeyPrefixes is a list of prefixes for Tag Keys which should be ignored
+	KeyPrefixes []string
+}
+
+// IsIgnored returns whether the specified Tag Key should be ignored - Tag Keys are case-insensitive in Azure
+func (c IgnoreConfig) IsIgnored(key string) bool {
+	for _, v := range c.Keys {
+		if strings.EqualFold(v, key) {
+			return true
+		}
+	}
+
+	for _, v := range c.KeyPrefixes {
+		if v != "" && strings.HasPrefix(strings.ToLower(key), strings.ToLower(v)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MergeDefaultTags returns the Default Tags merged with the specified Tags, where the specified
+// Tags take precedence over a Default Tag with the same (case-insensitive) key
+func (c Config) MergeDefaultTags(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(c.DefaultTags)+len(input))
+
+	for k, v := range c.DefaultTags {
+		if containsKey(input, k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	for k, v := range input {
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveIgnoredTags returns the specified Tags without any which should be ignored
+func (c Config) RemoveIgnoredTags(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if c.IgnoreTags.IsIgnored(k) {
+			continue
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+// RemoveDefaultTags returns the specified Tags without any Default Tags (that is, Tags with the same key and
+// value as a Default Tag) - unless that Tag is also present in the configured Tags for this resource
+func (c Config) RemoveDefaultTags(configured map[string]interface{}, input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{}, len(input))
+
+	for k, v := range input {
+		if _, ok := configured[k]; !ok {
+			if defaultValue, ok := c.defaultTagValue(k); ok && defaultValue == v {
+				continue
+			}
+		}
+
+		output[k] = v
+	}
+
+	return output
+}
+
+func (c Config) defaultTagValue(key string) (string, bool) {
+	for k, v := range c.DefaultTags {
+		if strings.EqualFold(k, key) {
+			return v, true
+		}
+	}
+
+	return "", false
+}
+
+func containsKey(input map[string]interface{}, key string) bool {
+	for k := range input {
+		if strings.EqualFold(k, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,155 @@
+package tags
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+func TestIgnoreConfig_IsIgnored(t *testing.T) {
+	ignore := IgnoreConfig{
+		Keys:        []string{"CreatedBy"},
+		KeyPrefixes: []string{"policy-"},
+	}
+
+	cases := []struct {
+		Key      string
+		Expected bool
+	}{
+		{
+			Key:      "CreatedBy",
+			Expected: true,
+		},
+		{
+			Key:      "createdby",
+			Expected: true,
+		},
+		{
+			Key:      "CreatedByTerraform",
+			Expected: false,
+		},
+		{
+			Key:      "Policy-Owner",
+			Expected: true,
+		},
+		{
+			Key:      "environment",
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Key, func(t *testing.T) {
+			if actual := ignore.IsIgnored(tc.Key); actual != tc.Expected {
+				t.Fatalf("Expected %t but got %t", tc.Expected, actual)
+			}
+		})
+	}
+}
+
+func TestConfig_MergeDefaultTags(t *testing.T) {
+	config := Config{
+		DefaultTags: map[string]string{
+			"Environment": "production",
+			"CostCenter":  "123",
+		},
+	}
+
+	merged := config.MergeDefaultTags(map[string]interface{}{
+		"environment": "staging",
+		"owner":       "team1",
+	})
+	expected := map[string]interface{}{
+		"CostCenter":  "123",
+		"environment": "staging",
+		"owner":       "team1",
+	}
+	if !reflect.DeepEqual(merged, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, merged)
+	}
+
+	withoutDefaults := Config{}.MergeDefaultTags(map[string]interface{}{
+		"owner": "team1",
+	})
+	if !reflect.DeepEqual(withoutDefaults, map[string]interface{}{"owner": "team1"}) {
+		t.Fatalf("Expected no Default Tags to be merged but got %+v", withoutDefaults)
+	}
+}
+
+func TestConfig_RemoveIgnoredTags(t *testing.T) {
+	config := Config{
+		IgnoreTags: IgnoreConfig{
+			Keys:        []string{"CreatedBy"},
+			KeyPrefixes: []string{"policy-"},
+		},
+	}
+
+	actual := config.RemoveIgnoredTags(map[string]interface{}{
+		"createdBy":     "someone",
+		"policy-source": "azure",
+		"owner":         "team1",
+	})
+	expected := map[string]interface{}{
+		"owner": "team1",
+	}
+	if !reflect.DeepEqual(actual, expected) {
+		t.Fatalf("Expected %+v but got %+v", expected, actual)
+	}
+}
+
+func TestConfig_SetTagsAll(t *testing.T) {
+	config := Config{
+		DefaultTags: map[string]string{
+			"Environment": "production",
+			"CostCenter":  "123",
+		},
+		IgnoreTags: IgnoreConfig{
+			Keys: []string{"CreatedBy"},
+		},
+	}
+
+	resourceSchema := map[string]*pluginsdk.Schema{
+		"tags":     Schema(),
+		"tags_all": SchemaTagsAll(),
+	}
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{})
+
+	// the Tags as returned from Azure
+	remote := FromTypedObject(map[string]string{
+		"Environment": "production",
+		"CostCenter":  "123",
+		"owner":       "team1",
+		"CreatedBy":   "someone",
+	})
+	if err := FlattenAndSet(d, remote); err != nil {
+		t.Fatalf("flattening: %+v", err)
+	}
+
+	configured := map[string]interface{}{
+		"CostCenter": "123",
+		"owner":      "team1",
+	}
+	if err := config.SetTagsAll(d, configured); err != nil {
+		t.Fatalf("setting `tags_all`: %+v", err)
+	}
+
+	// the Default Tag `CostCenter` is retained since it's also configured on the resource
+	expectedTags := map[string]interface{}{
+		"CostCenter": "123",
+		"owner":      "team1",
+	}
+	if actual := d.Get("tags").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTags) {
+		t.Fatalf("Expected `tags` to be %+v but got %+v", expectedTags, actual)
+	}
+
+	expectedTagsAll := map[string]interface{}{
+		"Environment": "production",
+		"CostCenter":  "123",
+		"owner":       "team1",
+	}
+	if actual := d.Get("tags_all").(map[string]interface{}); !reflect.DeepEqual(actual, expectedTagsAll) {
+		t.Fatalf("Expected `tags_all` to be %+v but got %+v", expectedTagsAll, actual)
+	}
+}
@@ -0,0 +1,70 @@
+package tags
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+// SchemaTagsAll returns the Schema used for the `tags_all` field, which contains all of the Tags
+// assigned to the resource, including any Default Tags configured in the Provider block
+func SchemaTagsAll() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeMap,
+		Computed: true,
+		Elem: &pluginsdk.Schema{
+			Type: pluginsdk.TypeString,
+		},
+	}
+}
+
+// SupportsTagsAll returns whether the specified resource can have a `tags_all` field - which is
+// the case when the resource has a user-configurable `tags` field and no existing `tags_all` field
+func SupportsTagsAll(resource *pluginsdk.Resource) bool {
+	if _, exists := resource.Schema["tags_all"]; exists {
+		return false
+	}
+
+	v, exists := resource.Schema["tags"]
+	if !exists {
+		return false
+	}
+
+	return v.Type == pluginsdk.TypeMap && (v.Optional || v.Required)
+}
+
+// CustomizeDiffTagsAll sets the planned value of `tags_all` to the configured Tags merged with the
+// Default Tags configured in the Provider block, such that the plan shows the effective Tags
+func (c Config) CustomizeDiffTagsAll(d *pluginsdk.ResourceDiff) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+
+	configured, _ := d.Get("tags").(map[string]interface{})
+	merged := c.RemoveIgnoredTags(c.MergeDefaultTags(configured))
+
+	existing, _ := d.Get("tags_all").(map[string]interface{})
+	if reflect.DeepEqual(existing, merged) {
+		return nil
+	}
+
+	return d.SetNew("tags_all", merged)
+}
+
+// SetTagsAll sets `tags_all` to all of the Tags for this resource (other than those which are ignored), and
+// removes any Default Tags from `tags` which aren't also present in the configured Tags for this resource
+func (c Config) SetTagsAll(d *pluginsdk.ResourceData, configured map[string]interface{}) error {
+	existing, _ := d.Get("tags").(map[string]interface{})
+	all := c.RemoveIgnoredTags(existing)
+
+	if err := d.Set("tags", c.RemoveDefaultTags(configured, all)); err != nil {
+		return fmt.Errorf("setting `tags`: %+v", err)
+	}
+
+	if err := d.Set("tags_all", all); err != nil {
+		return fmt.Errorf("setting `tags_all`: %+v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,477 @@
+package validation
+
+import (
+	"strings"
+)
+
+// NamingScope is the scope within which the name of a resource must be unique
+type NamingScope string
+
+const (
+	// NamingScopeGlobal means the name must be globally unique, since it's used in a DNS Name
+	NamingScopeGlobal NamingScope = "Global"
+
+	// NamingScopeParent means the name must be unique within the parent resource
+	NamingScopeParent NamingScope = "Parent"
+
+	// NamingScopeResourceGroup means the name must be unique within the Resource Group
+	NamingScopeResourceGroup NamingScope = "ResourceGroup"
+
+	// NamingScopeSubscription means the name must be unique within the Subscription
+	NamingScopeSubscription NamingScope = "Subscription"
+)
+
+// NamingCase defines the casing which can be used within the name of a resource
+type NamingCase string
+
+const (
+	// NamingCaseAny means both uppercase and lowercase letters can be used
+	NamingCaseAny NamingCase = "Any"
+
+	// NamingCaseLower means only lowercase letters can be used
+	NamingCaseLower NamingCase = "Lower"
+)
+
+// NamingRule defines the rules which the name of a resource of a given Resource Type must meet
+type NamingRule struct {
+	// MinLength is the minimum length of the name
+	MinLength int
+
+	// MaxLength is the maximum length of the name
+	MaxLength int
+
+	// Case is the casing which can be used within the name
+	Case NamingCase
+
+	// ValidCharacters are the characters which can be used within the name, as the contents of a
+	// Regular Expression character class (e.g. `a-z0-9-`) - which is matched case-insensitively
+	ValidCharacters string
+
+	// ValidCharactersDescription describes the ValidCharacters, e.g. `letters, numbers and hyphens`
+	ValidCharactersDescription string
+
+	// StartCharacters are the characters which the name can start with, in the same format as ValidCharacters
+	// when this is empty the name can start with any of the ValidCharacters
+	StartCharacters string
+
+	// StartCharactersDescription describes the StartCharacters, e.g. `a letter or number`
+	StartCharactersDescription string
+
+	// EndCharacters are the characters which the name can end with, in the same format as ValidCharacters
+	// when this is empty the name can end with any of the ValidCharacters
+	EndCharacters string
+
+	// EndCharactersDescription describes the EndCharacters, e.g. `a letter or number`
+	EndCharactersDescription string
+
+	// NoConsecutiveHyphens specifies that the name cannot contain consecutive hyphens
+	NoConsecutiveHyphens bool
+
+	// ReservedWords are names which cannot be used (which are compared case-insensitively)
+	ReservedWords []string
+
+	// ReservedSuffixes are suffixes which the name cannot end with (which are compared case-insensitively)
+	ReservedSuffixes []string
+
+	// AllowedNames are well-known names which are valid, even though they don't meet the rules above
+	AllowedNames []string
+
+	// Scope is the scope within which the name must be unique
+	Scope NamingScope
+
+	// Legacy is the (less strict) rule which was previously used to validate the names of this Resource
+	// Type, where this differs from the rules documented by Azure. Names which are only valid using the
+	// Legacy rule raise a deprecation warning (rather than an error) until v3.0 of the Provider, so that
+	// the configurations for existing resources continue to work.
+	Legacy *NamingRule
+}
+
+const (
+	alphanumericCharacters              = "a-z0-9"
+	letterOrNumber                      = "a letter or number"
+	alphanumericOrUnderscoreCharacters  = "a-z0-9_"
+	letterNumberOrUnderscore            = "a letter, number or underscore"
+	letterCharacters                    = "a-z"
+	aLetter                             = "a letter"
+	anyCharacters                       = `\s\S`
+	anyCharactersDesc                   = "any characters"
+	networkingCharacters                = "a-z0-9_.-"
+	networkingCharactersDesc            = "letters, numbers, underscores, periods and hyphens"
+	lettersNumbersAndHyphens            = "letters, numbers and hyphens"
+	lettersAndNumbers                   = "letters and numbers"
+	lettersNumbersUnderscoresAndHyphens = "letters, numbers, underscores and hyphens"
+)
+
+// reservedWords are words which can't be used as the name of resources which expose a public endpoint
+// https://docs.microsoft.com/azure/azure-resource-manager/templates/error-reserved-resource-name
+var reservedWords = []string{
+	"access", "app_browsers", "app_code", "app_data", "app_globalresources", "app_localresources", "app_themes",
+	"app_webreferences", "azure", "bing", "bizspark", "biztalk", "cortana", "directx", "dotnet", "dynamics", "excel",
+	"exchange", "forefront", "groove", "hololens", "hyperv", "kinect", "lync", "msdn", "o365", "office",
+	"office365", "onedrive", "onenote", "outlook", "powerpoint", "sharepoint", "skype", "visio", "visualstudio",
+}
+
+// namingRules are the Naming Rules for each Resource Type, keyed by the Resource Type
+// https://docs.microsoft.com/azure/azure-resource-manager/management/resource-name-rules
+//
+// Where the validation previously used for a Resource Type was less strict than these rules, this is
+// defined as the Legacy rule - so that existing names raise a deprecation warning rather than an error.
+// Resource Types which weren't previously validated don't need a Legacy rule, since Azure rejects
+// names which don't meet these rules.
+var namingRules = map[string]NamingRule{
+	"Microsoft.Cache/redis": {
+		MinLength:                  1,
+		MaxLength:                  63,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		NoConsecutiveHyphens:       true,
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.ContainerRegistry/registries": {
+		MinLength:                  5,
+		MaxLength:                  50,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            alphanumericCharacters,
+		ValidCharactersDescription: lettersAndNumbers,
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.ContainerService/managedClusters": {
+		MinLength:                  1,
+		MaxLength:                  63,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9_-",
+		ValidCharactersDescription: lettersNumbersUnderscoresAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.DocumentDB/databaseAccounts": {
+		MinLength:                  3,
+		MaxLength:                  44,
+		Case:                       NamingCaseLower,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		ReservedWords:              reservedWords,
+		Scope:                      NamingScopeGlobal,
+		Legacy: &NamingRule{
+			MinLength:                  3,
+			MaxLength:                  50,
+			Case:                       NamingCaseLower,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			Scope:                      NamingScopeGlobal,
+		},
+	},
+	"Microsoft.EventHub/namespaces": {
+		MinLength:                  6,
+		MaxLength:                  50,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            letterCharacters,
+		StartCharactersDescription: aLetter,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.EventHub/namespaces/eventhubs/consumerGroups": {
+		MinLength:                  1,
+		MaxLength:                  50,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9_.-",
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeParent,
+	},
+	"Microsoft.KeyVault/vaults": {
+		MinLength:                  3,
+		MaxLength:                  24,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            letterCharacters,
+		StartCharactersDescription: aLetter,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		NoConsecutiveHyphens:       true,
+		ReservedWords:              reservedWords,
+		Scope:                      NamingScopeGlobal,
+		Legacy: &NamingRule{
+			MinLength:                  3,
+			MaxLength:                  24,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			Scope:                      NamingScopeGlobal,
+		},
+	},
+	"Microsoft.ManagedIdentity/userAssignedIdentities": {
+		MinLength:                  3,
+		MaxLength:                  128,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9_-",
+		ValidCharactersDescription: lettersNumbersUnderscoresAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		Scope:                      NamingScopeResourceGroup,
+		Legacy: &NamingRule{
+			MinLength:                  3,
+			MaxLength:                  128,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            anyCharacters,
+			ValidCharactersDescription: anyCharactersDesc,
+			Scope:                      NamingScopeResourceGroup,
+		},
+	},
+	"Microsoft.Network/networkInterfaces": {
+		MinLength:                  1,
+		MaxLength:                  80,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            networkingCharacters,
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericOrUnderscoreCharacters,
+		EndCharactersDescription:   letterNumberOrUnderscore,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.Network/networkSecurityGroups": {
+		MinLength:                  1,
+		MaxLength:                  80,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            networkingCharacters,
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericOrUnderscoreCharacters,
+		EndCharactersDescription:   letterNumberOrUnderscore,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.Network/publicIPAddresses": {
+		MinLength:                  1,
+		MaxLength:                  80,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            networkingCharacters,
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericOrUnderscoreCharacters,
+		EndCharactersDescription:   letterNumberOrUnderscore,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.Network/virtualNetworks": {
+		MinLength:                  2,
+		MaxLength:                  64,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            networkingCharacters,
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericOrUnderscoreCharacters,
+		EndCharactersDescription:   letterNumberOrUnderscore,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.Network/virtualNetworks/subnets": {
+		MinLength:                  1,
+		MaxLength:                  80,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            networkingCharacters,
+		ValidCharactersDescription: networkingCharactersDesc,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericOrUnderscoreCharacters,
+		EndCharactersDescription:   letterNumberOrUnderscore,
+		Scope:                      NamingScopeParent,
+	},
+	"Microsoft.OperationalInsights/workspaces": {
+		MinLength:                  4,
+		MaxLength:                  63,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeResourceGroup,
+	},
+	"Microsoft.Resources/resourceGroups": {
+		MinLength:                  1,
+		MaxLength:                  90,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9_.()-",
+		ValidCharactersDescription: "letters, numbers, underscores, periods, parentheses and hyphens",
+		EndCharacters:              "a-z0-9_()-",
+		EndCharactersDescription:   "a letter, number, underscore, parenthesis or hyphen",
+		Scope:                      NamingScopeSubscription,
+	},
+	"Microsoft.ServiceBus/namespaces": {
+		MinLength:                  6,
+		MaxLength:                  50,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            letterCharacters,
+		StartCharactersDescription: aLetter,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		ReservedSuffixes:           []string{"-sb", "-mgmt"},
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.Sql/servers": {
+		MinLength:                  1,
+		MaxLength:                  63,
+		Case:                       NamingCaseLower,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.Storage/storageAccounts": {
+		MinLength:                  3,
+		MaxLength:                  24,
+		Case:                       NamingCaseLower,
+		ValidCharacters:            alphanumericCharacters,
+		ValidCharactersDescription: lettersAndNumbers,
+		Scope:                      NamingScopeGlobal,
+	},
+	"Microsoft.Storage/storageAccounts/blobServices/containers": {
+		MinLength:                  3,
+		MaxLength:                  63,
+		Case:                       NamingCaseLower,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		NoConsecutiveHyphens:       true,
+		AllowedNames:               []string{"$root", "$web"},
+		Scope:                      NamingScopeParent,
+		Legacy: &NamingRule{
+			MinLength:                  3,
+			MaxLength:                  63,
+			Case:                       NamingCaseLower,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			StartCharacters:            alphanumericCharacters,
+			StartCharactersDescription: letterOrNumber,
+			AllowedNames:               []string{"$root", "$web"},
+			Scope:                      NamingScopeParent,
+		},
+	},
+	"Microsoft.Web/serverFarms": {
+		MinLength:                  1,
+		MaxLength:                  60,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		Scope:                      NamingScopeResourceGroup,
+		Legacy: &NamingRule{
+			MinLength:                  1,
+			MaxLength:                  60,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            "a-z0-9_-",
+			ValidCharactersDescription: lettersNumbersUnderscoresAndHyphens,
+			Scope:                      NamingScopeResourceGroup,
+		},
+	},
+	"Microsoft.Web/hostingEnvironments": {
+		MinLength:                  2,
+		MaxLength:                  60,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		Scope:                      NamingScopeGlobal,
+		Legacy: &NamingRule{
+			MinLength:                  2,
+			MaxLength:                  63,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			StartCharacters:            alphanumericCharacters,
+			StartCharactersDescription: letterOrNumber,
+			EndCharacters:              alphanumericCharacters,
+			EndCharactersDescription:   letterOrNumber,
+			Scope:                      NamingScopeGlobal,
+		},
+	},
+	"Microsoft.Web/sites": {
+		MinLength:                  2,
+		MaxLength:                  60,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		StartCharacters:            alphanumericCharacters,
+		StartCharactersDescription: letterOrNumber,
+		EndCharacters:              alphanumericCharacters,
+		EndCharactersDescription:   letterOrNumber,
+		ReservedWords:              reservedWords,
+		Scope:                      NamingScopeGlobal,
+		Legacy: &NamingRule{
+			MinLength:                  1,
+			MaxLength:                  60,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			Scope:                      NamingScopeGlobal,
+		},
+	},
+	"Microsoft.Web/sites/slots": {
+		MinLength:                  2,
+		MaxLength:                  59,
+		Case:                       NamingCaseAny,
+		ValidCharacters:            "a-z0-9-",
+		ValidCharactersDescription: lettersNumbersAndHyphens,
+		Scope:                      NamingScopeParent,
+		Legacy: &NamingRule{
+			MinLength:                  1,
+			MaxLength:                  60,
+			Case:                       NamingCaseAny,
+			ValidCharacters:            "a-z0-9-",
+			ValidCharactersDescription: lettersNumbersAndHyphens,
+			Scope:                      NamingScopeParent,
+		},
+	},
+}
+
+// NamingRuleForResourceType returns the Naming Rule for the specified Resource Type
+// (e.g. `Microsoft.Storage/storageAccounts`), which is matched case-insensitively
+func NamingRuleForResourceType(resourceType string) (*NamingRule, bool) {
+	for k, v := range namingRules {
+		if strings.EqualFold(k, resourceType) {
+			rule := v
+			return &rule, true
+		}
+	}
+
+	return nil, false
+}
+
+// NamingRules returns the Naming Rules for each Resource Type, keyed by the Resource Type
+func NamingRules() map[string]NamingRule {
+	output := make(map[string]NamingRule, len(namingRules))
+	for k, v := range namingRules {
+		output[k] = v
+	}
+	return output
+}
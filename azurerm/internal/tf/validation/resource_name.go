@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/features"
+)
+
+// ResourceName returns a SchemaValidateFunc which tests if the provided value
+// is a valid name for a resource of the specified Resource Type, using the Naming Rules
+// defined for this Resource Type (e.g. `Microsoft.Storage/storageAccounts`).
+//
+// Names which don't meet these rules but were valid using the Legacy rule for this Resource
+// Type raise a deprecation warning, rather than an error, until v3.0 of the Provider.
+func ResourceName(resourceType string) schema.SchemaValidateFunc {
+	rule, ok := NamingRuleForResourceType(resourceType)
+	if !ok {
+		// this is a programming error, so is surfaced when the Provider Schema is built
+		panic(fmt.Sprintf("no Naming Rule is defined for the Resource Type %q", resourceType))
+	}
+
+	validator := newNamingRuleValidator(*rule)
+	return validator.validate
+}
+
+type namingRuleValidator struct {
+	rule            NamingRule
+	validCharacters *regexp.Regexp
+	startCharacter  *regexp.Regexp
+	endCharacter    *regexp.Regexp
+	legacy          *namingRuleValidator
+}
+
+func newNamingRuleValidator(rule NamingRule) namingRuleValidator {
+	validator := namingRuleValidator{
+		rule: rule,
+		// the casing of the name is validated separately, so that the error is clearer
+		validCharacters: regexp.MustCompile(fmt.Sprintf(`(?i)^[%s]*$`, rule.ValidCharacters)),
+	}
+	if rule.StartCharacters != "" {
+		validator.startCharacter = regexp.MustCompile(fmt.Sprintf(`(?i)^[%s]`, rule.StartCharacters))
+	}
+	if rule.EndCharacters != "" {
+		validator.endCharacter = regexp.MustCompile(fmt.Sprintf(`(?i)[%s]$`, rule.EndCharacters))
+	}
+	if rule.Legacy != nil {
+		legacy := newNamingRuleValidator(*rule.Legacy)
+		validator.legacy = &legacy
+	}
+	return validator
+}
+
+func (v namingRuleValidator) validate(i interface{}, k string) (warnings []string, errors []error) {
+	value, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	errors = v.validateName(value, k)
+	if len(errors) == 0 || v.legacy == nil || features.ThreePointOh() {
+		return
+	}
+
+	// names which were previously valid are deprecated, rather than being invalid, so that existing configurations continue to work
+	if legacyErrors := v.legacy.validateName(value, k); len(legacyErrors) == 0 {
+		for _, err := range errors {
+			warnings = append(warnings, fmt.Sprintf("%s - this name doesn't meet the naming rules documented by Azure and will be invalid in v3.0 of the AzureRM Provider", err))
+		}
+		errors = nil
+	}
+
+	return
+}
+
+func (v namingRuleValidator) validateName(value, k string) (errors []error) {
+	for _, allowed := range v.rule.AllowedNames {
+		if value == allowed {
+			return
+		}
+	}
+
+	if length := utf8.RuneCountInString(value); length < v.rule.MinLength || length > v.rule.MaxLength {
+		errors = append(errors, fmt.Errorf("%q must be between %d and %d characters in length but got %d characters: %q", k, v.rule.MinLength, v.rule.MaxLength, length, value))
+	}
+
+	if !v.validCharacters.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q can only contain %s: %q", k, v.rule.ValidCharactersDescription, value))
+	}
+
+	if v.rule.Case == NamingCaseLower && strings.ToLower(value) != value {
+		errors = append(errors, fmt.Errorf("%q can only contain lowercase letters: %q", k, value))
+	}
+
+	if value == "" {
+		return
+	}
+
+	if v.startCharacter != nil && !v.startCharacter.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must start with %s: %q", k, v.rule.StartCharactersDescription, value))
+	}
+
+	if v.endCharacter != nil && !v.endCharacter.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%q must end with %s: %q", k, v.rule.EndCharactersDescription, value))
+	}
+
+	if v.rule.NoConsecutiveHyphens && strings.Contains(value, "--") {
+		errors = append(errors, fmt.Errorf("%q cannot contain consecutive hyphens: %q", k, value))
+	}
+
+	for _, word := range v.rule.ReservedWords {
+		if strings.EqualFold(value, word) {
+			errors = append(errors, fmt.Errorf("%q cannot be the reserved word %q", k, value))
+		}
+	}
+
+	for _, suffix := range v.rule.ReservedSuffixes {
+		if strings.HasSuffix(strings.ToLower(value), strings.ToLower(suffix)) {
+			errors = append(errors, fmt.Errorf("%q cannot end with %q: %q", k, suffix, value))
+		}
+	}
+
+	return
+}
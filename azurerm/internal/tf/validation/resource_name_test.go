@@ -0,0 +1,304 @@
+package validation
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type resourceNameTestCase struct {
+	Name  string
+	Input string
+	Valid bool
+}
+
+// testCasesForNamingRule generates the test cases for the specified Naming Rule from the rule itself
+func testCasesForNamingRule(rule NamingRule) []resourceNameTestCase {
+	padded := func(prefix, suffix string, length int) string {
+		if padding := length - len(prefix) - len(suffix); padding > 0 {
+			return prefix + strings.Repeat("a", padding) + suffix
+		}
+		return prefix + suffix
+	}
+	minLength := rule.MinLength
+	if minLength < 3 {
+		minLength = 3
+	}
+	validCharacters := regexp.MustCompile(fmt.Sprintf(`^[%s]$`, rule.ValidCharacters))
+
+	testCases := []resourceNameTestCase{
+		{
+			Name:  "Minimum Length",
+			Input: strings.Repeat("a", rule.MinLength),
+			Valid: true,
+		},
+		{
+			Name:  "Maximum Length",
+			Input: strings.Repeat("a", rule.MaxLength),
+			Valid: true,
+		},
+		{
+			Name:  "Above Maximum Length",
+			Input: strings.Repeat("a", rule.MaxLength+1),
+			Valid: false,
+		},
+		{
+			Name:  "Invalid Character",
+			Input: padded("a!", "a", minLength),
+			Valid: validCharacters.MatchString("!"),
+		},
+		{
+			Name:  "Uppercase",
+			Input: strings.Repeat("A", minLength),
+			Valid: rule.Case == NamingCaseAny,
+		},
+	}
+
+	if rule.MinLength > 0 {
+		testCases = append(testCases, resourceNameTestCase{
+			Name:  "Below Minimum Length",
+			Input: strings.Repeat("a", rule.MinLength-1),
+			Valid: false,
+		})
+	}
+
+	for _, character := range []string{"-", "_", ".", "(", ")", "0"} {
+		if !validCharacters.MatchString(character) {
+			continue
+		}
+
+		if rule.StartCharacters != "" && !regexp.MustCompile(fmt.Sprintf(`^[%s]$`, rule.StartCharacters)).MatchString(character) {
+			testCases = append(testCases, resourceNameTestCase{
+				Name:  fmt.Sprintf("Starting with %q", character),
+				Input: padded(character, "a", minLength),
+				Valid: false,
+			})
+		}
+
+		if rule.EndCharacters != "" && !regexp.MustCompile(fmt.Sprintf(`^[%s]$`, rule.EndCharacters)).MatchString(character) {
+			testCases = append(testCases, resourceNameTestCase{
+				Name:  fmt.Sprintf("Ending with %q", character),
+				Input: padded("a", character, minLength),
+				Valid: false,
+			})
+		}
+	}
+
+	if rule.NoConsecutiveHyphens {
+		testCases = append(testCases, resourceNameTestCase{
+			Name:  "Consecutive Hyphens",
+			Input: padded("a--", "a", minLength+1),
+			Valid: false,
+		})
+	}
+
+	for _, word := range rule.ReservedWords {
+		if len(word) < rule.MinLength || len(word) > rule.MaxLength {
+			continue
+		}
+
+		testCases = append(testCases, resourceNameTestCase{
+			Name:  fmt.Sprintf("Reserved Word %q", word),
+			Input: strings.ToUpper(word),
+			Valid: false,
+		})
+	}
+
+	for _, suffix := range rule.ReservedSuffixes {
+		testCases = append(testCases, resourceNameTestCase{
+			Name:  fmt.Sprintf("Reserved Suffix %q", suffix),
+			Input: padded("a", suffix, minLength+len(suffix)),
+			Valid: false,
+		})
+	}
+
+	for _, name := range rule.AllowedNames {
+		testCases = append(testCases, resourceNameTestCase{
+			Name:  fmt.Sprintf("Allowed Name %q", name),
+			Input: name,
+			Valid: true,
+		})
+	}
+
+	return testCases
+}
+
+func TestResourceName(t *testing.T) {
+	for resourceType, rule := range NamingRules() {
+		validator := newNamingRuleValidator(rule)
+
+		for _, v := range testCasesForNamingRule(rule) {
+			t.Logf("[DEBUG] Test Case: %q / %q (%q)", resourceType, v.Name, v.Input)
+
+			errors := validator.validateName(v.Input, "name")
+			valid := len(errors) == 0
+			if v.Valid != valid {
+				t.Fatalf("Expected %t but got %t for %q: %+v", v.Valid, valid, v.Input, errors)
+			}
+		}
+	}
+}
+
+func TestResourceNameLegacy(t *testing.T) {
+	for resourceType, rule := range NamingRules() {
+		if rule.Legacy == nil {
+			continue
+		}
+
+		validator := newNamingRuleValidator(rule)
+		validateFunc := ResourceName(resourceType)
+		for _, v := range testCasesForNamingRule(*rule.Legacy) {
+			t.Logf("[DEBUG] Test Case: %q / %q (%q)", resourceType, v.Name, v.Input)
+
+			// names which were valid using the Legacy rule are deprecated, rather than invalid
+			deprecated := v.Valid && len(validator.validateName(v.Input, "name")) > 0
+
+			warnings, errors := validateFunc(v.Input, "name")
+			if valid := len(errors) == 0; v.Valid != valid {
+				t.Fatalf("Expected %t but got %t for %q: %+v", v.Valid, valid, v.Input, errors)
+			}
+			if hasWarnings := len(warnings) > 0; deprecated != hasWarnings {
+				t.Fatalf("Expected a deprecation warning to be %t but got %t for %q: %+v", deprecated, hasWarnings, v.Input, warnings)
+			}
+		}
+	}
+}
+
+func TestResourceNameExisting(t *testing.T) {
+	testData := []struct {
+		ResourceType string
+		Input        string
+		Valid        bool
+		Deprecated   bool
+	}{
+		{
+			ResourceType: "Microsoft.Resources/resourceGroups",
+			Input:        "example-resources(1)",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Resources/resourceGroups",
+			Input:        "example.",
+			Valid:        false,
+		},
+		{
+			ResourceType: "microsoft.storage/storageaccounts",
+			Input:        "examplestorage01",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.KeyVault/vaults",
+			Input:        "example-Vault-01",
+			Valid:        true,
+		},
+		{
+			// Key Vaults must start with a letter, but this was previously allowed
+			ResourceType: "Microsoft.KeyVault/vaults",
+			Input:        "1example",
+			Valid:        true,
+			Deprecated:   true,
+		},
+		{
+			ResourceType: "Microsoft.KeyVault/vaults",
+			Input:        "example--vault",
+			Valid:        true,
+			Deprecated:   true,
+		},
+		{
+			ResourceType: "Microsoft.KeyVault/vaults",
+			Input:        "example_vault",
+			Valid:        false,
+		},
+		{
+			ResourceType: "Microsoft.ServiceBus/namespaces",
+			Input:        "example-namespace-SB",
+			Valid:        false,
+		},
+		{
+			// Cosmos DB Accounts can't start with a hyphen, but this was previously allowed
+			ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+			Input:        "-example-cosmosdb-account",
+			Valid:        true,
+			Deprecated:   true,
+		},
+		{
+			ResourceType: "Microsoft.DocumentDB/databaseAccounts",
+			Input:        "example-cosmosdb-account-which-is-much-too-long-for-azure",
+			Valid:        false,
+		},
+		{
+			ResourceType: "Microsoft.Storage/storageAccounts/blobServices/containers",
+			Input:        "$web",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Storage/storageAccounts/blobServices/containers",
+			Input:        "$logs",
+			Valid:        false,
+		},
+		{
+			ResourceType: "Microsoft.Web/sites",
+			Input:        "office365-example",
+			Valid:        true,
+		},
+		{
+			ResourceType: "Microsoft.Web/sites",
+			Input:        "office365",
+			Valid:        true,
+			Deprecated:   true,
+		},
+		{
+			ResourceType: "Microsoft.Cache/redis",
+			Input:        "example--cache",
+			Valid:        false,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Test Case: %q / %q", v.ResourceType, v.Input)
+
+		warnings, errors := ResourceName(v.ResourceType)(v.Input, "name")
+		if valid := len(errors) == 0; v.Valid != valid {
+			t.Fatalf("Expected %t but got %t: %+v", v.Valid, valid, errors)
+		}
+		if deprecated := len(warnings) > 0; v.Deprecated != deprecated {
+			t.Fatalf("Expected a deprecation warning to be %t but got %t: %+v", v.Deprecated, deprecated, warnings)
+		}
+	}
+}
+
+func TestNamingRulesAreComplete(t *testing.T) {
+	for resourceType, rule := range NamingRules() {
+		t.Logf("[DEBUG] Test Case: %q", resourceType)
+
+		if rule.MinLength > rule.MaxLength || rule.MaxLength == 0 {
+			t.Fatalf("expected the minimum length (%d) to be less than the maximum length (%d)", rule.MinLength, rule.MaxLength)
+		}
+		if rule.Case == "" || rule.Scope == "" {
+			t.Fatalf("expected the Case and Scope to be specified")
+		}
+		if rule.ValidCharacters == "" || rule.ValidCharactersDescription == "" {
+			t.Fatalf("expected the Valid Characters and a Description to be specified")
+		}
+		if (rule.StartCharacters == "") != (rule.StartCharactersDescription == "") {
+			t.Fatalf("expected both the Start Characters and a Description to be specified")
+		}
+		if (rule.EndCharacters == "") != (rule.EndCharactersDescription == "") {
+			t.Fatalf("expected both the End Characters and a Description to be specified")
+		}
+		if rule.Legacy != nil && rule.Legacy.Legacy != nil {
+			t.Fatalf("expected the Legacy rule not to have a Legacy rule")
+		}
+	}
+}
+
+func TestResourceNameUnknownResourceType(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatalf("expected a panic for an unknown Resource Type")
+		}
+	}()
+
+	ResourceName("Microsoft.Unknown/things")
+}
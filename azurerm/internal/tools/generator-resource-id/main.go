@@ -10,6 +10,8 @@ import (
 	"sort"
 	"strings"
 	"unicode"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
 )
 
 var packagesUsingAlias = map[string]struct{}{
@@ -22,6 +24,8 @@ func main() {
 	servicePackagePath := flag.String("path", "", "The relative path to the service package")
 	name := flag.String("name", "", "The name of this Resource Type")
 	id := flag.String("id", "", "An example of this Resource ID")
+	segments := flag.String("segments", "", "A comma-separated list of the Segments within this Resource ID, used instead of `id`")
+	scoped := flag.String("scoped", "", "The `{provider}/{resourceType}` of this Extension Resource which can exist within any Scope, used instead of `id`")
 	rewrite := flag.Bool("rewrite", false, "Should this Resource ID be parsed insensitively, to workaround an API bug?")
 	showHelp := flag.Bool("help", false, "Display this message")
 
@@ -32,12 +36,19 @@ func main() {
 		return
 	}
 
-	if err := run(*servicePackagePath, *name, *id, *rewrite); err != nil {
+	if err := run(*servicePackagePath, *name, *id, *segments, *scoped, *rewrite); err != nil {
 		panic(err)
 	}
 }
 
-func run(servicePackagePath, name, id string, shouldRewrite bool) error {
+type Generator interface {
+	Code() string
+	TestCode() string
+	ValidatorCode() string
+	ValidatorTestCode() string
+}
+
+func run(servicePackagePath, name, id, segments, scoped string, shouldRewrite bool) error {
 	servicePackage, err := parseServicePackageName(servicePackagePath)
 	if err != nil {
 		return fmt.Errorf("determining Service Package Name for %q: %+v", servicePackagePath, err)
@@ -59,16 +70,11 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 		// e.g. "webtest" in applicationInsights
 		fileName += "_id"
 	}
-	resourceId, err := NewResourceID(name, *servicePackage, id)
+	generator, err := newGenerator(name, *servicePackage, id, segments, scoped, shouldRewrite)
 	if err != nil {
 		return err
 	}
 
-	generator := ResourceIdGenerator{
-		ResourceId:    *resourceId,
-		ShouldRewrite: shouldRewrite,
-	}
-
 	parserFilePath := fmt.Sprintf("%s/%s.go", parsersPath, fileName)
 	if err := goFmtAndWriteToFile(parserFilePath, generator.Code()); err != nil {
 		return fmt.Errorf("generating Parser at %q: %+v", parserFilePath, err)
@@ -92,6 +98,46 @@ func run(servicePackagePath, name, id string, shouldRewrite bool) error {
 	return nil
 }
 
+func newGenerator(name, servicePackage, id, segments, scoped string, shouldRewrite bool) (Generator, error) {
+	specified := 0
+	for _, v := range []string{id, segments, scoped} {
+		if v != "" {
+			specified++
+		}
+	}
+	if specified > 1 {
+		return nil, fmt.Errorf("only one of `id`, `segments` and `scoped` can be specified")
+	}
+
+	if segments != "" || scoped != "" {
+		var resourceId *SegmentResourceId
+		var err error
+		if scoped != "" {
+			resourceId, err = NewScopedResourceID(name, servicePackage, scoped)
+		} else {
+			resourceId, err = NewSegmentResourceID(name, servicePackage, segments)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return SegmentResourceIdGenerator{
+			SegmentResourceId: *resourceId,
+			ShouldRewrite:     shouldRewrite,
+		}, nil
+	}
+
+	resourceId, err := NewResourceID(name, servicePackage, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ResourceIdGenerator{
+		ResourceId:    *resourceId,
+		ShouldRewrite: shouldRewrite,
+	}, nil
+}
+
 func parseServicePackageName(relativePath string) (*string, error) {
 	path := relativePath
 	if !filepath.IsAbs(path) {
@@ -159,6 +205,19 @@ func convertToSnakeCase(input string) string {
 	return strings.Join(out, "_")
 }
 
+func makeHumanReadable(input string) string {
+	chars := make([]rune, 0)
+	for _, c := range input {
+		if unicode.IsUpper(c) {
+			chars = append(chars, ' ')
+		}
+
+		chars = append(chars, c)
+	}
+	out := string(chars)
+	return strings.TrimSpace(out)
+}
+
 type ResourceIdSegment struct {
 	// ArgumentName is the name which should be used when this segment is used in an Argument
 	ArgumentName string
@@ -377,19 +436,6 @@ func New%[1]sID(%[2]s string) %[1]sId {
 }
 
 func (id ResourceIdGenerator) codeForDescription() string {
-	makeHumanReadable := func(input string) string {
-		chars := make([]rune, 0)
-		for _, c := range input {
-			if unicode.IsUpper(c) {
-				chars = append(chars, ' ')
-			}
-
-			chars = append(chars, c)
-		}
-		out := string(chars)
-		return strings.TrimSpace(out)
-	}
-
 	formatKeys := make([]string, 0)
 	for _, segment := range id.Segments {
 		if segment.FieldName == "SubscriptionId" {
@@ -454,14 +500,14 @@ func (id ResourceIdGenerator) codeForParser() string {
 			continue
 		}
 
-		fmtString := "\tif resourceId.%[1]s, err = id.PopSegment(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
+		fmtString := "\tif resourceId.%[1]s, err = id.PopSegmentInsensitively(\"%[2]s\"); err != nil {\n\t\treturn nil, err\n\t}"
 		parserStatements = append(parserStatements, fmt.Sprintf(fmtString, segment.FieldName, segment.SegmentKey))
 	}
 	parserStatementsStr := strings.Join(parserStatements, "\n")
 	return fmt.Sprintf(`
 // %[1]sID parses a %[1]s ID into an %[1]sId struct 
 func %[1]sID(input string) (*%[1]sId, error) {
-	id, err := azure.ParseAzureResourceID(input)
+	id, err := azure.ParseAzureResourceIDInsensitively(input)
 	if err != nil {
 		return nil, err
 	}
@@ -662,13 +708,19 @@ func (id ResourceIdGenerator) testCodeForParser() string {
 		},
 `, id.IDRaw, typeName, strings.Join(expectAssignments, "\n")))
 
-	// add an intentionally failing upper-cased test case
+	// add an upper-cased test case, since the segment names are matched case-insensitively
+	upperCasedAssignments := make([]string, 0)
+	for _, segment := range id.Segments {
+		upperCasedAssignments = append(upperCasedAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", segment.FieldName, strings.ToUpper(segment.SegmentValue)))
+	}
 	testCases = append(testCases, fmt.Sprintf(`
 		{
 			// upper-cased
-			Input: %q,
-			Error: true,
-		},`, strings.ToUpper(id.IDRaw)))
+			Input: %[1]q,
+			Expected: &%[2]s{
+%[3]s
+			},
+		},`, strings.ToUpper(id.IDRaw), typeName, strings.Join(upperCasedAssignments, "\n")))
 
 	testCasesStr := strings.Join(testCases, "\n")
 	assignmentCheckStr := strings.Join(assignmentChecks, "\n")
@@ -956,12 +1008,12 @@ func (id ResourceIdGenerator) ValidatorTestCode() string {
 		},
 `, id.IDRaw))
 
-	// add an intentionally failing upper-cased test case
+	// add an upper-cased test case, since the segment names are matched case-insensitively
 	testCases = append(testCases, fmt.Sprintf(`
 		{
 			// upper-cased
 			Input: %q,
-			Valid: false,
+			Valid: true,
 		},`, strings.ToUpper(id.IDRaw)))
 
 	testCasesStr := strings.Join(testCases, "\n")
@@ -1023,6 +1075,718 @@ func Test%[2]sID(t *testing.T) {
 `, id.TestPackageSuffix, id.TypeName, testCasesStr, id.ServicePackageName)
 }
 
+// SegmentResourceId is a Resource ID defined by the Segments it's comprised of, rather than by an example
+// Resource ID - which allows for Static segments, Scopes and Constants which can't be inferred from an example
+type SegmentResourceId struct {
+	TypeName string
+
+	ServicePackageName string
+	TestPackageSuffix  string
+
+	Segments []resourceid.Segment
+
+	// ScopedProvider and ScopedResourceType are the Resource Provider and Resource Type of an Extension Resource
+	// (see `resourceid.ScopedId`) - when this Resource ID is for an Extension Resource
+	ScopedProvider     string
+	ScopedResourceType string
+}
+
+// NewScopedResourceID returns the Resource ID for an Extension Resource which can exist within any Scope, where
+// the input is in the format `{provider}/{resourceType}` e.g. `Microsoft.Authorization/locks`
+func NewScopedResourceID(typeName, servicePackageName, input string) (*SegmentResourceId, error) {
+	split := strings.Split(input, "/")
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return nil, fmt.Errorf("expected the scoped resource %q to be in the format `{provider}/{resourceType}`", input)
+	}
+
+	packageSuffix := ""
+	if _, ok := packagesUsingAlias[servicePackageName]; ok {
+		packageSuffix = "_test"
+	}
+
+	return &SegmentResourceId{
+		TypeName:           typeName,
+		ServicePackageName: servicePackageName,
+		TestPackageSuffix:  packageSuffix,
+		Segments:           resourceid.ScopedSegments(split[0], split[1]),
+		ScopedProvider:     split[0],
+		ScopedResourceType: split[1],
+	}, nil
+}
+
+// NewSegmentResourceID parses the comma-separated list of Segments, where each Segment is in the format `{kind}:{value}`:
+//
+// * `static:resourceGroups` - a Static segment
+// * `provider:Microsoft.Network` - a Resource Provider segment
+// * `user:resourceGroup=group1` - a User Specified segment, with an example value
+// * `scope:scope=/subscriptions/12345678-1234-9876-4563-123456789012` - a Scope segment, with an example value
+// * `constant:sku=Basic|Standard` - a Constant segment with the possible values, where the first is used as the example
+func NewSegmentResourceID(typeName, servicePackageName, input string) (*SegmentResourceId, error) {
+	segments := make([]resourceid.Segment, 0)
+	names := make(map[string]struct{})
+	fieldNames := make(map[string]struct{})
+	hasScope := false
+	hasUserSpecifiedValue := false
+
+	for _, v := range strings.Split(input, ",") {
+		split := strings.SplitN(v, ":", 2)
+		if len(split) != 2 || split[1] == "" {
+			return nil, fmt.Errorf("expected the segment %q to be in the format `{kind}:{value}`", v)
+		}
+		kind := split[0]
+		value := split[1]
+
+		var segment resourceid.Segment
+		switch kind {
+		case "static":
+			segment = resourceid.StaticSegment(staticSegmentName(value, names), value)
+
+		case "provider":
+			segment = resourceid.ResourceProviderSegment(staticSegmentName(value, names), value)
+
+		case "constant", "scope", "user":
+			nameAndValue := strings.SplitN(value, "=", 2)
+			if len(nameAndValue) != 2 || nameAndValue[0] == "" || nameAndValue[1] == "" {
+				return nil, fmt.Errorf("expected the segment %q to be in the format `%s:{name}={example}`", v, kind)
+			}
+			name := nameAndValue[0]
+			example := nameAndValue[1]
+
+			if _, exists := names[name]; exists {
+				return nil, fmt.Errorf("the segment name %q is used more than once", name)
+			}
+			fieldName := strings.Title(name)
+			if _, exists := fieldNames[fieldName]; exists {
+				return nil, fmt.Errorf("the field name %q is used more than once", fieldName)
+			}
+			fieldNames[fieldName] = struct{}{}
+			hasUserSpecifiedValue = true
+
+			switch kind {
+			case "constant":
+				possibleValues := strings.Split(example, "|")
+				for _, possibleValue := range possibleValues {
+					if possibleValue == "" {
+						return nil, fmt.Errorf("the Constant segment %q contains an empty possible value", name)
+					}
+				}
+				segment = resourceid.ConstantSegment(name, possibleValues, possibleValues[0])
+
+			case "scope":
+				if hasScope {
+					return nil, fmt.Errorf("only a single Scope segment can be specified")
+				}
+				if !strings.HasPrefix(example, "/") {
+					return nil, fmt.Errorf("expected the example for the Scope segment %q to start with a `/`", name)
+				}
+				hasScope = true
+				segment = resourceid.ScopeSegment(name, example)
+
+			case "user":
+				if strings.Contains(example, "/") {
+					return nil, fmt.Errorf("the example for the User Specified segment %q cannot contain a `/`", name)
+				}
+				segment = resourceid.UserSpecifiedSegment(name, example)
+			}
+
+		default:
+			return nil, fmt.Errorf("unsupported kind %q for the segment %q - expected one of `constant`, `provider`, `scope`, `static` or `user`", kind, v)
+		}
+
+		names[segment.Name] = struct{}{}
+		segments = append(segments, segment)
+	}
+
+	if !hasUserSpecifiedValue {
+		return nil, fmt.Errorf("at least one `constant`, `scope` or `user` segment must be specified")
+	}
+
+	packageSuffix := ""
+	if _, ok := packagesUsingAlias[servicePackageName]; ok {
+		packageSuffix = "_test"
+	}
+
+	return &SegmentResourceId{
+		TypeName:           typeName,
+		ServicePackageName: servicePackageName,
+		TestPackageSuffix:  packageSuffix,
+		Segments:           segments,
+	}, nil
+}
+
+// staticSegmentName returns a unique name for a Static or Resource Provider segment, e.g. `staticMicrosoftNetwork`
+func staticSegmentName(value string, existing map[string]struct{}) string {
+	chars := make([]rune, 0)
+	upperNext := true
+	for _, c := range value {
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) {
+			upperNext = true
+			continue
+		}
+
+		if upperNext {
+			c = unicode.ToUpper(c)
+			upperNext = false
+		}
+		chars = append(chars, c)
+	}
+
+	name := fmt.Sprintf("static%s", string(chars))
+	if _, exists := existing[name]; !exists {
+		return name
+	}
+
+	for i := 2; ; i++ {
+		candidate := fmt.Sprintf("%s%d", name, i)
+		if _, exists := existing[candidate]; !exists {
+			return candidate
+		}
+	}
+}
+
+// userSpecifiedSegments returns the Segments whose value is specified by the user, which become fields on the ID
+func (id SegmentResourceId) userSpecifiedSegments() []resourceid.Segment {
+	output := make([]resourceid.Segment, 0)
+	for _, segment := range id.Segments {
+		if segment.FixedValue != nil {
+			continue
+		}
+
+		output = append(output, segment)
+	}
+	return output
+}
+
+func (id SegmentResourceId) exampleValues() map[string]string {
+	output := make(map[string]string)
+	for _, segment := range id.Segments {
+		output[segment.Name] = segment.ExampleValue
+	}
+	return output
+}
+
+func (id SegmentResourceId) exampleId() string {
+	return resourceid.Format(id.Segments, id.exampleValues())
+}
+
+// upperCasedExampleId returns the example ID with the Static, Resource Provider and Constant segments upper-cased,
+// and whether this differs from the example ID
+func (id SegmentResourceId) upperCasedExampleId() (string, bool) {
+	changed := false
+	values := id.exampleValues()
+	segments := make([]resourceid.Segment, 0)
+	for _, segment := range id.Segments {
+		if segment.Type == resourceid.ScopeSegmentType || segment.Type == resourceid.UserSpecifiedSegmentType {
+			segments = append(segments, segment)
+			continue
+		}
+
+		upperCased := strings.ToUpper(segment.ExampleValue)
+		if upperCased != segment.ExampleValue {
+			changed = true
+		}
+		values[segment.Name] = upperCased
+		segments = append(segments, resourceid.StaticSegment(segment.Name, upperCased))
+	}
+
+	return resourceid.Format(segments, values), changed
+}
+
+type SegmentResourceIdGenerator struct {
+	SegmentResourceId
+
+	ShouldRewrite bool
+}
+
+func (id SegmentResourceIdGenerator) Code() string {
+	return fmt.Sprintf(`
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+)
+
+%s
+%s
+%s
+%s
+%s
+%s
+%s
+%s
+`, id.codeForType(), id.codeForConstructor(), id.codeForDescription(), id.codeForFormatter(), id.codeForSegments(), id.codeForScopedId(), id.codeForParser(false), id.codeForParser(true))
+}
+
+func (id SegmentResourceIdGenerator) codeForType() string {
+	fields := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		fields = append(fields, fmt.Sprintf("\t%s\tstring", strings.Title(segment.Name)))
+	}
+	return fmt.Sprintf(`
+type %[1]sId struct {
+%[2]s
+}
+`, id.TypeName, strings.Join(fields, "\n"))
+}
+
+func (id SegmentResourceIdGenerator) codeForConstructor() string {
+	arguments := make([]string, 0)
+	assignments := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		arguments = append(arguments, segment.Name)
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\t%s,", strings.Title(segment.Name), segment.Name))
+	}
+
+	return fmt.Sprintf(`
+func New%[1]sID(%[2]s string) %[1]sId {
+	return %[1]sId{
+%[3]s
+	}
+}
+`, id.TypeName, strings.Join(arguments, ", "), strings.Join(assignments, "\n"))
+}
+
+func (id SegmentResourceIdGenerator) codeForDescription() string {
+	formatKeys := make([]string, 0)
+	segments := id.userSpecifiedSegments()
+	for i := len(segments); i != 0; i-- {
+		fieldName := strings.Title(segments[i-1].Name)
+		if fieldName == "SubscriptionId" {
+			continue
+		}
+
+		formatKeys = append(formatKeys, fmt.Sprintf("\t\tfmt.Sprintf(\"%[1]s %%q\", id.%[2]s),", makeHumanReadable(fieldName), fieldName))
+	}
+
+	return fmt.Sprintf(`
+func (id %[1]sId) String() string {
+	segments := []string{
+%[2]s
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%%s: (%%s)", %[3]q, segmentsStr)
+}
+`, id.TypeName, strings.Join(formatKeys, "\n"), makeHumanReadable(id.TypeName))
+}
+
+func (id SegmentResourceIdGenerator) codeForFormatter() string {
+	fmtString := ""
+	formatKeys := make([]string, 0)
+	for _, segment := range id.Segments {
+		if segment.FixedValue != nil {
+			fmtString += "/" + strings.ReplaceAll(*segment.FixedValue, "%", "%%")
+			continue
+		}
+
+		fmtString += "/%s"
+		if segment.Type == resourceid.ScopeSegmentType {
+			// the Scope is a Resource ID which (should) already start with a `/`
+			formatKeys = append(formatKeys, fmt.Sprintf("strings.TrimPrefix(id.%s, \"/\")", strings.Title(segment.Name)))
+			continue
+		}
+
+		formatKeys = append(formatKeys, fmt.Sprintf("id.%s", strings.Title(segment.Name)))
+	}
+
+	return fmt.Sprintf(`
+func (id %[1]sId) ID() string {
+	fmtString := %[2]q
+	return fmt.Sprintf(fmtString, %[3]s)
+}
+`, id.TypeName, fmtString, strings.Join(formatKeys, ", "))
+}
+
+func (id SegmentResourceIdGenerator) codeForSegments() string {
+	if id.ScopedProvider != "" {
+		return fmt.Sprintf(`
+// Segments returns the Segments which make up a %[1]s ID
+func (id %[1]sId) Segments() []resourceid.Segment {
+	return resourceid.ScopedSegments(%[2]q, %[3]q)
+}
+`, id.TypeName, id.ScopedProvider, id.ScopedResourceType)
+	}
+
+	lines := make([]string, 0)
+	for _, segment := range id.Segments {
+		switch segment.Type {
+		case resourceid.ConstantSegmentType:
+			possibleValues := make([]string, 0)
+			for _, v := range segment.PossibleValues {
+				possibleValues = append(possibleValues, fmt.Sprintf("%q", v))
+			}
+			lines = append(lines, fmt.Sprintf("\t\tresourceid.ConstantSegment(%q, []string{%s}, %q),", segment.Name, strings.Join(possibleValues, ", "), segment.ExampleValue))
+
+		case resourceid.ResourceProviderSegmentType:
+			lines = append(lines, fmt.Sprintf("\t\tresourceid.ResourceProviderSegment(%q, %q),", segment.Name, *segment.FixedValue))
+
+		case resourceid.ScopeSegmentType:
+			lines = append(lines, fmt.Sprintf("\t\tresourceid.ScopeSegment(%q, %q),", segment.Name, segment.ExampleValue))
+
+		case resourceid.StaticSegmentType:
+			lines = append(lines, fmt.Sprintf("\t\tresourceid.StaticSegment(%q, %q),", segment.Name, *segment.FixedValue))
+
+		case resourceid.UserSpecifiedSegmentType:
+			lines = append(lines, fmt.Sprintf("\t\tresourceid.UserSpecifiedSegment(%q, %q),", segment.Name, segment.ExampleValue))
+		}
+	}
+
+	return fmt.Sprintf(`
+// Segments returns the Segments which make up a %[1]s ID
+func (id %[1]sId) Segments() []resourceid.Segment {
+	return []resourceid.Segment{
+%[2]s
+	}
+}
+`, id.TypeName, strings.Join(lines, "\n"))
+}
+
+func (id SegmentResourceIdGenerator) codeForScopedId() string {
+	if id.ScopedProvider == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(`
+// ScopedId returns this %[1]s ID as a Scoped ID, which allows the Scope to be parsed
+func (id %[1]sId) ScopedId() resourceid.ScopedId {
+	return resourceid.NewScopedID(id.Scope, %[2]q, %[3]q, id.Name)
+}
+`, id.TypeName, id.ScopedProvider, id.ScopedResourceType)
+}
+
+func (id SegmentResourceIdGenerator) codeForParser(insensitively bool) string {
+	if insensitively && !id.ShouldRewrite {
+		// this only exists to workaround broken API's to patch those ID's, so shouldn't be used in most circumstances
+		return ""
+	}
+
+	assignments := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		assignments = append(assignments, fmt.Sprintf("\t\t%s:\tresult.Parsed[%q],", strings.Title(segment.Name), segment.Name))
+	}
+
+	if !insensitively {
+		return fmt.Sprintf(`
+// %[1]sID parses a %[1]s ID into an %[1]sId struct
+func %[1]sID(input string) (*%[1]sId, error) {
+	result, err := resourceid.NewParser(%[1]sId{}.Segments()).Parse(input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := %[1]sId{
+%[2]s
+	}
+
+	return &resourceId, nil
+}
+`, id.TypeName, strings.Join(assignments, "\n"))
+	}
+
+	return fmt.Sprintf(`
+// %[1]sIDInsensitively parses an %[1]s ID into an %[1]sId struct, insensitively
+// This should only be used to parse an ID for rewriting, the %[1]sID
+// method should be used instead for validation etc.
+//
+// Whilst this may seem strange, this enables Terraform have consistent casing
+// which works around issues in Core, whilst handling broken API responses.
+func %[1]sIDInsensitively(input string) (*%[1]sId, error) {
+	result, err := resourceid.NewParser(%[1]sId{}.Segments()).Parse(input, true)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := %[1]sId{
+%[2]s
+	}
+
+	return &resourceId, nil
+}
+`, id.TypeName, strings.Join(assignments, "\n"))
+}
+
+func (id SegmentResourceIdGenerator) TestCode() string {
+	importLine := ""
+	if id.TestPackageSuffix != "" {
+		importLine = fmt.Sprintf("\"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/%s/parse\"", id.ServicePackageName)
+	}
+
+	return fmt.Sprintf(`
+package parse%s
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/resourceid"
+	%s
+)
+
+%s
+%s
+%s
+%s
+`, id.TestPackageSuffix, importLine, id.testCodeForFormatter(), id.testCodeForParser(false), id.testCodeForParser(true), id.testCodeForRoundTrip())
+}
+
+// packagePrefix returns the prefix used to reference the parse package from the tests
+func (id SegmentResourceIdGenerator) packagePrefix() string {
+	if id.TestPackageSuffix != "" {
+		return "parse."
+	}
+
+	return ""
+}
+
+func (id SegmentResourceIdGenerator) testCodeForFormatter() string {
+	arguments := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		arguments = append(arguments, fmt.Sprintf("%q", segment.ExampleValue))
+	}
+
+	return fmt.Sprintf(`
+var _ resourceid.Formatter = %[4]s%[1]sId{}
+
+func Test%[1]sIDFormatter(t *testing.T) {
+	actual := %[4]sNew%[1]sID(%[2]s).ID()
+	expected := %[3]q
+	if actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+}
+`, id.TypeName, strings.Join(arguments, ", "), id.exampleId(), id.packagePrefix())
+}
+
+// invalidTestInputs returns Resource IDs which are missing one or more segments
+func (id SegmentResourceIdGenerator) invalidTestInputs() []string {
+	output := make([]string, 0)
+	values := id.exampleValues()
+	for i := range id.Segments {
+		output = append(output, resourceid.Format(id.Segments[0:i], values)+"/")
+	}
+	return output
+}
+
+func (id SegmentResourceIdGenerator) testCodeForParser(insensitively bool) string {
+	if insensitively && !id.ShouldRewrite {
+		// this functionality isn't enabled by default
+		return ""
+	}
+
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+`)
+	for i, input := range id.invalidTestInputs() {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// missing %s
+			Input: %q,
+			Error: true,
+		},`, id.Segments[i].Name, input))
+	}
+
+	expectAssignments := make([]string, 0)
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		fieldName := strings.Title(segment.Name)
+		expectAssignments = append(expectAssignments, fmt.Sprintf("\t\t\t\t%s:\t%q,", fieldName, segment.ExampleValue))
+
+		assignmentsFmt := "\t\tif actual.%[1]s != v.Expected.%[1]s {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", v.Expected.%[1]s, actual.%[1]s)\n\t\t}"
+		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, fieldName))
+	}
+	expected := fmt.Sprintf(`&%[1]s%[2]sId{
+%[3]s
+			}`, id.packagePrefix(), id.TypeName, strings.Join(expectAssignments, "\n"))
+
+	// add a successful test case
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// valid
+			Input: %q,
+			Expected: %s,
+		},
+`, id.exampleId(), expected))
+
+	// Static, Resource Provider and Constant segments are matched case-insensitively (returning the expected casing)
+	if upperCasedInput, ok := id.upperCasedExampleId(); ok {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased segment names
+			Input: %q,
+			Expected: %s,
+		},`, upperCasedInput, expected))
+	}
+
+	testName := fmt.Sprintf("%sID", id.TypeName)
+	if insensitively {
+		testName = fmt.Sprintf("%sIDInsensitively", id.TypeName)
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]s(t *testing.T) {
+	testData := []struct {
+		Input  string
+		Error  bool
+		Expected *%[4]s%[5]sId
+	}{
+%[2]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[4]s%[1]s(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[3]s
+	}
+}
+`, testName, strings.Join(testCases, "\n"), strings.Join(assignmentChecks, "\n"), id.packagePrefix(), id.TypeName)
+}
+
+func (id SegmentResourceIdGenerator) testCodeForRoundTrip() string {
+	arguments := make([]string, 0)
+	assignmentChecks := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		arguments = append(arguments, fmt.Sprintf("values[%q]", segment.Name))
+
+		assignmentsFmt := "\t\tif actual.%[1]s != values[%[2]q] {\n\t\t\tt.Fatalf(\"Expected %%q but got %%q for %[1]s\", values[%[2]q], actual.%[1]s)\n\t\t}"
+		assignmentChecks = append(assignmentChecks, fmt.Sprintf(assignmentsFmt, strings.Title(segment.Name), segment.Name))
+	}
+
+	return fmt.Sprintf(`
+func Test%[1]sIDRoundTrip(t *testing.T) {
+	segments := %[4]s%[1]sId{}.Segments()
+	r := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100; i++ {
+		values := resourceid.RandomValues(segments, r)
+		input := %[4]sNew%[1]sID(%[2]s).ID()
+
+		actual, err := %[4]s%[1]sID(input)
+		if err != nil {
+			t.Fatalf("parsing %%q: %%+v", input, err)
+		}
+
+%[3]s
+
+		for _, invalid := range resourceid.InvalidResourceIds(segments, values) {
+			_, err := %[4]s%[1]sID(invalid.Input)
+			var segmentErr resourceid.SegmentError
+			if !errors.As(err, &segmentErr) {
+				t.Fatalf("Expected a SegmentError for %%q but got: %%+v", invalid.Input, err)
+			}
+			if segmentErr.Segment.Name != invalid.Segment {
+				t.Fatalf("Expected the segment %%q to be invalid for %%q but got %%q", invalid.Segment, invalid.Input, segmentErr.Segment.Name)
+			}
+		}
+	}
+}
+`, id.TypeName, strings.Join(arguments, ", "), strings.Join(assignmentChecks, "\n"), id.packagePrefix())
+}
+
+func (id SegmentResourceIdGenerator) ValidatorCode() string {
+	return ResourceIdGenerator{
+		ResourceId: ResourceId{
+			TypeName:           id.TypeName,
+			ServicePackageName: id.ServicePackageName,
+		},
+	}.ValidatorCode()
+}
+
+func (id SegmentResourceIdGenerator) ValidatorTestCode() string {
+	testCases := make([]string, 0)
+	testCases = append(testCases, `
+		{
+			// empty
+			Input: "",
+			Valid: false,
+		},
+`)
+	for i, input := range id.invalidTestInputs() {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// missing %s
+			Input: %q,
+			Valid: false,
+		},`, id.Segments[i].Name, input))
+	}
+
+	testCases = append(testCases, fmt.Sprintf(`
+		{
+			// valid
+			Input: %q,
+			Valid: true,
+		},
+`, id.exampleId()))
+
+	if upperCasedInput, ok := id.upperCasedExampleId(); ok {
+		testCases = append(testCases, fmt.Sprintf(`
+		{
+			// upper-cased segment names
+			Input: %q,
+			Valid: true,
+		},`, upperCasedInput))
+	}
+
+	validatorPrefix := ""
+	importLine := `import "testing"`
+	if id.TestPackageSuffix != "" {
+		validatorPrefix = "validate."
+		importLine = fmt.Sprintf(`import (
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/%s/validate"
+)`, id.ServicePackageName)
+	}
+
+	return fmt.Sprintf(`package validate%[1]s
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+%[2]s
+
+func Test%[3]sID(t *testing.T) {
+	cases := []struct {
+		Input    string
+		Valid bool
+	}{
+%[4]s
+	}
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing Value %%s", tc.Input)
+		_, errors := %[5]s%[3]sID(tc.Input, "test")
+		valid := len(errors) == 0
+
+		if tc.Valid != valid {
+			t.Fatalf("Expected %%t but got %%t", tc.Valid, valid)
+		}
+	}
+}
+`, id.TestPackageSuffix, importLine, id.TypeName, strings.Join(testCases, "\n"), validatorPrefix)
+}
+
 func goFmtAndWriteToFile(filePath, fileContents string) error {
 	fmt, err := GolangCodeFormatter{}.Format(fileContents)
 	if err != nil {
@@ -54,3 +54,128 @@ func Test(t *testing.T) {
 		}
 	}
 }
+
+func TestNewSegmentResourceID(t *testing.T) {
+	cases := []struct {
+		input    string
+		error    bool
+		names    []string
+		expected string
+	}{
+		{
+			input: "",
+			error: true,
+		},
+		{
+			input: "static:subscriptions",
+			error: true,
+		},
+		{
+			input: "unknown:subscriptions,user:subscriptionId=12345678-1234-9876-4563-123456789012",
+			error: true,
+		},
+		{
+			input: "user:subscriptionId",
+			error: true,
+		},
+		{
+			input: "user:name=a/b",
+			error: true,
+		},
+		{
+			input: "user:name=first,user:name=second",
+			error: true,
+		},
+		{
+			input: "scope:scope=/subscriptions/12345678-1234-9876-4563-123456789012,scope:parent=/subscriptions/12345678-1234-9876-4563-123456789012",
+			error: true,
+		},
+		{
+			input: "scope:scope=subscriptions/12345678-1234-9876-4563-123456789012",
+			error: true,
+		},
+		{
+			input: "constant:sku=Basic||Standard",
+			error: true,
+		},
+		{
+			input:    "static:subscriptions,user:subscriptionId=12345678-1234-9876-4563-123456789012,static:providers,user:resourceProvider=Instruments.Didgeridoo",
+			names:    []string{"staticSubscriptions", "subscriptionId", "staticProviders", "resourceProvider"},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Instruments.Didgeridoo",
+		},
+		{
+			input:    "scope:scope=/subscriptions/12345678-1234-9876-4563-123456789012,static:providers,provider:Microsoft.Authorization,static:locks,user:name=lock1,static:providers,provider:Microsoft.Emulator,static:skus,constant:sku=Basic|Standard",
+			names:    []string{"scope", "staticProviders", "staticMicrosoftAuthorization", "staticLocks", "name", "staticProviders2", "staticMicrosoftEmulator", "staticSkus", "sku"},
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/providers/Microsoft.Authorization/locks/lock1/providers/Microsoft.Emulator/skus/Basic",
+		},
+	}
+
+	for idx, c := range cases {
+		actual, err := NewSegmentResourceID("Example", "example", c.input)
+		if err != nil {
+			if c.error {
+				continue
+			}
+
+			t.Fatalf("%d. expected no error but got: %+v", idx, err)
+		}
+		if c.error {
+			t.Fatalf("%d. expected an error but didn't get one", idx)
+		}
+
+		if len(actual.Segments) != len(c.names) {
+			t.Fatalf("%d. expected %d segments but got %d", idx, len(c.names), len(actual.Segments))
+		}
+		for i, segment := range actual.Segments {
+			if segment.Name != c.names[i] {
+				t.Fatalf("%d. expected the segment at %d to be %q but got %q", idx, i, c.names[i], segment.Name)
+			}
+		}
+		if id := actual.exampleId(); id != c.expected {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.expected, id)
+		}
+	}
+}
+
+func TestNewScopedResourceID(t *testing.T) {
+	cases := []struct {
+		input    string
+		error    bool
+		expected string
+	}{
+		{
+			input: "",
+			error: true,
+		},
+		{
+			input: "Microsoft.Authorization",
+			error: true,
+		},
+		{
+			input: "Microsoft.Authorization/locks/nested",
+			error: true,
+		},
+		{
+			input:    "Microsoft.Authorization/locks",
+			expected: "/subscriptions/12345678-1234-9876-4563-123456789012/resourceGroups/group1/providers/Microsoft.Authorization/locks/name1",
+		},
+	}
+
+	for idx, c := range cases {
+		actual, err := NewScopedResourceID("Example", "example", c.input)
+		if err != nil {
+			if c.error {
+				continue
+			}
+
+			t.Fatalf("%d. expected no error but got: %+v", idx, err)
+		}
+		if c.error {
+			t.Fatalf("%d. expected an error but didn't get one", idx)
+		}
+
+		if id := actual.exampleId(); id != c.expected {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.expected, id)
+		}
+	}
+}
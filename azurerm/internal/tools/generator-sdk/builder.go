@@ -0,0 +1,573 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// builder builds up the Package Definitions from one or more Swagger documents
+type builder struct {
+	loader   *swaggerLoader
+	packages map[string]*packageDefinition
+
+	// tag optionally limits the Operations which are generated to those within this Tag
+	tag string
+}
+
+func newBuilder(tag string) *builder {
+	return &builder{
+		loader:   newSwaggerLoader(),
+		packages: make(map[string]*packageDefinition),
+		tag:      tag,
+	}
+}
+
+var optionTypes = map[string]string{
+	"boolean": "bool",
+	"integer": "int64",
+	"number":  "float64",
+	"string":  "string",
+}
+
+var supportedHttpMethods = map[string]struct{}{
+	"delete": {},
+	"get":    {},
+	"head":   {},
+	"patch":  {},
+	"post":   {},
+	"put":    {},
+}
+
+func (b *builder) addDocument(filePath string) error {
+	doc, err := b.loader.load(filePath)
+	if err != nil {
+		return err
+	}
+
+	uris := make(map[string]struct{})
+	for uri := range doc.Paths {
+		uris[uri] = struct{}{}
+	}
+
+	for _, uri := range sortedKeys(uris) {
+		item := doc.Paths[uri]
+
+		pathParameters := make([]*swaggerParameter, 0)
+		if raw, ok := item["parameters"]; ok {
+			if err := json.Unmarshal(raw, &pathParameters); err != nil {
+				return fmt.Errorf("parsing the parameters for %q: %+v", uri, err)
+			}
+		}
+
+		methods := make(map[string]struct{})
+		for method := range item {
+			if _, ok := supportedHttpMethods[method]; ok {
+				methods[method] = struct{}{}
+			}
+		}
+
+		for _, method := range sortedKeys(methods) {
+			var operation swaggerOperation
+			if err := json.Unmarshal(item[method], &operation); err != nil {
+				return fmt.Errorf("parsing the %s operation for %q: %+v", strings.ToUpper(method), uri, err)
+			}
+
+			if err := b.addOperation(doc, filePath, uri, method, pathParameters, operation); err != nil {
+				if _, ok := err.(unsupportedError); ok {
+					log.Printf("[WARN] Skipping the Operation %q: %+v", operation.OperationId, err)
+					continue
+				}
+
+				return fmt.Errorf("building the Operation %q: %+v", operation.OperationId, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (b *builder) addOperation(doc *swaggerDocument, filePath, uri, method string, pathParameters []*swaggerParameter, input swaggerOperation) (err error) {
+	split := strings.SplitN(input.OperationId, "_", 2)
+	if len(split) != 2 {
+		return unsupported("the OperationId %q isn't in the format `{Tag}_{Operation}`", input.OperationId)
+	}
+
+	tag := split[0]
+	if len(input.Tags) > 0 {
+		tag = input.Tags[0]
+	}
+	tagName := pascalCase(tag)
+	if b.tag != "" && !strings.EqualFold(tagName, pascalCase(b.tag)) {
+		return nil
+	}
+
+	name := pascalCase(split[1])
+	if !strings.EqualFold(pascalCase(split[0]), tagName) {
+		name = pascalCase(split[0]) + name
+	}
+
+	pkg := b.packageForTag(tagName, doc.Info.Version)
+	if _, exists := pkg.Operations[name]; exists {
+		return fmt.Errorf("an Operation named %q already exists within the Package %q", name, pkg.Name)
+	}
+
+	// an unsupported Operation may already have added Constants, Models or Resource IDs - which would then be unused
+	snapshot := pkg.copy()
+	defer func() {
+		if err != nil {
+			*pkg = snapshot
+		}
+	}()
+
+	operation := operationDefinition{
+		Name:        name,
+		HttpMethod:  strings.ToUpper(method),
+		Uri:         uri,
+		LongRunning: input.LongRunning,
+	}
+
+	for _, v := range append(pathParameters, input.Parameters...) {
+		parameterFilePath, parameter, err := b.loader.resolveParameter(filePath, v)
+		if err != nil {
+			return err
+		}
+
+		switch parameter.In {
+		case "body":
+			requestType, _, err := b.typeForSchema(pkg, parameterFilePath, parameter.Schema, fmt.Sprintf("%sRequest", name))
+			if err != nil {
+				return fmt.Errorf("determining the Request Type: %+v", err)
+			}
+			operation.RequestType = strings.TrimPrefix(requestType, "*")
+
+		case "query":
+			if parameter.Name == "api-version" {
+				continue
+			}
+			if parameter.Required {
+				return unsupported("the required Query Parameter %q isn't supported", parameter.Name)
+			}
+
+			optionType, ok := optionTypes[parameter.Type]
+			if !ok {
+				return unsupported("the Query Parameter %q has the type %q which isn't supported", parameter.Name, parameter.Type)
+			}
+			operation.Options = append(operation.Options, optionDefinition{
+				Name:      pascalCase(parameter.Name),
+				QueryName: parameter.Name,
+				Type:      optionType,
+			})
+
+		case "header":
+			if parameter.Required {
+				return unsupported("the required Header %q isn't supported", parameter.Name)
+			}
+
+		case "path":
+			if !strings.Contains(uri, fmt.Sprintf("{%s}", parameter.Name)) {
+				return fmt.Errorf("the Path Parameter %q wasn't found within %q", parameter.Name, uri)
+			}
+
+		default:
+			return unsupported("the Parameter %q is in %q which isn't supported", parameter.Name, parameter.In)
+		}
+	}
+
+	resourceId, remainder, err := parseResourceIdFromUri(uri)
+	if err != nil {
+		return err
+	}
+	if resourceId != nil {
+		idName, err := b.addResourceId(pkg, *resourceId)
+		if err != nil {
+			return err
+		}
+		operation.ResourceIdName = idName
+		operation.Uri = remainder
+	}
+
+	statusCodes := make([]int, 0)
+	var responseSchema *swaggerSchema
+	for code, response := range input.Responses {
+		if code == "default" {
+			continue
+		}
+
+		statusCode, err := strconv.Atoi(code)
+		if err != nil {
+			return fmt.Errorf("parsing the Status Code %q: %+v", code, err)
+		}
+		if _, ok := statusCodeNames[statusCode]; !ok {
+			return unsupported("the Status Code %d isn't supported", statusCode)
+		}
+		statusCodes = append(statusCodes, statusCode)
+
+		if response != nil && response.Schema != nil && (responseSchema == nil || statusCode == http.StatusOK) {
+			responseSchema = response.Schema
+		}
+	}
+	sort.Ints(statusCodes)
+	operation.StatusCodes = statusCodes
+
+	sort.Slice(operation.Options, func(i, j int) bool {
+		return operation.Options[i].Name < operation.Options[j].Name
+	})
+
+	if responseSchema != nil {
+		responseType, _, err := b.typeForSchema(pkg, filePath, responseSchema, fmt.Sprintf("%sResult", name))
+		if err != nil {
+			return fmt.Errorf("determining the Response Type: %+v", err)
+		}
+		operation.ResponseType = strings.TrimPrefix(responseType, "*")
+	}
+
+	if input.Pageable != nil {
+		if err := b.populatePageable(pkg, &operation, *input.Pageable); err != nil {
+			return err
+		}
+	}
+
+	if operation.LongRunning && operation.Pageable {
+		return unsupported("Long Running Operations which are Pageable aren't supported")
+	}
+
+	pkg.Operations[name] = operation
+	return nil
+}
+
+func (b *builder) populatePageable(pkg *packageDefinition, operation *operationDefinition, input swaggerPageable) error {
+	operation.Pageable = true
+	operation.ItemName = "value"
+	if input.ItemName != nil {
+		operation.ItemName = *input.ItemName
+	}
+	operation.NextLinkName = "nextLink"
+	if len(input.NextLinkName) > 0 {
+		var nextLinkName *string
+		if err := json.Unmarshal(input.NextLinkName, &nextLinkName); err != nil {
+			return fmt.Errorf("parsing the nextLinkName: %+v", err)
+		}
+
+		// a nextLinkName of `null` means that the results aren't paged, but are returned as a single list
+		operation.NextLinkName = ""
+		if nextLinkName != nil {
+			operation.NextLinkName = *nextLinkName
+		}
+	}
+
+	model, ok := pkg.Models[operation.ResponseType]
+	if !ok {
+		return unsupported("the Response Type for a Pageable Operation must be a Model but got %q", operation.ResponseType)
+	}
+
+	for _, field := range model.Fields {
+		if field.JsonName != operation.ItemName {
+			continue
+		}
+
+		itemType := strings.TrimPrefix(strings.TrimPrefix(field.Type, "*"), "[]")
+		if itemType == strings.TrimPrefix(field.Type, "*") {
+			return unsupported("the field %q within the Model %q isn't a list", field.JsonName, model.Name)
+		}
+
+		if _, ok := pkg.Models[itemType]; !ok {
+			return unsupported("the items within the Model %q must be a Model but got %q", model.Name, itemType)
+		}
+
+		// the list model is only used to unmarshal each page, so it's not needed
+		// unless it's also referenced by another Operation or Model
+		operation.ResponseType = itemType
+		b.removeModelIfUnused(pkg, model.Name)
+		return nil
+	}
+
+	return unsupported("the field %q was not found within the Model %q", operation.ItemName, model.Name)
+}
+
+func (b *builder) removeModelIfUnused(pkg *packageDefinition, name string) {
+	for _, operation := range pkg.Operations {
+		if operation.RequestType == name || operation.ResponseType == name {
+			return
+		}
+	}
+
+	for _, model := range pkg.Models {
+		for _, field := range model.Fields {
+			if typeReferences(field.Type, name) {
+				return
+			}
+		}
+	}
+
+	delete(pkg.Models, name)
+}
+
+// typeReferences returns whether the specified Go type references the named type, e.g. `*[]Sku` references `Sku`
+func typeReferences(goType, name string) bool {
+	v := strings.TrimPrefix(goType, "*")
+	for _, prefix := range []string{"[]", "map[string]"} {
+		v = strings.TrimPrefix(v, prefix)
+	}
+	return v == name
+}
+
+func (b *builder) packageForTag(tagName, apiVersion string) *packageDefinition {
+	packageName := strings.ToLower(tagName)
+	if pkg, ok := b.packages[packageName]; ok {
+		return pkg
+	}
+
+	pkg := &packageDefinition{
+		Name:        packageName,
+		ClientName:  fmt.Sprintf("%sClient", tagName),
+		ApiVersion:  apiVersion,
+		Constants:   make(map[string]constantDefinition),
+		Models:      make(map[string]modelDefinition),
+		Operations:  make(map[string]operationDefinition),
+		ResourceIds: make(map[string]resourceIdDefinition),
+	}
+	b.packages[packageName] = pkg
+	return pkg
+}
+
+// addResourceId adds the specified Resource ID to the Package, returning the name of this Resource ID - which is
+// prefixed by the parent Resource Type when a different Resource ID with the same name exists within the Package
+func (b *builder) addResourceId(pkg *packageDefinition, id resourceIdDefinition) (string, error) {
+	name := id.Name
+	i := len(id.Segments) - 2
+	for {
+		existing, exists := pkg.ResourceIds[name]
+		if !exists {
+			id.Name = name
+			pkg.ResourceIds[name] = id
+			return name, nil
+		}
+
+		if existing.formatString() == id.formatString() {
+			return name, nil
+		}
+
+		if i < 0 {
+			return "", fmt.Errorf("unable to determine a unique name for the Resource ID %q", id.formatString())
+		}
+
+		if id.Segments[i].Key != "providers" {
+			name = pascalCase(singular(id.Segments[i].Key)) + name
+		}
+		i--
+	}
+}
+
+// typeForSchema returns the Go type for the specified Schema, adding any Constants and Models which it references to
+// the Package - and whether this is a RFC3339 date. The suggested name is used for any inlined Models or Constants
+func (b *builder) typeForSchema(pkg *packageDefinition, filePath string, schema *swaggerSchema, suggestedName string) (string, bool, error) {
+	if schema == nil {
+		return "", false, fmt.Errorf("the Schema for %q was nil", suggestedName)
+	}
+
+	if schema.Ref != "" {
+		reference, definition, err := b.loader.resolveDefinition(filePath, schema.Ref)
+		if err != nil {
+			return "", false, err
+		}
+
+		if isModel(definition) {
+			name := pascalCase(reference.name)
+			if err := b.addModel(pkg, reference.filePath, name, definition); err != nil {
+				return "", false, fmt.Errorf("building the Model %q: %+v", name, err)
+			}
+			return fmt.Sprintf("*%s", name), false, nil
+		}
+
+		return b.typeForSchema(pkg, reference.filePath, definition, pascalCase(reference.name))
+	}
+
+	if isModel(schema) {
+		if err := b.addModel(pkg, filePath, suggestedName, schema); err != nil {
+			return "", false, fmt.Errorf("building the Model %q: %+v", suggestedName, err)
+		}
+		return fmt.Sprintf("*%s", suggestedName), false, nil
+	}
+
+	if len(schema.Enum) > 0 {
+		name := suggestedName
+		if schema.Enumeration != nil && schema.Enumeration.Name != "" {
+			name = pascalCase(schema.Enumeration.Name)
+		}
+		if err := b.addConstant(pkg, name, schema); err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("*%s", name), false, nil
+	}
+
+	switch schema.Type {
+	case "boolean":
+		return "*bool", false, nil
+
+	case "integer":
+		return "*int64", false, nil
+
+	case "number":
+		return "*float64", false, nil
+
+	case "string":
+		return "*string", schema.Format == "date-time", nil
+
+	case "array":
+		if schema.Items == nil {
+			return "", false, fmt.Errorf("the array %q has no items", suggestedName)
+		}
+		itemType, _, err := b.typeForSchema(pkg, filePath, schema.Items, singular(suggestedName))
+		if err != nil {
+			return "", false, err
+		}
+		return fmt.Sprintf("*[]%s", strings.TrimPrefix(itemType, "*")), false, nil
+
+	case "object", "":
+		valueType := "interface{}"
+
+		var additionalProperties swaggerSchema
+		if len(schema.AdditionalProperties) > 0 && json.Unmarshal(schema.AdditionalProperties, &additionalProperties) == nil {
+			v, _, err := b.typeForSchema(pkg, filePath, &additionalProperties, fmt.Sprintf("%sValue", suggestedName))
+			if err != nil {
+				return "", false, err
+			}
+			valueType = strings.TrimPrefix(v, "*")
+		}
+
+		if schema.Type == "" && len(schema.AdditionalProperties) == 0 {
+			return "*interface{}", false, nil
+		}
+		return fmt.Sprintf("*map[string]%s", valueType), false, nil
+	}
+
+	return "", false, unsupported("the type %q used for %q isn't supported", schema.Type, suggestedName)
+}
+
+func isModel(schema *swaggerSchema) bool {
+	return len(schema.Properties) > 0 || len(schema.AllOf) > 0
+}
+
+func (b *builder) addConstant(pkg *packageDefinition, name string, schema *swaggerSchema) error {
+	values := make([]string, 0)
+	for _, v := range schema.Enum {
+		value, ok := v.(string)
+		if !ok {
+			return unsupported("the Constant %q contains the non-string value %v", name, v)
+		}
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	if existing, ok := pkg.Constants[name]; ok {
+		if strings.Join(existing.Values, ",") != strings.Join(values, ",") {
+			return fmt.Errorf("the Constant %q is defined multiple times with different values", name)
+		}
+		return nil
+	}
+
+	pkg.Constants[name] = constantDefinition{
+		Name:   name,
+		Values: values,
+	}
+	return nil
+}
+
+type swaggerProperty struct {
+	filePath string
+	name     string
+	schema   *swaggerSchema
+}
+
+func (b *builder) addModel(pkg *packageDefinition, filePath, name string, schema *swaggerSchema) error {
+	if _, ok := pkg.Models[name]; ok {
+		return nil
+	}
+	// add a placeholder first, since Models can reference themselves
+	pkg.Models[name] = modelDefinition{
+		Name: name,
+	}
+
+	properties := make(map[string]swaggerProperty)
+	required := make(map[string]struct{})
+	if err := b.collectProperties(filePath, schema, properties, required); err != nil {
+		return err
+	}
+
+	fields := make([]fieldDefinition, 0)
+	for _, propertyName := range sortedPropertyNames(properties) {
+		property := properties[propertyName]
+		fieldName := pascalCase(propertyName)
+
+		fieldType, dateTime, err := b.typeForSchema(pkg, property.filePath, property.schema, name+fieldName)
+		if err != nil {
+			return fmt.Errorf("determining the type for %q: %+v", propertyName, err)
+		}
+
+		_, isRequired := required[propertyName]
+		if isRequired {
+			fieldType = strings.TrimPrefix(fieldType, "*")
+		}
+
+		fields = append(fields, fieldDefinition{
+			Name:     fieldName,
+			JsonName: propertyName,
+			Type:     fieldType,
+			Required: isRequired,
+			DateTime: dateTime && !isRequired,
+		})
+	}
+
+	pkg.Models[name] = modelDefinition{
+		Name:   name,
+		Fields: fields,
+	}
+	return nil
+}
+
+// collectProperties collects the Properties (and the Required Properties) from the specified Schema, including those
+// from any Schemas it inherits from via `allOf`
+func (b *builder) collectProperties(filePath string, schema *swaggerSchema, properties map[string]swaggerProperty, required map[string]struct{}) error {
+	for _, parent := range schema.AllOf {
+		parentFilePath := filePath
+		if parent.Ref != "" {
+			reference, definition, err := b.loader.resolveDefinition(filePath, parent.Ref)
+			if err != nil {
+				return err
+			}
+			parentFilePath = reference.filePath
+			parent = definition
+		}
+
+		if err := b.collectProperties(parentFilePath, parent, properties, required); err != nil {
+			return err
+		}
+	}
+
+	for k, v := range schema.Properties {
+		properties[k] = swaggerProperty{
+			filePath: filePath,
+			name:     k,
+			schema:   v,
+		}
+	}
+	for _, v := range schema.Required {
+		required[v] = struct{}{}
+	}
+
+	return nil
+}
+
+func sortedPropertyNames(input map[string]swaggerProperty) []string {
+	out := make([]string, 0)
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,312 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// packageDefinition is a package containing the API Client for a single Tag within the Swagger
+type packageDefinition struct {
+	// Name is the name of this package, e.g. `namespaces`
+	Name string
+
+	// ClientName is the name of the API Client within this package, e.g. `NamespacesClient`
+	ClientName string
+
+	// ApiVersion is the API Version used by this API Client, e.g. `2018-01-01-preview`
+	ApiVersion string
+
+	Constants   map[string]constantDefinition
+	Models      map[string]modelDefinition
+	Operations  map[string]operationDefinition
+	ResourceIds map[string]resourceIdDefinition
+}
+
+func (p packageDefinition) copy() packageDefinition {
+	out := p
+	out.Constants = make(map[string]constantDefinition)
+	for k, v := range p.Constants {
+		out.Constants[k] = v
+	}
+	out.Models = make(map[string]modelDefinition)
+	for k, v := range p.Models {
+		out.Models[k] = v
+	}
+	out.Operations = make(map[string]operationDefinition)
+	for k, v := range p.Operations {
+		out.Operations[k] = v
+	}
+	out.ResourceIds = make(map[string]resourceIdDefinition)
+	for k, v := range p.ResourceIds {
+		out.ResourceIds[k] = v
+	}
+	return out
+}
+
+type constantDefinition struct {
+	// Name is the name of this Constant, e.g. `SkuName`
+	Name string
+
+	// Values are the possible values for this Constant
+	Values []string
+}
+
+type modelDefinition struct {
+	// Name is the name of this Model, e.g. `EHNamespace`
+	Name string
+
+	// Fields are the fields within this Model, ordered by name
+	Fields []fieldDefinition
+}
+
+type fieldDefinition struct {
+	// Name is the name of this field within the Model, e.g. `ProvisioningState`
+	Name string
+
+	// JsonName is the name of this field within the API, e.g. `provisioningState`
+	JsonName string
+
+	// Type is the Go type of this field, e.g. `*string` or `SkuName`
+	Type string
+
+	// Required specifies whether this field is required, in which case it's not a pointer and isn't omitted when empty
+	Required bool
+
+	// DateTime specifies whether this field contains a RFC3339 date, in which case helpers are output to get/set the value as a `time.Time`
+	DateTime bool
+}
+
+type operationDefinition struct {
+	// Name is the name of this Operation, e.g. `CreateOrUpdate`
+	Name string
+
+	// HttpMethod is the HTTP Method used for this Operation, e.g. `PUT`
+	HttpMethod string
+
+	// ResourceIdName is the name of the Resource ID which this Operation is performed against, if any
+	ResourceIdName string
+
+	// Uri is the URI of this Operation when it isn't performed against a Resource ID, otherwise the suffix
+	// which is appended to the Resource ID, e.g. `/listKeys`
+	Uri string
+
+	// RequestType is the Go type of the request body, if any
+	RequestType string
+
+	// ResponseType is the Go type of the response body (or each item for a List operation), if any
+	ResponseType string
+
+	// LongRunning specifies whether this is a Long Running Operation, which returns a Poller
+	LongRunning bool
+
+	// Pageable specifies whether this Operation returns a list of items which may be paged
+	Pageable bool
+
+	// ItemName is the name of the field containing the items when this Operation is Pageable
+	ItemName string
+
+	// NextLinkName is the name of the field containing the link to the next page when this Operation is Pageable
+	NextLinkName string
+
+	// StatusCodes are the expected HTTP Status Codes returned from this Operation
+	StatusCodes []int
+
+	// Options are the optional Query String parameters for this Operation, ordered by name
+	Options []optionDefinition
+}
+
+type optionDefinition struct {
+	// Name is the name of the field for this Option, e.g. `Top`
+	Name string
+
+	// QueryName is the name of this Option within the Query String, e.g. `$top`
+	QueryName string
+
+	// Type is the Go type of this Option, e.g. `int64`
+	Type string
+}
+
+type resourceIdDefinition struct {
+	// Name is the name of this Resource ID, e.g. `Namespace`
+	Name string
+
+	// Segments are the key/value pairs which make up this Resource ID
+	Segments []resourceIdSegment
+}
+
+type resourceIdSegment struct {
+	// Key is the key for this Segment, e.g. `namespaces`
+	Key string
+
+	// FieldName is the name of the field for the user-specified value of this Segment, e.g. `NamespaceName`
+	FieldName string
+
+	// Placeholder is the name of the user-specified value within the Swagger, e.g. `{namespaceName}`
+	Placeholder string
+
+	// FixedValue is the value of this Segment when it's not user-specified, e.g. `Microsoft.EventHub`
+	FixedValue string
+}
+
+func (id resourceIdDefinition) formatString() string {
+	out := ""
+	for _, segment := range id.Segments {
+		value := "%s"
+		if segment.FieldName == "" {
+			value = segment.FixedValue
+		}
+		out += fmt.Sprintf("/%s/%s", segment.Key, value)
+	}
+	return out
+}
+
+func (id resourceIdDefinition) userSpecifiedSegments() []resourceIdSegment {
+	out := make([]resourceIdSegment, 0)
+	for _, segment := range id.Segments {
+		if segment.FieldName != "" {
+			out = append(out, segment)
+		}
+	}
+	return out
+}
+
+// unsupportedError is returned when an Operation uses a feature which isn't supported by this generator,
+// in which case the Operation is skipped
+type unsupportedError struct {
+	message string
+}
+
+func (e unsupportedError) Error() string {
+	return e.message
+}
+
+func unsupported(format string, a ...interface{}) error {
+	return unsupportedError{
+		message: fmt.Sprintf(format, a...),
+	}
+}
+
+func isParameter(input string) bool {
+	return strings.HasPrefix(input, "{") && strings.HasSuffix(input, "}")
+}
+
+// parseResourceIdFromUri parses the Resource ID from the specified URI - which is everything up to and including
+// the last user-specified segment - returning the remainder of the URI
+func parseResourceIdFromUri(uri string) (*resourceIdDefinition, string, error) {
+	segments := strings.Split(strings.TrimPrefix(uri, "/"), "/")
+	last := -1
+	for i, v := range segments {
+		if isParameter(v) {
+			last = i
+		}
+	}
+	if last == -1 {
+		return nil, uri, nil
+	}
+
+	if segments[0] != "subscriptions" {
+		return nil, "", unsupported("the Resource ID within %q isn't within a Subscription", uri)
+	}
+	if (last+1)%2 != 0 {
+		return nil, "", unsupported("the Resource ID within %q isn't made up of key/value pairs", uri)
+	}
+
+	id := resourceIdDefinition{
+		Segments: make([]resourceIdSegment, 0),
+	}
+	keys := make(map[string]struct{})
+	lastUserSpecified := -1
+	for i := 0; i <= last; i += 2 {
+		key := segments[i]
+		value := segments[i+1]
+		if isParameter(key) {
+			return nil, "", unsupported("the Resource ID within %q contains the user-specified key %q", uri, key)
+		}
+		if _, exists := keys[key]; exists {
+			return nil, "", unsupported("the Resource ID within %q contains the key %q multiple times", uri, key)
+		}
+		keys[key] = struct{}{}
+
+		segment := resourceIdSegment{
+			Key: key,
+		}
+		if isParameter(value) {
+			if key == "providers" {
+				return nil, "", unsupported("the Resource ID within %q contains a user-specified Resource Provider", uri)
+			}
+
+			segment.Placeholder = value
+			switch key {
+			case "subscriptions":
+				segment.FieldName = "SubscriptionId"
+			case "resourceGroups":
+				segment.FieldName = "ResourceGroup"
+			default:
+				segment.FieldName = fmt.Sprintf("%sName", pascalCase(singular(key)))
+				lastUserSpecified = len(id.Segments)
+			}
+		} else {
+			segment.FixedValue = value
+		}
+		id.Segments = append(id.Segments, segment)
+	}
+
+	if lastUserSpecified != -1 {
+		id.Segments[lastUserSpecified].FieldName = "Name"
+	}
+
+	id.Name = pascalCase(singular(id.Segments[len(id.Segments)-1].Key))
+	remainder := ""
+	if last+1 < len(segments) {
+		remainder = "/" + strings.Join(segments[last+1:], "/")
+	}
+	return &id, remainder, nil
+}
+
+// pascalCase converts the specified value to PascalCase, removing any characters which aren't valid in an identifier
+// e.g. `Microsoft.KeyVault` becomes `MicrosoftKeyVault` and `event hubs` becomes `EventHubs`
+func pascalCase(input string) string {
+	out := ""
+	upperNext := true
+	for _, c := range input {
+		isLetter := (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+		isDigit := c >= '0' && c <= '9'
+		if !isLetter && !isDigit {
+			upperNext = true
+			continue
+		}
+
+		if upperNext {
+			out += strings.ToUpper(string(c))
+			upperNext = false
+			continue
+		}
+		out += string(c)
+	}
+	return out
+}
+
+// singular returns the singular form of the specified (plural) value, e.g. `namespaces` becomes `namespace`
+func singular(input string) string {
+	switch {
+	case strings.HasSuffix(input, "ies"):
+		return strings.TrimSuffix(input, "ies") + "y"
+	case strings.HasSuffix(input, "sses"):
+		return strings.TrimSuffix(input, "es")
+	case strings.HasSuffix(input, "ss"):
+		return input
+	case strings.HasSuffix(input, "s"):
+		return strings.TrimSuffix(input, "s")
+	}
+	return input
+}
+
+func sortedKeys(input map[string]struct{}) []string {
+	out := make([]string, 0)
+	for k := range input {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
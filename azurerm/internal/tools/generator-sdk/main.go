@@ -0,0 +1,182 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func main() {
+	swaggerFiles := flag.String("swagger", "", "A comma-separated list of the paths to the Swagger files to generate API Clients for")
+	outputDirectory := flag.String("output", "", "The path to the directory the API Clients should be output to, e.g. `./azurerm/internal/services/eventhub/sdk`")
+	tag := flag.String("tag", "", "Only generate the API Client for this Tag within the Swagger")
+	showHelp := flag.Bool("help", false, "Display this message")
+
+	flag.Parse()
+
+	if *showHelp {
+		flag.Usage()
+		return
+	}
+
+	if err := run(strings.Split(*swaggerFiles, ","), *outputDirectory, *tag); err != nil {
+		panic(err)
+	}
+}
+
+func run(swaggerFiles []string, outputDirectory, tag string) error {
+	if outputDirectory == "" {
+		return fmt.Errorf("an output directory must be specified")
+	}
+
+	packages, err := buildPackages(swaggerFiles, tag)
+	if err != nil {
+		return err
+	}
+
+	for _, pkg := range packages {
+		if err := writePackage(filepath.Join(outputDirectory, pkg.Name), pkg); err != nil {
+			return fmt.Errorf("generating the Package %q: %+v", pkg.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// buildPackages builds the Package Definitions for the Operations within the specified Swagger files, ordered by name
+func buildPackages(swaggerFiles []string, tag string) ([]packageDefinition, error) {
+	b := newBuilder(tag)
+	for _, swaggerFile := range swaggerFiles {
+		swaggerFile = strings.TrimSpace(swaggerFile)
+		if swaggerFile == "" {
+			continue
+		}
+
+		if err := b.addDocument(swaggerFile); err != nil {
+			return nil, fmt.Errorf("parsing the Swagger file %q: %+v", swaggerFile, err)
+		}
+	}
+
+	packages := make([]packageDefinition, 0)
+	for _, pkg := range b.packages {
+		// Packages can be empty when all of the Operations within them are unsupported
+		if len(pkg.Operations) == 0 {
+			continue
+		}
+
+		packages = append(packages, *pkg)
+	}
+	if len(packages) == 0 {
+		return nil, fmt.Errorf("no supported Operations were found")
+	}
+
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].Name < packages[j].Name
+	})
+	return packages, nil
+}
+
+// generatePackage returns the code for the specified Package, keyed by file name
+func generatePackage(pkg packageDefinition) map[string]string {
+	files := map[string]string{
+		"client.go":  pkg.clientCode(),
+		"version.go": pkg.versionCode(),
+	}
+
+	if len(pkg.Constants) > 0 {
+		files["constants.go"] = pkg.constantsCode()
+	}
+
+	for _, id := range pkg.ResourceIds {
+		fileName := fmt.Sprintf("id_%s", strings.ToLower(id.Name))
+		files[fileName+".go"] = id.idCode(pkg.Name)
+		files[fileName+"_test.go"] = id.testCode(pkg.Name)
+	}
+
+	for _, model := range pkg.Models {
+		files[fmt.Sprintf("model_%s.go", strings.ToLower(model.Name))] = model.code(pkg.Name)
+	}
+
+	predicates := make(map[string]struct{})
+	for _, operation := range pkg.Operations {
+		generator := methodGenerator{
+			packageName: pkg.Name,
+			clientName:  pkg.ClientName,
+			operation:   operation,
+		}
+		files[fmt.Sprintf("method_%s_autorest.go", strings.ToLower(operation.Name))] = generator.code()
+
+		if operation.Pageable {
+			predicates[operation.ResponseType] = struct{}{}
+		}
+	}
+
+	if len(predicates) > 0 {
+		code := make([]string, 0)
+		for _, name := range sortedKeys(predicates) {
+			code = append(code, pkg.Models[name].predicateCode())
+		}
+		files["predicates.go"] = fmt.Sprintf("package %s\n\n%s\n", pkg.Name, strings.Join(code, "\n\n"))
+	}
+
+	return files
+}
+
+// generatedFilePrefixes are the prefixes of the files output by this generator, which are removed before the
+// Package is regenerated - so that files for Operations which no longer exist are removed
+var generatedFilePrefixes = []string{
+	"client.go",
+	"constants.go",
+	"id_",
+	"method_",
+	"model_",
+	"predicates.go",
+	"version.go",
+}
+
+func writePackage(directory string, pkg packageDefinition) error {
+	if err := os.MkdirAll(directory, 0755); err != nil {
+		return fmt.Errorf("creating the directory %q: %+v", directory, err)
+	}
+
+	existing, err := os.ReadDir(directory)
+	if err != nil {
+		return fmt.Errorf("listing the files within %q: %+v", directory, err)
+	}
+	for _, file := range existing {
+		if file.IsDir() || !isGeneratedFile(file.Name()) {
+			continue
+		}
+
+		if err := os.Remove(filepath.Join(directory, file.Name())); err != nil {
+			return fmt.Errorf("removing the existing file %q: %+v", file.Name(), err)
+		}
+	}
+
+	for fileName, code := range generatePackage(pkg) {
+		filePath := filepath.Join(directory, fileName)
+		formatted, err := format.Source([]byte(code))
+		if err != nil {
+			return fmt.Errorf("formatting %q: %+v", filePath, err)
+		}
+
+		if err := os.WriteFile(filePath, formatted, 0644); err != nil {
+			return fmt.Errorf("writing %q: %+v", filePath, err)
+		}
+	}
+
+	return nil
+}
+
+func isGeneratedFile(fileName string) bool {
+	for _, prefix := range generatedFilePrefixes {
+		if strings.HasPrefix(fileName, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,305 @@
+package main
+
+import (
+	"go/format"
+	"strings"
+	"testing"
+)
+
+func TestPascalCase(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{
+			"",
+			"",
+		},
+		{
+			"namespaces",
+			"Namespaces",
+		},
+		{
+			"Microsoft.KeyVault",
+			"MicrosoftKeyVault",
+		},
+		{
+			"event hubs",
+			"EventHubs",
+		},
+		{
+			"$top",
+			"Top",
+		},
+	}
+
+	for idx, c := range cases {
+		out := pascalCase(c.in)
+		if c.out != out {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.out, out)
+		}
+	}
+}
+
+func TestSingular(t *testing.T) {
+	cases := []struct {
+		in  string
+		out string
+	}{
+		{
+			"namespaces",
+			"namespace",
+		},
+		{
+			"policies",
+			"policy",
+		},
+		{
+			"addresses",
+			"address",
+		},
+		{
+			"access",
+			"access",
+		},
+		{
+			"default",
+			"default",
+		},
+	}
+
+	for idx, c := range cases {
+		out := singular(c.in)
+		if c.out != out {
+			t.Fatalf("%d. %q (expect) != %q (actual)", idx, c.out, out)
+		}
+	}
+}
+
+func TestParseResourceIdFromUri(t *testing.T) {
+	cases := []struct {
+		uri       string
+		name      string
+		format    string
+		remainder string
+		fields    []string
+		error     bool
+	}{
+		{
+			uri:       "/providers/Microsoft.EventHub/operations",
+			remainder: "/providers/Microsoft.EventHub/operations",
+		},
+		{
+			uri:       "/subscriptions/{subscriptionId}/providers/Microsoft.EventHub/namespaces",
+			name:      "Subscription",
+			format:    "/subscriptions/%s",
+			remainder: "/providers/Microsoft.EventHub/namespaces",
+			fields:    []string{"SubscriptionId"},
+		},
+		{
+			uri:    "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}",
+			name:   "ResourceGroup",
+			format: "/subscriptions/%s/resourceGroups/%s",
+			fields: []string{"SubscriptionId", "ResourceGroup"},
+		},
+		{
+			uri:       "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.EventHub/namespaces/{namespaceName}/eventhubs/{eventHubName}/authorizationRules/{authorizationRuleName}/listKeys",
+			name:      "AuthorizationRule",
+			format:    "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/eventhubs/%s/authorizationRules/%s",
+			remainder: "/listKeys",
+			fields:    []string{"SubscriptionId", "ResourceGroup", "NamespaceName", "EventhubName", "Name"},
+		},
+		{
+			uri:    "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.EventHub/namespaces/{namespaceName}/networkRuleSets/default/rules/{ruleName}",
+			name:   "Rule",
+			format: "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.EventHub/namespaces/%s/networkRuleSets/default/rules/%s",
+			fields: []string{"SubscriptionId", "ResourceGroup", "NamespaceName", "Name"},
+		},
+		{
+			// scope
+			uri:   "/{scope}/providers/Microsoft.Authorization/locks/{lockName}",
+			error: true,
+		},
+		{
+			// not key/value pairs
+			uri:   "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Example/{resourceName}",
+			error: true,
+		},
+		{
+			// user-specified resource provider
+			uri:   "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/{resourceProviderNamespace}",
+			error: true,
+		},
+		{
+			// duplicate keys
+			uri:   "/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Example/things/{thingName}/providers/Microsoft.Other/things/{otherThingName}",
+			error: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Logf("[DEBUG] Testing %q", c.uri)
+
+		id, remainder, err := parseResourceIdFromUri(c.uri)
+		if err != nil {
+			if c.error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %s", err)
+		}
+		if c.error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+		if remainder != c.remainder {
+			t.Fatalf("Expected the remainder to be %q but got %q", c.remainder, remainder)
+		}
+
+		if c.name == "" {
+			if id != nil {
+				t.Fatalf("Expected no Resource ID but got %q", id.formatString())
+			}
+			continue
+		}
+
+		if id.Name != c.name {
+			t.Fatalf("Expected the name to be %q but got %q", c.name, id.Name)
+		}
+		if id.formatString() != c.format {
+			t.Fatalf("Expected the format to be %q but got %q", c.format, id.formatString())
+		}
+
+		fields := make([]string, 0)
+		for _, segment := range id.userSpecifiedSegments() {
+			fields = append(fields, segment.FieldName)
+		}
+		if strings.Join(fields, ",") != strings.Join(c.fields, ",") {
+			t.Fatalf("Expected the fields to be %q but got %q", c.fields, fields)
+		}
+	}
+}
+
+func TestBuildPackages(t *testing.T) {
+	packages, err := buildPackages([]string{"./testdata/widgets.json"}, "")
+	if err != nil {
+		t.Fatalf("building the packages: %+v", err)
+	}
+
+	names := make([]string, 0)
+	for _, pkg := range packages {
+		names = append(names, pkg.Name)
+	}
+	// the Attachments Operation uses a Scope which isn't supported, so is skipped
+	if expected := "gadgets,operations,revisions,widgets"; strings.Join(names, ",") != expected {
+		t.Fatalf("Expected the packages %q but got %q", expected, strings.Join(names, ","))
+	}
+
+	widgets := packages[3]
+	if widgets.ClientName != "WidgetsClient" {
+		t.Fatalf("Expected the client name to be `WidgetsClient` but got %q", widgets.ClientName)
+	}
+	if widgets.ApiVersion != "2021-06-01-preview" {
+		t.Fatalf("Expected the API Version to be `2021-06-01-preview` but got %q", widgets.ApiVersion)
+	}
+
+	operations := map[string]struct {
+		resourceIdName string
+		requestType    string
+		responseType   string
+		longRunning    bool
+		pageable       bool
+		options        int
+	}{
+		"CreateOrUpdate": {
+			resourceIdName: "Widget",
+			requestType:    "Widget",
+			responseType:   "Widget",
+			longRunning:    true,
+		},
+		"Delete": {
+			resourceIdName: "Widget",
+			longRunning:    true,
+		},
+		"Get": {
+			resourceIdName: "Widget",
+			responseType:   "Widget",
+		},
+		"List": {
+			resourceIdName: "Subscription",
+			responseType:   "Widget",
+			pageable:       true,
+		},
+		"ListByResourceGroup": {
+			resourceIdName: "ResourceGroup",
+			responseType:   "Widget",
+			pageable:       true,
+			options:        1,
+		},
+		"ListKeys": {
+			resourceIdName: "Widget",
+			responseType:   "WidgetKeys",
+		},
+		"Update": {
+			resourceIdName: "Widget",
+			requestType:    "WidgetUpdate",
+			responseType:   "Widget",
+		},
+	}
+	if len(widgets.Operations) != len(operations) {
+		t.Fatalf("Expected %d operations but got %d", len(operations), len(widgets.Operations))
+	}
+	for name, expected := range operations {
+		actual, ok := widgets.Operations[name]
+		if !ok {
+			t.Fatalf("Expected the operation %q but it wasn't found", name)
+		}
+
+		if actual.ResourceIdName != expected.resourceIdName {
+			t.Fatalf("Expected the Resource ID for %q to be %q but got %q", name, expected.resourceIdName, actual.ResourceIdName)
+		}
+		if actual.RequestType != expected.requestType {
+			t.Fatalf("Expected the Request Type for %q to be %q but got %q", name, expected.requestType, actual.RequestType)
+		}
+		if actual.ResponseType != expected.responseType {
+			t.Fatalf("Expected the Response Type for %q to be %q but got %q", name, expected.responseType, actual.ResponseType)
+		}
+		if actual.LongRunning != expected.longRunning {
+			t.Fatalf("Expected Long Running for %q to be %t but got %t", name, expected.longRunning, actual.LongRunning)
+		}
+		if actual.Pageable != expected.pageable {
+			t.Fatalf("Expected Pageable for %q to be %t but got %t", name, expected.pageable, actual.Pageable)
+		}
+		if len(actual.Options) != expected.options {
+			t.Fatalf("Expected %d options for %q but got %d", expected.options, name, len(actual.Options))
+		}
+	}
+
+	// the list models are only used to unmarshal each page
+	if _, ok := widgets.Models["WidgetListResult"]; ok {
+		t.Fatalf("Expected the Model `WidgetListResult` to be removed")
+	}
+
+	widget := widgets.Models["Widget"]
+	for _, field := range widget.Fields {
+		switch field.Name {
+		case "Location":
+			if !field.Required || field.Type != "string" {
+				t.Fatalf("Expected `Location` (from the inherited Model) to be a required string but got %q", field.Type)
+			}
+
+		case "Tags":
+			if field.Type != "*map[string]string" {
+				t.Fatalf("Expected `Tags` to be a `*map[string]string` but got %q", field.Type)
+			}
+		}
+	}
+
+	for _, pkg := range packages {
+		for fileName, code := range generatePackage(pkg) {
+			if _, err := format.Source([]byte(code)); err != nil {
+				t.Fatalf("formatting %q within %q: %+v", fileName, pkg.Name, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// swaggerDocument is the subset of a Swagger 2.0 document used by this generator
+type swaggerDocument struct {
+	Info struct {
+		Version string `json:"version"`
+	} `json:"info"`
+	Paths       map[string]map[string]json.RawMessage `json:"paths"`
+	Definitions map[string]*swaggerSchema             `json:"definitions"`
+	Parameters  map[string]*swaggerParameter          `json:"parameters"`
+}
+
+type swaggerOperation struct {
+	OperationId string                      `json:"operationId"`
+	Tags        []string                    `json:"tags"`
+	Parameters  []*swaggerParameter         `json:"parameters"`
+	Responses   map[string]*swaggerResponse `json:"responses"`
+	LongRunning bool                        `json:"x-ms-long-running-operation"`
+	Pageable    *swaggerPageable            `json:"x-ms-pageable"`
+}
+
+type swaggerPageable struct {
+	ItemName *string `json:"itemName"`
+
+	// NextLinkName is either omitted (in which case it defaults to `nextLink`), `null` (in which case the results
+	// aren't paged) or the name of the field containing the link to the next page
+	NextLinkName json.RawMessage `json:"nextLinkName"`
+}
+
+type swaggerParameter struct {
+	Ref      string         `json:"$ref"`
+	Name     string         `json:"name"`
+	In       string         `json:"in"`
+	Required bool           `json:"required"`
+	Type     string         `json:"type"`
+	Schema   *swaggerSchema `json:"schema"`
+}
+
+type swaggerResponse struct {
+	Ref    string         `json:"$ref"`
+	Schema *swaggerSchema `json:"schema"`
+}
+
+type swaggerSchema struct {
+	Ref                  string                    `json:"$ref"`
+	Type                 string                    `json:"type"`
+	Format               string                    `json:"format"`
+	Properties           map[string]*swaggerSchema `json:"properties"`
+	Required             []string                  `json:"required"`
+	Items                *swaggerSchema            `json:"items"`
+	AdditionalProperties json.RawMessage           `json:"additionalProperties"`
+	AllOf                []*swaggerSchema          `json:"allOf"`
+	Enum                 []interface{}             `json:"enum"`
+	Enumeration          *swaggerEnum              `json:"x-ms-enum"`
+}
+
+type swaggerEnum struct {
+	Name string `json:"name"`
+}
+
+// swaggerLoader loads Swagger documents (and those referenced from them) and resolves references between them
+type swaggerLoader struct {
+	documents map[string]*swaggerDocument
+}
+
+func newSwaggerLoader() *swaggerLoader {
+	return &swaggerLoader{
+		documents: make(map[string]*swaggerDocument),
+	}
+}
+
+func (l *swaggerLoader) load(filePath string) (*swaggerDocument, error) {
+	filePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("determining the absolute path for %q: %+v", filePath, err)
+	}
+
+	if doc, ok := l.documents[filePath]; ok {
+		return doc, nil
+	}
+
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("reading %q: %+v", filePath, err)
+	}
+
+	var doc swaggerDocument
+	if err := json.Unmarshal(contents, &doc); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", filePath, err)
+	}
+
+	l.documents[filePath] = &doc
+	return &doc, nil
+}
+
+// swaggerReference is a resolved reference to a Definition or Parameter within a Swagger document
+type swaggerReference struct {
+	// filePath is the path to the document containing this Definition or Parameter
+	filePath string
+
+	// name is the name of this Definition or Parameter
+	name string
+}
+
+// parseReference parses the specified reference (e.g. `#/definitions/Sku` or `../types.json#/parameters/ApiVersion`)
+// which is relative to the specified document
+func parseReference(filePath, ref, kind string) (*swaggerReference, error) {
+	split := strings.SplitN(ref, "#", 2)
+	if len(split) != 2 {
+		return nil, fmt.Errorf("expected the reference %q to contain a `#`", ref)
+	}
+
+	prefix := fmt.Sprintf("/%s/", kind)
+	if !strings.HasPrefix(split[1], prefix) {
+		return nil, fmt.Errorf("expected the reference %q to start with %q", ref, prefix)
+	}
+
+	if split[0] != "" {
+		filePath = filepath.Join(filepath.Dir(filePath), split[0])
+	}
+
+	return &swaggerReference{
+		filePath: filePath,
+		name:     strings.TrimPrefix(split[1], prefix),
+	}, nil
+}
+
+func (l *swaggerLoader) resolveDefinition(filePath, ref string) (*swaggerReference, *swaggerSchema, error) {
+	reference, err := parseReference(filePath, ref, "definitions")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := l.load(reference.filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	definition, ok := doc.Definitions[reference.name]
+	if !ok {
+		return nil, nil, fmt.Errorf("the Definition %q was not found in %q", reference.name, reference.filePath)
+	}
+
+	return reference, definition, nil
+}
+
+func (l *swaggerLoader) resolveParameter(filePath string, parameter *swaggerParameter) (string, *swaggerParameter, error) {
+	if parameter.Ref == "" {
+		return filePath, parameter, nil
+	}
+
+	reference, err := parseReference(filePath, parameter.Ref, "parameters")
+	if err != nil {
+		return "", nil, err
+	}
+
+	doc, err := l.load(reference.filePath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	resolved, ok := doc.Parameters[reference.name]
+	if !ok {
+		return "", nil, fmt.Errorf("the Parameter %q was not found in %q", reference.name, reference.filePath)
+	}
+
+	return reference.filePath, resolved, nil
+}
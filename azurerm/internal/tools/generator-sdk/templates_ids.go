@@ -0,0 +1,431 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+func (id resourceIdDefinition) typeName() string {
+	return fmt.Sprintf("%sId", id.Name)
+}
+
+func (id resourceIdDefinition) hasSegment(key string) bool {
+	for _, segment := range id.Segments {
+		if segment.Key == key && segment.FieldName != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// popSegments returns the segments which are parsed using `PopSegment` - that is, everything
+// other than the Subscription, Resource Group and Resource Provider
+func (id resourceIdDefinition) popSegments() []resourceIdSegment {
+	out := make([]resourceIdSegment, 0)
+	for _, segment := range id.Segments {
+		switch segment.Key {
+		case "subscriptions", "resourceGroups", "providers":
+			continue
+		}
+		out = append(out, segment)
+	}
+	return out
+}
+
+func (id resourceIdDefinition) idCode(packageName string) string {
+	typeName := id.typeName()
+	userSpecified := id.userSpecifiedSegments()
+
+	fields := make([]string, 0)
+	arguments := make([]string, 0)
+	assignments := make([]string, 0)
+	formatArguments := make([]string, 0)
+	for _, segment := range userSpecified {
+		fields = append(fields, fmt.Sprintf("\t%s string", segment.FieldName))
+		arguments = append(arguments, lowerFirst(segment.FieldName))
+		assignments = append(assignments, fmt.Sprintf("\t\t%s: %s,", segment.FieldName, lowerFirst(segment.FieldName)))
+		formatArguments = append(formatArguments, fmt.Sprintf("id.%s", segment.FieldName))
+	}
+
+	// the Subscription is only output when it's the only segment
+	descriptions := make([]string, 0)
+	for i := len(userSpecified) - 1; i >= 0; i-- {
+		segment := userSpecified[i]
+		if segment.FieldName == "SubscriptionId" && len(userSpecified) > 1 {
+			continue
+		}
+		descriptions = append(descriptions, fmt.Sprintf("\t\tfmt.Sprintf(\"%s %%q\", id.%s),", segmentDescription(segment), segment.FieldName))
+	}
+
+	return fmt.Sprintf(`package %[1]s
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type %[2]s struct {
+%[3]s
+}
+
+func New%[4]sID(%[5]s string) %[2]s {
+	return %[2]s{
+%[6]s
+	}
+}
+
+func (id %[2]s) String() string {
+	segments := []string{
+%[7]s
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%%s: (%%s)", %[8]q, segmentsStr)
+}
+
+func (id %[2]s) ID() string {
+	fmtString := %[9]q
+	return fmt.Sprintf(fmtString, %[10]s)
+}
+
+%[11]s
+
+%[12]s
+`, packageName, typeName, strings.Join(fields, "\n"), id.Name, strings.Join(arguments, ", "), strings.Join(assignments, "\n"),
+		strings.Join(descriptions, "\n"), splitCamelCase(id.Name), id.formatString(), strings.Join(formatArguments, ", "),
+		id.parserCode(false), id.parserCode(true))
+}
+
+func (id resourceIdDefinition) parserCode(insensitively bool) string {
+	typeName := id.typeName()
+
+	initialFields := []string{
+		"\t\tSubscriptionId: id.SubscriptionID,",
+	}
+	checks := []string{
+		`	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+`,
+	}
+	if id.hasSegment("resourceGroups") {
+		initialFields = append(initialFields, "\t\tResourceGroup:  id.ResourceGroup,")
+		checks = append(checks, `	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+`)
+	}
+
+	for _, segment := range id.popSegments() {
+		key := fmt.Sprintf("%q", segment.Key)
+		if insensitively {
+			variableName := fmt.Sprintf("%sKey", lowerFirst(pascalCase(segment.Key)))
+			checks = append(checks, fmt.Sprintf(`	// find the correct casing for the '%[1]s' segment
+	%[2]s := %[1]q
+	for key := range id.Path {
+		if strings.EqualFold(key, %[2]s) {
+			%[2]s = key
+			break
+		}
+	}`, segment.Key, variableName))
+			key = variableName
+		}
+
+		if segment.FieldName != "" {
+			checks = append(checks, fmt.Sprintf(`	if resourceId.%s, err = id.PopSegment(%s); err != nil {
+		return nil, err
+	}
+`, segment.FieldName, key))
+			continue
+		}
+
+		// whilst this segment isn't user-specified, it needs to be parsed since the Resource ID otherwise contains extra segments
+		variableName := fmt.Sprintf("%sValue", lowerFirst(pascalCase(segment.Key)))
+		comparison := fmt.Sprintf("%s != %q", variableName, segment.FixedValue)
+		if insensitively {
+			comparison = fmt.Sprintf("!strings.EqualFold(%s, %q)", variableName, segment.FixedValue)
+		}
+		checks = append(checks, fmt.Sprintf(`	%[1]s, err := id.PopSegment(%[2]s)
+	if err != nil {
+		return nil, err
+	}
+	if %[3]s {
+		return nil, fmt.Errorf("expected the '%[4]s' element to be %%q but got %%q", %[5]q, %[1]s)
+	}
+`, variableName, key, comparison, segment.Key, segment.FixedValue))
+	}
+
+	comment := fmt.Sprintf("// %[1]sID parses a %[1]s ID into an %[2]s struct", id.Name, typeName)
+	functionName := fmt.Sprintf("%sID", id.Name)
+	if insensitively {
+		comment = fmt.Sprintf(`// %[1]sIDInsensitively parses an %[1]s ID into an %[2]s struct, insensitively
+// This should only be used to parse an ID for rewriting to a consistent casing,
+// the %[1]sID method should be used instead for validation etc.`, id.Name, typeName)
+		functionName = fmt.Sprintf("%sIDInsensitively", id.Name)
+	}
+
+	return fmt.Sprintf(`%[1]s
+func %[2]s(input string) (*%[3]s, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := %[3]s{
+%[4]s
+	}
+
+%[5]s
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}`, comment, functionName, typeName, strings.Join(initialFields, "\n"), strings.Join(checks, "\n"))
+}
+
+func (id resourceIdDefinition) testCode(packageName string) string {
+	typeName := id.typeName()
+	userSpecified := id.userSpecifiedSegments()
+
+	placeholders := make([]string, 0)
+	for _, segment := range userSpecified {
+		placeholders = append(placeholders, fmt.Sprintf("%q", segment.Placeholder))
+	}
+
+	assertions := make([]string, 0)
+	for _, segment := range userSpecified {
+		assertions = append(assertions, fmt.Sprintf(`		if actual.%[1]s != v.Expected.%[1]s {
+			t.Fatalf("Expected %%q but got %%q for %[1]s", v.Expected.%[1]s, actual.%[1]s)
+		}`, segment.FieldName))
+	}
+
+	return fmt.Sprintf(`package %[1]s
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+var _ resourceids.Id = %[2]s{}
+
+func Test%[3]sIDFormatter(t *testing.T) {
+	actual := New%[3]sID(%[4]s).ID()
+	expected := %[5]q
+	if actual != expected {
+		t.Fatalf("Expected %%q but got %%q", expected, actual)
+	}
+}
+
+func Test%[3]sID(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *%[2]s
+	}{
+%[6]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[3]sID(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[7]s
+	}
+}
+
+func Test%[3]sIDInsensitively(t *testing.T) {
+	testData := []struct {
+		Input    string
+		Error    bool
+		Expected *%[2]s
+	}{
+%[8]s
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %%q", v.Input)
+
+		actual, err := %[3]sIDInsensitively(v.Input)
+		if err != nil {
+			if v.Error {
+				continue
+			}
+
+			t.Fatalf("Expect a value but got an error: %%s", err)
+		}
+		if v.Error {
+			t.Fatal("Expect an error but didn't get one")
+		}
+
+%[7]s
+	}
+}
+`, packageName, typeName, id.Name, strings.Join(placeholders, ", "), id.exampleId(nil), id.testCases(false),
+		strings.Join(assertions, "\n"), id.testCases(true))
+}
+
+// exampleId returns an example of this Resource ID using the placeholders as the values, where the keys of
+// the popped segments are transformed using the specified function (if any)
+func (id resourceIdDefinition) exampleId(transform func(string) string) string {
+	out := ""
+	popped := make(map[string]struct{})
+	for _, segment := range id.popSegments() {
+		popped[segment.Key] = struct{}{}
+	}
+
+	for _, segment := range id.Segments {
+		key := segment.Key
+		if _, ok := popped[key]; ok && transform != nil {
+			key = transform(key)
+		}
+
+		value := segment.FixedValue
+		if segment.FieldName != "" {
+			value = segment.Placeholder
+		}
+		out += fmt.Sprintf("/%s/%s", key, value)
+	}
+	return out
+}
+
+func (id resourceIdDefinition) testCases(insensitively bool) string {
+	testCases := []string{
+		`		{
+			// empty
+			Input: "",
+			Error: true,
+		},
+`,
+	}
+
+	prefix := ""
+	for _, segment := range id.Segments {
+		if segment.FieldName != "" {
+			testCases = append(testCases, fmt.Sprintf(`		{
+			// missing %[1]s
+			Input: %[2]q,
+			Error: true,
+		},
+
+		{
+			// missing value for %[1]s
+			Input: %[3]q,
+			Error: true,
+		},
+`, segment.FieldName, prefix+"/", fmt.Sprintf("%s/%s/", prefix, segment.Key)))
+		}
+
+		value := segment.FixedValue
+		if segment.FieldName != "" {
+			value = segment.Placeholder
+		}
+		prefix += fmt.Sprintf("/%s/%s", segment.Key, value)
+	}
+
+	expectedFields := make([]string, 0)
+	for _, segment := range id.userSpecifiedSegments() {
+		expectedFields = append(expectedFields, fmt.Sprintf("\t\t\t\t%s: %q,", segment.FieldName, segment.Placeholder))
+	}
+	expected := fmt.Sprintf(`&%s{
+%s
+			}`, id.typeName(), strings.Join(expectedFields, "\n"))
+
+	testCases = append(testCases, fmt.Sprintf(`		{
+			// valid
+			Input: %q,
+			Expected: %s,
+		},
+`, id.exampleId(nil), expected))
+
+	if !insensitively {
+		testCases = append(testCases, fmt.Sprintf(`		{
+			// upper-cased
+			Input: %q,
+			Error: true,
+		},`, strings.ToUpper(id.exampleId(nil))))
+		return strings.Join(testCases, "\n")
+	}
+
+	transforms := []struct {
+		description string
+		transform   func(string) string
+	}{
+		{
+			description: "lower-cased segment names",
+			transform:   strings.ToLower,
+		},
+		{
+			description: "upper-cased segment names",
+			transform:   strings.ToUpper,
+		},
+		{
+			description: "mixed-cased segment names",
+			transform:   mixedCase,
+		},
+	}
+	for _, v := range transforms {
+		testCases = append(testCases, fmt.Sprintf(`		{
+			// %s
+			Input: %q,
+			Expected: %s,
+		},
+`, v.description, id.exampleId(v.transform), expected))
+	}
+
+	return strings.Join(testCases, "\n")
+}
+
+func segmentDescription(segment resourceIdSegment) string {
+	if segment.FieldName == "SubscriptionId" {
+		return "Subscription"
+	}
+	return splitCamelCase(segment.FieldName)
+}
+
+// splitCamelCase splits the specified value into words, e.g. `ResourceGroup` becomes `Resource Group`
+func splitCamelCase(input string) string {
+	out := ""
+	runes := []rune(input)
+	for i, c := range runes {
+		if i > 0 && unicode.IsUpper(c) && unicode.IsLower(runes[i-1]) {
+			out += " "
+		}
+		out += string(c)
+	}
+	return out
+}
+
+func lowerFirst(input string) string {
+	if input == "" {
+		return input
+	}
+	return strings.ToLower(input[0:1]) + input[1:]
+}
+
+// mixedCase alternates the casing of each character in the specified value, e.g. `namespaces` becomes `NaMeSpAcEs`
+func mixedCase(input string) string {
+	out := ""
+	for i, c := range input {
+		if i%2 == 0 {
+			out += strings.ToUpper(string(c))
+		} else {
+			out += strings.ToLower(string(c))
+		}
+	}
+	return out
+}
@@ -0,0 +1,475 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+var statusCodeNames = map[int]string{
+	http.StatusOK:                   "http.StatusOK",
+	http.StatusCreated:              "http.StatusCreated",
+	http.StatusAccepted:             "http.StatusAccepted",
+	http.StatusNonAuthoritativeInfo: "http.StatusNonAuthoritativeInfo",
+	http.StatusNoContent:            "http.StatusNoContent",
+	http.StatusResetContent:         "http.StatusResetContent",
+	http.StatusPartialContent:       "http.StatusPartialContent",
+	http.StatusMultiStatus:          "http.StatusMultiStatus",
+	http.StatusMovedPermanently:     "http.StatusMovedPermanently",
+	http.StatusFound:                "http.StatusFound",
+	http.StatusNotModified:          "http.StatusNotModified",
+	http.StatusBadRequest:           "http.StatusBadRequest",
+	http.StatusNotFound:             "http.StatusNotFound",
+	http.StatusConflict:             "http.StatusConflict",
+	http.StatusPreconditionFailed:   "http.StatusPreconditionFailed",
+}
+
+var httpMethodPreparers = map[string]string{
+	"DELETE": "autorest.AsDelete()",
+	"GET":    "autorest.AsGet()",
+	"HEAD":   "autorest.AsHead()",
+	"PATCH":  "autorest.AsPatch()",
+	"POST":   "autorest.AsPost()",
+	"PUT":    "autorest.AsPut()",
+}
+
+// methodGenerator generates the code for a single Operation within an API Client
+type methodGenerator struct {
+	packageName string
+	clientName  string
+	operation   operationDefinition
+}
+
+func (g methodGenerator) code() string {
+	var body string
+	switch {
+	case g.operation.LongRunning:
+		body = g.longRunningCode()
+	case g.operation.Pageable:
+		body = g.pageableCode()
+	default:
+		body = g.immediateCode()
+	}
+
+	imports := []string{
+		`"context"`,
+		`"net/http"`,
+	}
+	if strings.Contains(body, "fmt.") {
+		imports = append(imports, `"fmt"`)
+	}
+	if strings.Contains(body, "url.") {
+		imports = append(imports, `"net/url"`)
+	}
+	sort.Strings(imports)
+
+	imports = append(imports, "", `"github.com/Azure/go-autorest/autorest"`, `"github.com/Azure/go-autorest/autorest/azure"`)
+	if strings.Contains(body, "polling.") {
+		imports = append(imports, `"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/polling"`)
+	}
+
+	return fmt.Sprintf(`package %s
+
+import (
+%s
+)
+
+%s
+%s`, g.packageName, strings.Join(imports, "\n"), g.optionsCode(), body)
+}
+
+func (g methodGenerator) errorSource() string {
+	return fmt.Sprintf("%s.%s", g.packageName, g.clientName)
+}
+
+// arguments returns the arguments for this Operation, both as the signature and the values passed in
+func (g methodGenerator) arguments() (string, string) {
+	signature := []string{"ctx context.Context"}
+	values := []string{"ctx"}
+	if g.operation.ResourceIdName != "" {
+		signature = append(signature, fmt.Sprintf("id %sId", g.operation.ResourceIdName))
+		values = append(values, "id")
+	}
+	if g.operation.RequestType != "" {
+		signature = append(signature, fmt.Sprintf("input %s", g.operation.RequestType))
+		values = append(values, "input")
+	}
+	if len(g.operation.Options) > 0 {
+		signature = append(signature, fmt.Sprintf("options %sOptions", g.operation.Name))
+		values = append(values, "options")
+	}
+	return strings.Join(signature, ", "), strings.Join(values, ", ")
+}
+
+func (g methodGenerator) optionsCode() string {
+	if len(g.operation.Options) == 0 {
+		return ""
+	}
+
+	fields := make([]string, 0)
+	values := make([]string, 0)
+	for _, option := range g.operation.Options {
+		fields = append(fields, fmt.Sprintf("\t%s *%s", option.Name, option.Type))
+		values = append(values, fmt.Sprintf(`	if o.%[1]s != nil {
+		out[%[2]q] = *o.%[1]s
+	}
+`, option.Name, option.QueryName))
+	}
+
+	return fmt.Sprintf(`type %[1]sOptions struct {
+%[2]s
+}
+
+func Default%[1]sOptions() %[1]sOptions {
+	return %[1]sOptions{}
+}
+
+func (o %[1]sOptions) toQueryString() map[string]interface{} {
+	out := make(map[string]interface{})
+
+%[3]s
+	return out
+}
+`, g.operation.Name, strings.Join(fields, "\n"), strings.Join(values, "\n"))
+}
+
+func (g methodGenerator) preparerCode() string {
+	signature, _ := g.arguments()
+	name := g.operation.Name
+
+	path := fmt.Sprintf("%q", g.operation.Uri)
+	if g.operation.ResourceIdName != "" {
+		path = "id.ID()"
+		if g.operation.Uri != "" {
+			path = fmt.Sprintf("fmt.Sprintf(%q, id.ID())", "%s"+g.operation.Uri)
+		}
+	}
+
+	options := ""
+	if len(g.operation.Options) > 0 {
+		options = `
+	for k, v := range options.toQueryString() {
+		queryParameters[k] = autorest.Encode("query", v)
+	}
+`
+	}
+
+	body := ""
+	if g.operation.RequestType != "" {
+		body = "\n\t\tautorest.WithJSON(input),"
+	}
+
+	return fmt.Sprintf(`// preparerFor%[1]s prepares the %[1]s request.
+func (c %[2]s) preparerFor%[1]s(%[3]s) (*http.Request, error) {
+	queryParameters := map[string]interface{}{
+		"api-version": defaultApiVersion,
+	}
+%[4]s
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		%[5]s,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(%[6]s),%[7]s
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+`, name, g.clientName, signature, options, httpMethodPreparers[g.operation.HttpMethod], path, body)
+}
+
+func (g methodGenerator) statusCodes() string {
+	codes := append([]int{}, g.operation.StatusCodes...)
+	sort.Ints(codes)
+
+	out := make([]string, 0)
+	for _, code := range codes {
+		out = append(out, statusCodeNames[code])
+	}
+	return strings.Join(out, ", ")
+}
+
+func (g methodGenerator) immediateCode() string {
+	name := g.operation.Name
+	signature, values := g.arguments()
+
+	model := ""
+	unmarshal := ""
+	if g.operation.ResponseType != "" {
+		model = fmt.Sprintf("\n\tModel        *%s", g.operation.ResponseType)
+		unmarshal = "\n\t\tautorest.ByUnmarshallingJSON(&result.Model),"
+	}
+
+	return fmt.Sprintf(`type %[1]sResponse struct {
+	HttpResponse *http.Response%[2]s
+}
+
+// %[1]s ...
+func (c %[3]s) %[1]s(%[4]s) (result %[1]sResponse, err error) {
+	req, err := c.preparerFor%[1]s(%[5]s)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, nil, "Failure preparing request")
+		return
+	}
+
+	result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	result, err = c.responderFor%[1]s(result.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, result.HttpResponse, "Failure responding to request")
+		return
+	}
+
+	return
+}
+
+%[7]s
+// responderFor%[1]s handles the response to the %[1]s request. The method always
+// closes the http.Response Body.
+func (c %[3]s) responderFor%[1]s(resp *http.Response) (result %[1]sResponse, err error) {
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(%[8]s),%[9]s
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	return
+}
+`, name, model, g.clientName, signature, values, g.errorSource(), g.preparerCode(), g.statusCodes(), unmarshal)
+}
+
+func (g methodGenerator) longRunningCode() string {
+	name := g.operation.Name
+	signature, values := g.arguments()
+
+	return fmt.Sprintf(`type %[1]sResponse struct {
+	Poller       polling.LongRunningPoller
+	HttpResponse *http.Response
+}
+
+// %[1]s ...
+func (c %[2]s) %[1]s(%[3]s) (result %[1]sResponse, err error) {
+	req, err := c.preparerFor%[1]s(%[4]s)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[5]q, %[1]q, nil, "Failure preparing request")
+		return
+	}
+
+	result, err = c.senderFor%[1]s(ctx, req)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[5]q, %[1]q, result.HttpResponse, "Failure sending request")
+		return
+	}
+
+	return
+}
+
+// %[1]sThenPoll performs %[1]s then polls until it's completed
+func (c %[2]s) %[1]sThenPoll(%[3]s) error {
+	result, err := c.%[1]s(%[4]s)
+	if err != nil {
+		return fmt.Errorf("performing %[1]s: %%+v", err)
+	}
+
+	if err := result.Poller.PollUntilDone(); err != nil {
+		return fmt.Errorf("polling after %[1]s: %%+v", err)
+	}
+
+	return nil
+}
+
+%[6]s
+// senderFor%[1]s sends the %[1]s request. The method will close the
+// http.Response Body if it receives an error.
+func (c %[2]s) senderFor%[1]s(ctx context.Context, req *http.Request) (future %[1]sResponse, err error) {
+	var resp *http.Response
+	resp, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		return
+	}
+	future.Poller, err = polling.NewLongRunningPollerFromResponse(ctx, resp, c.Client)
+	return
+}
+`, name, g.clientName, signature, values, g.errorSource(), g.preparerCode())
+}
+
+func (g methodGenerator) pageableCode() string {
+	name := g.operation.Name
+	itemType := g.operation.ResponseType
+	signature, values := g.arguments()
+
+	nextLinkField := ""
+	nextLinkAssignment := ""
+	nextLinkPreparer := ""
+	if g.operation.NextLinkName != "" {
+		nextLinkField = fmt.Sprintf("\n\t\tNextLink *string `json:%q`", g.operation.NextLinkName)
+		nextLinkAssignment = fmt.Sprintf(`
+	result.nextLink = respObj.NextLink
+	if respObj.NextLink != nil {
+		result.nextPageFunc = func(ctx context.Context, nextLink string) (result %[1]sResponse, err error) {
+			req, err := c.preparerFor%[1]sWithNextLink(ctx, nextLink)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, %[2]q, %[1]q, nil, "Failure preparing request")
+				return
+			}
+
+			result.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+			if err != nil {
+				err = autorest.NewErrorWithError(err, %[2]q, %[1]q, result.HttpResponse, "Failure sending request")
+				return
+			}
+
+			result, err = c.responderFor%[1]s(result.HttpResponse)
+			if err != nil {
+				err = autorest.NewErrorWithError(err, %[2]q, %[1]q, result.HttpResponse, "Failure responding to request")
+				return
+			}
+
+			return
+		}
+	}`, name, g.errorSource())
+		nextLinkPreparer = fmt.Sprintf(`
+// preparerFor%[1]sWithNextLink prepares the %[1]s request with the given nextLink token.
+func (c %[2]s) preparerFor%[1]sWithNextLink(ctx context.Context, nextLink string) (*http.Request, error) {
+	uri, err := url.Parse(nextLink)
+	if err != nil {
+		return nil, fmt.Errorf("parsing nextLink %%q: %%+v", nextLink, err)
+	}
+	queryParameters := map[string]interface{}{}
+	for k, v := range uri.Query() {
+		if len(v) == 0 {
+			continue
+		}
+		val := v[0]
+		val = autorest.Encode("query", val)
+		queryParameters[k] = val
+	}
+
+	preparer := autorest.CreatePreparer(
+		autorest.AsContentType("application/json; charset=utf-8"),
+		%[3]s,
+		autorest.WithBaseURL(c.baseUri),
+		autorest.WithPath(uri.Path),
+		autorest.WithQueryParameters(queryParameters))
+	return preparer.Prepare((&http.Request{}).WithContext(ctx))
+}
+`, name, g.clientName, httpMethodPreparers[g.operation.HttpMethod])
+	}
+
+	completeSignature := signature
+	completeValues := strings.TrimPrefix(values, "ctx")
+	predicateSignature := fmt.Sprintf("%s, predicate %sPredicate", signature, itemType)
+
+	return fmt.Sprintf(`type %[1]sResponse struct {
+	HttpResponse *http.Response
+	Model        *[]%[2]s
+
+	nextLink     *string
+	nextPageFunc func(ctx context.Context, nextLink string) (%[1]sResponse, error)
+}
+
+type %[1]sCompleteResult struct {
+	Items []%[2]s
+}
+
+func (r %[1]sResponse) HasMore() bool {
+	return r.nextLink != nil
+}
+
+func (r %[1]sResponse) LoadMore(ctx context.Context) (resp %[1]sResponse, err error) {
+	if !r.HasMore() {
+		err = fmt.Errorf("no more pages returned")
+		return
+	}
+	return r.nextPageFunc(ctx, *r.nextLink)
+}
+
+// %[1]s ...
+func (c %[3]s) %[1]s(%[4]s) (resp %[1]sResponse, err error) {
+	req, err := c.preparerFor%[1]s(%[5]s)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, nil, "Failure preparing request")
+		return
+	}
+
+	resp.HttpResponse, err = c.Client.Send(req, azure.DoRetryWithRegistration(c.Client))
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, resp.HttpResponse, "Failure sending request")
+		return
+	}
+
+	resp, err = c.responderFor%[1]s(resp.HttpResponse)
+	if err != nil {
+		err = autorest.NewErrorWithError(err, %[6]q, %[1]q, resp.HttpResponse, "Failure responding to request")
+		return
+	}
+	return
+}
+
+// %[1]sComplete retrieves all of the results into a single object
+func (c %[3]s) %[1]sComplete(%[7]s) (%[1]sCompleteResult, error) {
+	return c.%[1]sCompleteMatchingPredicate(ctx%[8]s, %[2]sPredicate{})
+}
+
+// %[1]sCompleteMatchingPredicate retrieves all of the results and then applied the predicate
+func (c %[3]s) %[1]sCompleteMatchingPredicate(%[9]s) (resp %[1]sCompleteResult, err error) {
+	items := make([]%[2]s, 0)
+
+	page, err := c.%[1]s(%[5]s)
+	if err != nil {
+		err = fmt.Errorf("loading the initial page: %%+v", err)
+		return
+	}
+	if page.Model != nil {
+		for _, v := range *page.Model {
+			if predicate.Matches(v) {
+				items = append(items, v)
+			}
+		}
+	}
+
+	for page.HasMore() {
+		page, err = page.LoadMore(ctx)
+		if err != nil {
+			err = fmt.Errorf("loading the next page: %%+v", err)
+			return
+		}
+
+		if page.Model != nil {
+			for _, v := range *page.Model {
+				if predicate.Matches(v) {
+					items = append(items, v)
+				}
+			}
+		}
+	}
+
+	out := %[1]sCompleteResult{
+		Items: items,
+	}
+	return out, nil
+}
+
+%[10]s%[11]s
+// responderFor%[1]s handles the response to the %[1]s request. The method always
+// closes the http.Response Body.
+func (c %[3]s) responderFor%[1]s(resp *http.Response) (result %[1]sResponse, err error) {
+	type page struct {
+		Values   []%[2]s `+"`json:%[12]q`"+`%[13]s
+	}
+	var respObj page
+	err = autorest.Respond(
+		resp,
+		azure.WithErrorUnlessStatusCode(%[14]s),
+		autorest.ByUnmarshallingJSON(&respObj),
+		autorest.ByClosing())
+	result.HttpResponse = resp
+	result.Model = &respObj.Values%[15]s
+	return
+}
+`, name, itemType, g.clientName, signature, values, g.errorSource(), completeSignature, completeValues,
+		predicateSignature, g.preparerCode(), nextLinkPreparer, g.operation.ItemName, nextLinkField, g.statusCodes(),
+		nextLinkAssignment)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+func (p packageDefinition) clientCode() string {
+	return fmt.Sprintf(`package %[1]s
+
+import "github.com/Azure/go-autorest/autorest"
+
+type %[2]s struct {
+	Client  autorest.Client
+	baseUri string
+}
+
+func New%[2]sWithBaseURI(endpoint string) %[2]s {
+	return %[2]s{
+		Client:  autorest.NewClientWithUserAgent(userAgent()),
+		baseUri: endpoint,
+	}
+}
+`, p.Name, p.ClientName)
+}
+
+func (p packageDefinition) versionCode() string {
+	return fmt.Sprintf(`package %[1]s
+
+import "fmt"
+
+const defaultApiVersion = %[2]q
+
+func userAgent() string {
+	return fmt.Sprintf("pandora/%[1]s/%%s", defaultApiVersion)
+}
+`, p.Name, p.ApiVersion)
+}
+
+func (p packageDefinition) constantsCode() string {
+	names := make([]string, 0)
+	for name := range p.Constants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	constants := make([]string, 0)
+	for _, name := range names {
+		values := make([]string, 0)
+		for _, value := range p.Constants[name].Values {
+			values = append(values, fmt.Sprintf("\t%s%s %s = %q", name, pascalCase(value), name, value))
+		}
+
+		constants = append(constants, fmt.Sprintf(`type %[1]s string
+
+const (
+%[2]s
+)`, name, strings.Join(values, "\n")))
+	}
+
+	return fmt.Sprintf(`package %s
+
+%s
+`, p.Name, strings.Join(constants, "\n\n"))
+}
+
+func (m modelDefinition) code(packageName string) string {
+	fields := make([]string, 0)
+	helpers := make([]string, 0)
+	for _, field := range m.Fields {
+		tag := fmt.Sprintf("%s,omitempty", field.JsonName)
+		if field.Required {
+			tag = field.JsonName
+		}
+		fields = append(fields, fmt.Sprintf("\t%s %s `json:%q`", field.Name, field.Type, tag))
+
+		if field.DateTime {
+			helpers = append(helpers, fmt.Sprintf(`func (o %[1]s) Get%[2]sAsTime() (*time.Time, error) {
+	return formatting.ParseAsDateFormat(o.%[2]s, "2006-01-02T15:04:05Z07:00")
+}
+
+func (o *%[1]s) Set%[2]sAsTime(input time.Time) {
+	formatted := input.Format("2006-01-02T15:04:05Z07:00")
+	o.%[2]s = &formatted
+}`, m.Name, field.Name))
+		}
+	}
+
+	imports := ""
+	if len(helpers) > 0 {
+		imports = `import (
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/formatting"
+)
+`
+	}
+
+	return fmt.Sprintf(`package %[1]s
+
+%[2]s
+type %[3]s struct {
+%[4]s
+}
+
+%[5]s
+`, packageName, imports, m.Name, strings.Join(fields, "\n"), strings.Join(helpers, "\n\n"))
+}
+
+// predicateCode returns a Predicate which can be used to filter a list of this Model by its top-level scalar fields
+func (m modelDefinition) predicateCode() string {
+	fields := make([]string, 0)
+	conditions := make([]string, 0)
+	for _, field := range m.Fields {
+		switch strings.TrimPrefix(field.Type, "*") {
+		case "bool", "float64", "int64", "string":
+		default:
+			continue
+		}
+
+		fields = append(fields, fmt.Sprintf("\t%s *%s", field.Name, strings.TrimPrefix(field.Type, "*")))
+
+		if field.Required {
+			conditions = append(conditions, fmt.Sprintf(`	if p.%[1]s != nil && input.%[1]s != *p.%[1]s {
+		return false
+	}
+`, field.Name))
+			continue
+		}
+		conditions = append(conditions, fmt.Sprintf(`	if p.%[1]s != nil && (input.%[1]s == nil || *p.%[1]s != *input.%[1]s) {
+		return false
+	}
+`, field.Name))
+	}
+
+	return fmt.Sprintf(`type %[1]sPredicate struct {
+%[2]s
+}
+
+func (p %[1]sPredicate) Matches(input %[1]s) bool {
+%[3]s
+	return true
+}`, m.Name, strings.Join(fields, "\n"), strings.Join(conditions, "\n"))
+}